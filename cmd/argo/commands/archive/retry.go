@@ -18,11 +18,13 @@ import (
 )
 
 type retryOps struct {
-	nodeFieldSelector string // --node-field-selector
-	restartSuccessful bool   // --restart-successful
-	namespace         string // --namespace
-	labelSelector     string // --selector
-	fieldSelector     string // --field-selector
+	nodeFieldSelector string   // --node-field-selector
+	restartSuccessful bool     // --restart-successful
+	namespace         string   // --namespace
+	labelSelector     string   // --selector
+	fieldSelector     string   // --field-selector
+	nodeParameters    []string // --node-parameter
+	nodeArtifacts     []string // --node-artifact
 }
 
 // hasSelector returns true if the CLI arguments selects multiple workflows
@@ -98,6 +100,8 @@ func NewRetryCommand() *cobra.Command {
 	command.Flags().BoolVar(&cliSubmitOpts.Log, "log", false, "log the workflow until it completes")
 	command.Flags().BoolVar(&retryOpts.restartSuccessful, "restart-successful", false, "indicates to restart successful nodes matching the --node-field-selector")
 	command.Flags().StringVar(&retryOpts.nodeFieldSelector, "node-field-selector", "", "selector of nodes to reset, eg: --node-field-selector inputs.paramaters.myparam.value=abc")
+	command.Flags().StringArrayVar(&retryOpts.nodeParameters, "node-parameter", []string{}, "input parameter to override on a node being retried, eg: --node-parameter NODE=NAME=VALUE, where NODE is the node's name or ID")
+	command.Flags().StringArrayVar(&retryOpts.nodeArtifacts, "node-artifact", []string{}, "input artifact to override on a node being retried with the one at KEY in the same repository, eg: --node-artifact NODE=NAME=KEY, where NODE is the node's name or ID")
 	command.Flags().StringVarP(&retryOpts.labelSelector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
 	command.Flags().StringVar(&retryOpts.fieldSelector, "field-selector", "", "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type.")
 	return command
@@ -142,6 +146,8 @@ func retryArchivedWorkflows(ctx context.Context, archiveServiceClient workflowar
 			RestartSuccessful: retryOpts.restartSuccessful,
 			NodeFieldSelector: selector.String(),
 			Parameters:        cliSubmitOpts.Parameters,
+			NodeParameters:    retryOpts.nodeParameters,
+			NodeArtifacts:     retryOpts.nodeArtifacts,
 		})
 		if err != nil {
 			return err
@@ -0,0 +1,97 @@
+package artifact
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/humanize"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+)
+
+func NewLineageCommand() *cobra.Command {
+	var (
+		allNamespaces bool
+		output        = common.EnumFlagValue{AllowedValues: []string{"wide", "json", "yaml"}, Value: "wide"}
+	)
+	command := &cobra.Command{
+		Use:   "lineage KEY",
+		Short: "list the archived workflows which produced or consumed an artifact",
+		Long:  "List the nodes of archived workflows which produced or consumed the artifact with the key, most recently finished first. Requires the workflow archive to be enabled.",
+		Example: `# List the workflows which produced or consumed an artifact:
+  argo artifact lineage my-wf/my-wf-123/main.tgz
+
+# List them across all namespaces:
+  argo artifact lineage my-wf/my-wf-123/main.tgz --all-namespaces
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			if err != nil {
+				return err
+			}
+			namespace := client.Namespace()
+			if allNamespaces {
+				namespace = ""
+			}
+			lineage, err := serviceClient.ListArtifactLineage(ctx, &workflowarchivepkg.ListArtifactLineageRequest{
+				Key:       args[0],
+				Namespace: namespace,
+			})
+			if err != nil {
+				return err
+			}
+			switch output.String() {
+			case "json":
+				data, err := json.MarshalIndent(lineage, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(data))
+			case "yaml":
+				data, err := yaml.Marshal(lineage)
+				if err != nil {
+					return err
+				}
+				fmt.Print(string(data))
+			default:
+				printLineage(lineage)
+			}
+			return nil
+		},
+	}
+	command.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "Show workflows from all namespaces")
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	return command
+}
+
+func printLineage(lineage *workflowarchivepkg.ArtifactLineage) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprint(w, "DIRECTION\tNAMESPACE\tWORKFLOW\tNODE ID\tARTIFACT\tDIGEST\tFINISHED\n")
+	for _, node := range lineage.Producers {
+		printLineageNode(w, "produced", node)
+	}
+	for _, node := range lineage.Consumers {
+		printLineageNode(w, "consumed", node)
+	}
+	_ = w.Flush()
+}
+
+func printLineageNode(w *tabwriter.Writer, direction string, node *workflowarchivepkg.ArtifactLineageNode) {
+	finished := "N/A"
+	if node.FinishedAt != nil && !node.FinishedAt.IsZero() {
+		finished = humanize.RelativeDurationShort(node.FinishedAt.Time, time.Now())
+	}
+	_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", direction, node.Namespace, node.WorkflowName, node.NodeID, node.ArtifactName, node.Digest, finished)
+}
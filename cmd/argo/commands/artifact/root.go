@@ -0,0 +1,18 @@
+package artifact
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewArtifactCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "artifact",
+		Short: "query artifacts in the workflow archive",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	command.AddCommand(NewLineageCommand())
+	return command
+}
@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+)
+
+func NewDeleteCommand() *cobra.Command {
+	cacheType := newCacheTypeValue()
+	command := &cobra.Command{
+		Use:   "delete NAME KEY...",
+		Short: "delete entries in a memoization cache",
+		Args:  cobra.MinimumNArgs(2),
+		Example: `# Delete the entry "my-key" in the ConfigMap cache "my-cache", so the memoized step runs again:
+  argo cache delete my-cache my-key
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewMemoizationCacheServiceClient()
+			if err != nil {
+				return err
+			}
+			name := args[0]
+			for _, key := range args[1:] {
+				_, err := serviceClient.DeleteMemoizationCacheEntry(ctx, &memoizationcachepkg.DeleteMemoizationCacheEntryRequest{
+					Namespace: client.Namespace(),
+					Name:      name,
+					Key:       key,
+					CacheType: cacheType.String(),
+				})
+				if err != nil {
+					return err
+				}
+				fmt.Printf("Cache entry '%s' deleted\n", key)
+			}
+			return nil
+		},
+	}
+	addCacheTypeFlag(command, &cacheType)
+	return command
+}
@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+)
+
+func NewGetCommand() *cobra.Command {
+	var (
+		cacheType = newCacheTypeValue()
+		output    = common.EnumFlagValue{AllowedValues: []string{"json", "yaml"}, Value: "yaml"}
+	)
+	command := &cobra.Command{
+		Use:   "get NAME KEY",
+		Short: "get an entry in a memoization cache",
+		Args:  cobra.ExactArgs(2),
+		Example: `# Get the entry "my-key" in the ConfigMap cache "my-cache":
+  argo cache get my-cache my-key
+
+# Get the entry "my-key" in the artifact cache "my-cache" as JSON:
+  argo cache get my-cache my-key --type Artifact -o json
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewMemoizationCacheServiceClient()
+			if err != nil {
+				return err
+			}
+			entry, err := serviceClient.GetMemoizationCacheEntry(ctx, &memoizationcachepkg.GetMemoizationCacheEntryRequest{
+				Namespace: client.Namespace(),
+				Name:      args[0],
+				Key:       args[1],
+				CacheType: cacheType.String(),
+			})
+			if err != nil {
+				return err
+			}
+			return printEntries(entry, output.String())
+		},
+	}
+	addCacheTypeFlag(command, &cacheType)
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	return command
+}
+
+func printEntries(v interface{}, output string) error {
+	var data []byte
+	var err error
+	if output == "json" {
+		data, err = json.MarshalIndent(v, "", "  ")
+	} else {
+		data, err = yaml.Marshal(v)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+	return nil
+}
@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/humanize"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+)
+
+func NewListCommand() *cobra.Command {
+	var (
+		cacheType = newCacheTypeValue()
+		output    = common.EnumFlagValue{AllowedValues: []string{"wide", "name", "json", "yaml"}, Value: "wide"}
+	)
+	command := &cobra.Command{
+		Use:   "list NAME",
+		Short: "list the entries in a memoization cache",
+		Args:  cobra.ExactArgs(1),
+		Example: `# List the entries in the ConfigMap cache "my-cache":
+  argo cache list my-cache
+
+# List the entries in the SQL cache "my-cache":
+  argo cache list my-cache --type SQL
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewMemoizationCacheServiceClient()
+			if err != nil {
+				return err
+			}
+			list, err := serviceClient.ListMemoizationCacheEntries(ctx, &memoizationcachepkg.ListMemoizationCacheEntriesRequest{
+				Namespace: client.Namespace(),
+				Name:      args[0],
+				CacheType: cacheType.String(),
+			})
+			if err != nil {
+				return err
+			}
+			switch output.String() {
+			case "json", "yaml":
+				return printEntries(list.Items, output.String())
+			case "name":
+				for _, entry := range list.Items {
+					fmt.Println(entry.Key)
+				}
+			default:
+				printTable(list.Items)
+			}
+			return nil
+		},
+	}
+	addCacheTypeFlag(command, &cacheType)
+	command.Flags().VarP(&output, "output", "o", "Output format. "+output.Usage())
+	return command
+}
+
+func printTable(entries []*memoizationcachepkg.MemoizationCacheEntry) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprint(w, "KEY\tNODE ID\tAGE\tLAST HIT\n")
+	for _, entry := range entries {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", entry.Key, entry.NodeID, relativeDuration(entry.CreationTimestamp), relativeDuration(entry.LastHitTimestamp))
+	}
+	_ = w.Flush()
+}
+
+func relativeDuration(t *metav1.Time) string {
+	if t == nil || t.IsZero() {
+		return "N/A"
+	}
+	return humanize.RelativeDurationShort(t.Time, time.Now())
+}
@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+)
+
+func NewPruneCommand() *cobra.Command {
+	var (
+		cacheType = newCacheTypeValue()
+		olderThan string
+	)
+	command := &cobra.Command{
+		Use:   "prune NAME",
+		Short: "delete old entries in a memoization cache",
+		Args:  cobra.ExactArgs(1),
+		Example: `# Delete entries created more than a day ago in the ConfigMap cache "my-cache":
+  argo cache prune my-cache --older-than 24h
+
+# Delete all the entries in the SQL cache "my-cache":
+  argo cache prune my-cache --type SQL --older-than 0s
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewMemoizationCacheServiceClient()
+			if err != nil {
+				return err
+			}
+			resp, err := serviceClient.PruneMemoizationCache(ctx, &memoizationcachepkg.PruneMemoizationCacheRequest{
+				Namespace: client.Namespace(),
+				Name:      args[0],
+				CacheType: cacheType.String(),
+				OlderThan: olderThan,
+			})
+			if err != nil {
+				return err
+			}
+			for _, key := range resp.Keys {
+				fmt.Printf("Cache entry '%s' deleted\n", key)
+			}
+			return nil
+		},
+	}
+	addCacheTypeFlag(command, &cacheType)
+	command.Flags().StringVar(&olderThan, "older-than", "", "Delete entries created longer ago than this duration, e.g. 24h")
+	_ = command.MarkFlagRequired("older-than")
+	return command
+}
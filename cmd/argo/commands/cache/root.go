@@ -0,0 +1,35 @@
+package cache
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/common"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func NewCacheCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "cache",
+		Short: "manage memoization caches",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	command.AddCommand(NewListCommand())
+	command.AddCommand(NewGetCommand())
+	command.AddCommand(NewDeleteCommand())
+	command.AddCommand(NewPruneCommand())
+	return command
+}
+
+func newCacheTypeValue() common.EnumFlagValue {
+	return common.EnumFlagValue{
+		AllowedValues: []string{string(wfv1.MemoizationCacheTypeConfigMap), string(wfv1.MemoizationCacheTypeSQL), string(wfv1.MemoizationCacheTypeArtifact)},
+		Value:         string(wfv1.MemoizationCacheTypeConfigMap),
+	}
+}
+
+func addCacheTypeFlag(command *cobra.Command, cacheType *common.EnumFlagValue) {
+	command.Flags().Var(cacheType, "type", "Type of the cache. "+cacheType.Usage())
+}
@@ -132,6 +132,10 @@ func PrintWorkflowHelper(wf *wfv1.Workflow, getArgs GetFlags) string {
 					out += fmt.Sprintf(fmtStr, "  "+art.Name+":", art.GCS.String())
 				} else if art.Azure != nil {
 					out += fmt.Sprintf(fmtStr, "  "+art.Name+":", art.Azure.String())
+				} else if art.OCI != nil {
+					out += fmt.Sprintf(fmtStr, "  "+art.Name+":", art.OCI.String())
+				} else if art.SFTP != nil {
+					out += fmt.Sprintf(fmtStr, "  "+art.Name+":", art.SFTP.String())
 				}
 			}
 		}
@@ -233,11 +237,11 @@ func isBoundaryNode(node wfv1.NodeType) bool {
 }
 
 func isNonBoundaryParentNode(node wfv1.NodeType) bool {
-	return (node == wfv1.NodeTypeStepGroup) || (node == wfv1.NodeTypeRetry)
+	return (node == wfv1.NodeTypeStepGroup) || (node == wfv1.NodeTypeRetry) || (node == wfv1.NodeTypeLoop)
 }
 
 func isExecutionNode(node wfv1.NodeType) bool {
-	return (node == wfv1.NodeTypePod) || (node == wfv1.NodeTypeSkipped) || (node == wfv1.NodeTypeSuspend) || (node == wfv1.NodeTypeHTTP) || (node == wfv1.NodeTypePlugin)
+	return (node == wfv1.NodeTypePod) || (node == wfv1.NodeTypeSkipped) || (node == wfv1.NodeTypeSuspend) || (node == wfv1.NodeTypeHTTP) || (node == wfv1.NodeTypePlugin) || (node == wfv1.NodeTypeCompacted)
 }
 
 func insertSorted(wf *wfv1.Workflow, sortedArray []renderNode, item renderNode) []renderNode {
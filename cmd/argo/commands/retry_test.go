@@ -124,6 +124,25 @@ func Test_retryWorkflows(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("Retry workflow with node parameters", func(t *testing.T) {
+		c := &workflowmocks.WorkflowServiceClient{}
+		retryOpts := retryOps{
+			namespace:      "argo",
+			nodeParameters: []string{"foo.bar=message=hello"},
+		}
+		cliSubmitOpts := common.CliSubmitOpts{}
+
+		c.On("RetryWorkflow", mock.Anything, mock.Anything).Return(&wfv1.Workflow{}, nil)
+
+		err := retryWorkflows(context.Background(), c, retryOpts, cliSubmitOpts, []string{"foo"})
+		require.NoError(t, err)
+		c.AssertCalled(t, "RetryWorkflow", mock.Anything, &workflowpkg.WorkflowRetryRequest{
+			Name:           "foo",
+			Namespace:      "argo",
+			NodeParameters: []string{"foo.bar=message=hello"},
+		})
+	})
+
 	t.Run("Retry workflow list error", func(t *testing.T) {
 		c := &workflowmocks.WorkflowServiceClient{}
 		retryOpts := retryOps{
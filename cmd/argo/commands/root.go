@@ -12,7 +12,9 @@ import (
 
 	"github.com/argoproj/argo-workflows/v3"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/archive"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/artifact"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/auth"
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/cache"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/clustertemplate"
 	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/cron"
@@ -114,6 +116,8 @@ If your server is behind an ingress with a path (running "argo server --base-hre
 	command.AddCommand(NewNodeCommand())
 	command.AddCommand(NewTerminateCommand())
 	command.AddCommand(archive.NewArchiveCommand())
+	command.AddCommand(artifact.NewArtifactCommand())
+	command.AddCommand(cache.NewCacheCommand())
 	command.AddCommand(NewVersionCommand())
 	command.AddCommand(template.NewTemplateCommand())
 	command.AddCommand(cron.NewCronWorkflowCommand())
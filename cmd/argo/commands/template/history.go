@@ -0,0 +1,54 @@
+package template
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
+)
+
+func NewHistoryCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "history WORKFLOW_TEMPLATE",
+		Short: "list the revisions of a workflow template",
+		Long:  "List the revisions of a workflow template recorded by the Argo Server, newest first. Requires persistence to be configured.",
+		Example: `# List the revisions of a workflow template:
+  argo template history my-wftmpl
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewWorkflowTemplateServiceClient()
+			if err != nil {
+				return err
+			}
+			revisions, err := serviceClient.ListWorkflowTemplateRevisions(ctx, &workflowtemplatepkg.WorkflowTemplateRevisionsRequest{
+				Name:      args[0],
+				Namespace: client.Namespace(),
+			})
+			if err != nil {
+				return err
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			_, _ = fmt.Fprintln(w, "REVISION\tCREATED")
+			for _, revision := range revisions.Items {
+				created := "N/A"
+				if revision.CreationTimestamp != nil {
+					created = humanize.RelativeDurationShort(revision.CreationTimestamp.Time, time.Now())
+				}
+				_, _ = fmt.Fprintf(w, "%d\t%s\n", revision.Revision, created)
+			}
+			return w.Flush()
+		},
+	}
+	return command
+}
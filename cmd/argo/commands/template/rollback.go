@@ -0,0 +1,48 @@
+package template
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
+)
+
+func NewRollbackCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "rollback WORKFLOW_TEMPLATE REVISION",
+		Short: "roll a workflow template back to an earlier revision",
+		Long:  "Roll a workflow template back to the spec of an earlier revision. The rollback is recorded as a new revision. Requires persistence to be configured.",
+		Example: `# Roll a workflow template back to its first revision:
+  argo template rollback my-wftmpl 1
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			revision, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid revision %q: %w", args[1], err)
+			}
+			ctx, apiClient, err := client.NewAPIClient(cmd.Context())
+			if err != nil {
+				return err
+			}
+			serviceClient, err := apiClient.NewWorkflowTemplateServiceClient()
+			if err != nil {
+				return err
+			}
+			wftmpl, err := serviceClient.RollbackWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateRollbackRequest{
+				Name:      args[0],
+				Namespace: client.Namespace(),
+				Revision:  revision,
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Printf("WorkflowTemplate '%s' rolled back to revision %d\n", wftmpl.Name, revision)
+			return nil
+		},
+	}
+	return command
+}
@@ -19,6 +19,8 @@ func NewTemplateCommand() *cobra.Command {
 	command.AddCommand(NewDeleteCommand())
 	command.AddCommand(NewLintCommand())
 	command.AddCommand(NewUpdateCommand())
+	command.AddCommand(NewHistoryCommand())
+	command.AddCommand(NewRollbackCommand())
 
 	return command
 }
@@ -208,6 +208,11 @@ func NewEmissaryCommand() *cobra.Command {
 						}
 					}
 				}
+				if x := template.Outputs.DAG; x != nil && x.Path != "" {
+					if err := saveParameter(x.Path); err != nil {
+						return err
+					}
+				}
 				for _, x := range template.Outputs.Artifacts {
 					if x.Path != "" {
 						if err := saveArtifact(x.Path); err != nil {
@@ -6,6 +6,8 @@ import (
 
 	"github.com/argoproj/pkg/stats"
 	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 )
 
 func NewInitCommand() *cobra.Command {
@@ -25,6 +27,11 @@ func NewInitCommand() *cobra.Command {
 }
 
 func loadArtifacts(ctx context.Context) error {
+	tracing := initTracing(ctx)
+	defer tracing.Shutdown(context.Background())
+	ctx, span := tracing.Tracer().Start(telemetry.ContextFromEnv(ctx), "init")
+	defer span.End()
+
 	wfExecutor := initExecutor()
 	defer wfExecutor.HandleError(ctx)
 	defer stats.LogStats()
@@ -44,5 +51,10 @@ func loadArtifacts(ctx context.Context) error {
 		wfExecutor.AddError(err)
 		return err
 	}
+	err = wfExecutor.RestoreCheckpoint(ctx)
+	if err != nil {
+		wfExecutor.AddError(err)
+		return err
+	}
 	return nil
 }
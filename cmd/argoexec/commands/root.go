@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -23,6 +24,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util"
 	"github.com/argoproj/argo-workflows/v3/util/cmd"
 	"github.com/argoproj/argo-workflows/v3/util/logs"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor/emissary"
@@ -76,6 +78,15 @@ func NewRootCommand() *cobra.Command {
 	return &command
 }
 
+// initTracing sets up tracing of the executor. Failing to do so is not fatal, as the workflow does not depend on it.
+func initTracing(ctx context.Context) *telemetry.Tracing {
+	tracing, err := telemetry.NewTracing(ctx, CLIName)
+	if err != nil {
+		log.WithError(err).Warn("Failed to set up tracing")
+	}
+	return tracing
+}
+
 func initExecutor() *executor.WorkflowExecutor {
 	version := argo.GetVersion()
 	log.WithFields(log.Fields{"version": version.Version}).Info("Starting Workflow Executor")
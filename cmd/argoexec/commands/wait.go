@@ -7,6 +7,9 @@ import (
 
 	"github.com/argoproj/pkg/stats"
 	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 )
 
 func NewWaitCommand() *cobra.Command {
@@ -26,13 +29,19 @@ func NewWaitCommand() *cobra.Command {
 }
 
 func waitContainer(ctx context.Context) error {
+	tracing := initTracing(ctx)
+	defer tracing.Shutdown(context.Background())
+
 	wfExecutor := initExecutor()
 
 	// Don't allow cancellation to impact capture of results, parameters, artifacts, or defers.
-	bgCtx := context.Background()
+	bgCtx, span := tracing.Tracer().Start(telemetry.ContextFromEnv(context.Background()), "wait")
+	defer span.End()
+	ctx = trace.ContextWithSpan(ctx, span)
 
-	defer wfExecutor.HandleError(bgCtx)    // Must be placed at the bottom of defers stack.
-	defer wfExecutor.FinalizeOutput(bgCtx) // Ensures the LabelKeyReportOutputsCompleted is set to true.
+	defer wfExecutor.HandleError(bgCtx)          // Must be placed at the bottom of defers stack.
+	defer wfExecutor.ServeStreamedArtifacts(ctx) // Serves streamed artifacts once the outputs are reported.
+	defer wfExecutor.FinalizeOutput(bgCtx)       // Ensures the LabelKeyReportOutputsCompleted is set to true.
 	defer stats.LogStats()
 	stats.StartStatsTicker(5 * time.Minute)
 
@@ -57,6 +66,12 @@ func waitContainer(ctx context.Context) error {
 		wfExecutor.AddError(err)
 	}
 
+	// Saving output DAG
+	err = wfExecutor.SaveDAG(bgCtx)
+	if err != nil {
+		wfExecutor.AddError(err)
+	}
+
 	// Saving output artifacts
 	artifacts, err := wfExecutor.SaveArtifacts(bgCtx)
 	if err != nil {
@@ -67,12 +67,24 @@ type Config struct {
 	// NamespaceParallelism limits the max workflows that can execute at the same time in a namespace
 	NamespaceParallelism int `json:"namespaceParallelism,omitempty"`
 
+	// ResourceQuotaAdmission only runs the workflows of a namespace, in priority order, once the resources their pods
+	// request fit within the headroom of the namespace's resource quotas
+	ResourceQuotaAdmission bool `json:"resourceQuotaAdmission,omitempty"`
+
 	// ResourceRateLimit limits the rate at which pods are created
 	ResourceRateLimit *ResourceRateLimit `json:"resourceRateLimit,omitempty"`
 
 	// Persistence contains the workflow persistence DB configuration
 	Persistence *PersistConfig `json:"persistence,omitempty"`
 
+	// NodeStatusCompaction collapses the completed, successful nodes of the expanded steps and tasks of large
+	// workflows into summary nodes, storing their statuses in the workflow's artifact repository
+	NodeStatusCompaction *NodeStatusCompaction `json:"nodeStatusCompaction,omitempty"`
+
+	// Synchronization enables semaphores and mutexes held in the persistence database, so that they can be shared
+	// between controllers using the same database
+	Synchronization *SyncConfig `json:"synchronization,omitempty"`
+
 	// Links to related apps.
 	Links []*wfv1.Link `json:"links,omitempty"`
 
@@ -217,6 +229,79 @@ func (c PersistConfig) GetClusterName() string {
 	return "default"
 }
 
+// NodeStatusCompaction contains the configuration for compacting the nodes of large workflows
+type NodeStatusCompaction struct {
+	// Enabled turns on compaction
+	Enabled bool `json:"enabled,omitempty"`
+	// MinNodes is the number of nodes a workflow must have before its nodes are compacted. Defaults to 1000.
+	MinNodes int `json:"minNodes,omitempty"`
+	// MinChildren is the number of completed, successful nodes an expanded step or task must have before they are
+	// compacted. Defaults to 100.
+	MinChildren int `json:"minChildren,omitempty"`
+}
+
+func (c *NodeStatusCompaction) IsEnabled() bool {
+	return c != nil && c.Enabled
+}
+
+func (c NodeStatusCompaction) GetMinNodes() int {
+	if c.MinNodes <= 0 {
+		return 1000
+	}
+	return c.MinNodes
+}
+
+func (c NodeStatusCompaction) GetMinChildren() int {
+	if c.MinChildren <= 0 {
+		return 100
+	}
+	return c.MinChildren
+}
+
+// SyncConfig contains the configuration for database semaphores and mutexes
+type SyncConfig struct {
+	// ControllerName uniquely identifies this controller amongst all controllers sharing the database
+	ControllerName string `json:"controllerName"`
+	// PollInterval is how often workflows waiting for a lock re-check the database, as the lock may have been
+	// released by another controller. Defaults to 5 seconds.
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+	// HeartbeatInterval is how often this controller records that it is still alive. Defaults to 60 seconds.
+	HeartbeatInterval *metav1.Duration `json:"heartbeatInterval,omitempty"`
+	// InactiveControllerTimeout is how long a controller may go without a heartbeat before the locks it holds
+	// expire. Defaults to 5 minutes.
+	InactiveControllerTimeout *metav1.Duration `json:"inactiveControllerTimeout,omitempty"`
+	// LimitCacheTTL is how long semaphore limits read from the database are cached. Defaults to 0, no caching.
+	LimitCacheTTL *metav1.Duration `json:"limitCacheTTL,omitempty"`
+}
+
+func (c SyncConfig) GetPollInterval() time.Duration {
+	if c.PollInterval == nil {
+		return 5 * time.Second
+	}
+	return c.PollInterval.Duration
+}
+
+func (c SyncConfig) GetHeartbeatInterval() time.Duration {
+	if c.HeartbeatInterval == nil {
+		return time.Minute
+	}
+	return c.HeartbeatInterval.Duration
+}
+
+func (c SyncConfig) GetInactiveControllerTimeout() time.Duration {
+	if c.InactiveControllerTimeout == nil {
+		return 5 * time.Minute
+	}
+	return c.InactiveControllerTimeout.Duration
+}
+
+func (c SyncConfig) GetLimitCacheTTL() time.Duration {
+	if c.LimitCacheTTL == nil {
+		return 0
+	}
+	return c.LimitCacheTTL.Duration
+}
+
 type ConnectionPool struct {
 	MaxIdleConns    int `json:"maxIdleConns,omitempty"`
 	MaxOpenConns    int `json:"maxOpenConns,omitempty"`
@@ -0,0 +1,100 @@
+package sqldb
+
+import (
+	"time"
+
+	"github.com/upper/db/v4"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const archiveArtifactsTableName = archiveTableName + "_artifacts"
+
+const (
+	// ArtifactDirectionInput is the direction of an artifact consumed by a node
+	ArtifactDirectionInput = "input"
+	// ArtifactDirectionOutput is the direction of an artifact produced by a node
+	ArtifactDirectionOutput = "output"
+)
+
+// archivedWorkflowArtifactRecord is an artifact produced or consumed by a node of an archived workflow
+type archivedWorkflowArtifactRecord struct {
+	ClusterName  string `db:"clustername"`
+	UID          string `db:"uid"`
+	NodeID       string `db:"nodeid"`
+	Direction    string `db:"direction"`
+	ArtifactName string `db:"artifactname"`
+	// Why is this called "artifactkey" not "key"? Key is an SQL reserved word.
+	Key    string `db:"artifactkey"`
+	Digest string `db:"digest"`
+}
+
+// ArtifactLineageRecord is an artifact produced or consumed by a node of an archived workflow, together with the workflow
+type ArtifactLineageRecord struct {
+	UID          string    `db:"uid"`
+	Name         string    `db:"name"`
+	Namespace    string    `db:"namespace"`
+	FinishedAt   time.Time `db:"finishedat"`
+	NodeID       string    `db:"nodeid"`
+	Direction    string    `db:"direction"`
+	ArtifactName string    `db:"artifactname"`
+	Key          string    `db:"artifactkey"`
+	Digest       string    `db:"digest"`
+}
+
+// artifactRecords returns the artifacts produced and consumed by the nodes of the workflow. Artifacts without
+// a key, such as raw artifacts and optional artifacts which were not produced, are not recorded.
+func artifactRecords(clusterName string, wf *wfv1.Workflow) []archivedWorkflowArtifactRecord {
+	var records []archivedWorkflowArtifactRecord
+	add := func(node wfv1.NodeStatus, direction string, artifacts wfv1.Artifacts) {
+		for _, art := range artifacts {
+			key, err := art.GetKey()
+			if err != nil || key == "" {
+				continue
+			}
+			records = append(records, archivedWorkflowArtifactRecord{
+				ClusterName:  clusterName,
+				UID:          string(wf.UID),
+				NodeID:       node.ID,
+				Direction:    direction,
+				ArtifactName: art.Name,
+				Key:          key,
+				Digest:       art.Digest,
+			})
+		}
+	}
+	for _, node := range wf.Status.Nodes {
+		if node.Inputs != nil {
+			add(node, ArtifactDirectionInput, node.Inputs.Artifacts)
+		}
+		if node.Outputs != nil {
+			add(node, ArtifactDirectionOutput, node.Outputs.Artifacts)
+		}
+	}
+	return records
+}
+
+// ListArtifactLineage returns the nodes of archived workflows which produced or consumed the artifact with the key,
+// most recently finished first
+func (r *workflowArchive) ListArtifactLineage(namespace, key string) ([]ArtifactLineageRecord, error) {
+	var records []ArtifactLineageRecord
+	err := r.session.SQL().
+		Select("w.uid", "w.name", "w.namespace", "w.finishedat", "a.nodeid", "a.direction", "a.artifactname", "a.artifactkey", "a.digest").
+		From(archiveArtifactsTableName+" a").
+		Join(archiveTableName+" w").On("a.clustername = w.clustername and a.uid = w.uid").
+		Where(db.Cond{"w.clustername": r.clusterName}).
+		And(db.Cond{"w.instanceid": r.instanceIDService.InstanceID()}).
+		And(qualifiedNamespaceEqual(r.managedNamespace)).
+		And(qualifiedNamespaceEqual(namespace)).
+		And(db.Cond{"a.artifactkey": key}).
+		OrderBy("-w.finishedat", "a.nodeid").
+		All(&records)
+	return records, err
+}
+
+func qualifiedNamespaceEqual(namespace string) db.Cond {
+	if namespace != "" {
+		return db.Cond{"w.namespace": namespace}
+	}
+	return db.Cond{}
+}
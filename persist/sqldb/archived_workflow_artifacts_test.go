@@ -0,0 +1,36 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func Test_artifactRecords(t *testing.T) {
+	s3 := func(key string) wfv1.ArtifactLocation {
+		return wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: key}}
+	}
+	wf := &wfv1.Workflow{}
+	wf.UID = "my-uid"
+	wf.Status.Nodes = wfv1.Nodes{
+		"producer": {
+			ID: "producer",
+			Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+				{Name: "data", ArtifactLocation: s3("my-key"), Digest: "sha256:abc"},
+				{Name: "raw", ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "raw"}}},
+				{Name: "missing", Optional: true},
+			}},
+		},
+		"consumer": {
+			ID:     "consumer",
+			Inputs: &wfv1.Inputs{Artifacts: wfv1.Artifacts{{Name: "data", ArtifactLocation: s3("my-key"), Digest: "sha256:abc"}}},
+		},
+	}
+
+	assert.ElementsMatch(t, []archivedWorkflowArtifactRecord{
+		{ClusterName: "my-cluster", UID: "my-uid", NodeID: "producer", Direction: ArtifactDirectionOutput, ArtifactName: "data", Key: "my-key", Digest: "sha256:abc"},
+		{ClusterName: "my-cluster", UID: "my-uid", NodeID: "consumer", Direction: ArtifactDirectionInput, ArtifactName: "data", Key: "my-key", Digest: "sha256:abc"},
+	}, artifactRecords("my-cluster", wf))
+}
@@ -0,0 +1,114 @@
+package sqldb
+
+import (
+	"time"
+
+	"github.com/upper/db/v4"
+)
+
+const memoizationCacheTableName = "argo_memoization_cache"
+
+// MemoizationCacheRecord is an entry in a memoization cache, with the outputs of the node that created it as JSON
+type MemoizationCacheRecord struct {
+	Namespace    string    `db:"namespace"`
+	CacheName    string    `db:"cachename"`
+	Key          string    `db:"cachekey"`
+	NodeID       string    `db:"nodeid"`
+	Outputs      string    `db:"outputs"`
+	CreationTime time.Time `db:"creationtime"`
+	LastHitTime  time.Time `db:"lasthittime"`
+}
+
+//go:generate mockery --name=MemoizationCacheRepo
+
+// MemoizationCacheRepo holds memoization cache entries in the persistence database
+type MemoizationCacheRepo interface {
+	// Get returns the entry, or nil if there is none
+	Get(namespace, cacheName, key string) (*MemoizationCacheRecord, error)
+	// Save creates the entry, replacing any existing entry for the same key
+	Save(record *MemoizationCacheRecord) error
+	// Hit records that the entry has been used
+	Hit(namespace, cacheName, key string, lastHitTime time.Time) error
+	// List returns all the entries in the cache
+	List(namespace, cacheName string) ([]MemoizationCacheRecord, error)
+	// Delete removes the entry, if it exists
+	Delete(namespace, cacheName, key string) error
+}
+
+type memoizationCacheRepo struct {
+	session db.Session
+}
+
+// NewMemoizationCacheRepo returns a new MemoizationCacheRepo
+func NewMemoizationCacheRepo(session db.Session) MemoizationCacheRepo {
+	return &memoizationCacheRepo{session: session}
+}
+
+var memoizationCacheColumns = []interface{}{"namespace", "cachename", "cachekey", "nodeid", "outputs", "creationtime", "lasthittime"}
+
+func (r *memoizationCacheRepo) Get(namespace, cacheName, key string) (*MemoizationCacheRecord, error) {
+	record := &MemoizationCacheRecord{}
+	err := r.session.SQL().
+		Select(memoizationCacheColumns...).
+		From(memoizationCacheTableName).
+		Where(db.Cond{"namespace": namespace}).
+		And(db.Cond{"cachename": cacheName}).
+		And(db.Cond{"cachekey": key}).
+		One(record)
+	if err == db.ErrNoMoreRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (r *memoizationCacheRepo) Save(record *MemoizationCacheRecord) error {
+	return r.session.Tx(func(sess db.Session) error {
+		_, err := sess.SQL().
+			DeleteFrom(memoizationCacheTableName).
+			Where(db.Cond{"namespace": record.Namespace}).
+			And(db.Cond{"cachename": record.CacheName}).
+			And(db.Cond{"cachekey": record.Key}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		_, err = sess.Collection(memoizationCacheTableName).Insert(record)
+		return err
+	})
+}
+
+func (r *memoizationCacheRepo) Hit(namespace, cacheName, key string, lastHitTime time.Time) error {
+	_, err := r.session.SQL().
+		Update(memoizationCacheTableName).
+		Set("lasthittime", lastHitTime.UTC()).
+		Where(db.Cond{"namespace": namespace}).
+		And(db.Cond{"cachename": cacheName}).
+		And(db.Cond{"cachekey": key}).
+		Exec()
+	return err
+}
+
+func (r *memoizationCacheRepo) List(namespace, cacheName string) ([]MemoizationCacheRecord, error) {
+	var records []MemoizationCacheRecord
+	err := r.session.SQL().
+		Select(memoizationCacheColumns...).
+		From(memoizationCacheTableName).
+		Where(db.Cond{"namespace": namespace}).
+		And(db.Cond{"cachename": cacheName}).
+		OrderBy("cachekey").
+		All(&records)
+	return records, err
+}
+
+func (r *memoizationCacheRepo) Delete(namespace, cacheName, key string) error {
+	_, err := r.session.SQL().
+		DeleteFrom(memoizationCacheTableName).
+		Where(db.Cond{"namespace": namespace}).
+		And(db.Cond{"cachename": cacheName}).
+		And(db.Cond{"cachekey": key}).
+		Exec()
+	return err
+}
@@ -275,6 +275,59 @@ func (m migrate) Exec(ctx context.Context) (err error) {
 			ansiSQLChange(`drop index argo_archived_workflows_i4`),
 		),
 		ansiSQLChange(`create index argo_archived_workflows_i4 on argo_archived_workflows (clustername, startedat)`),
+		// tables for semaphores and mutexes shared between controllers
+		ansiSQLChange(`create table if not exists ` + syncLimitTableName + ` (
+    name varchar(256) not null,
+    sizelimit int not null,
+    primary key (name)
+)`),
+		ansiSQLChange(`create table if not exists ` + syncStateTableName + ` (
+    name varchar(256) not null,
+    workflowkey varchar(256) not null,
+    controller varchar(64) not null,
+    held boolean not null,
+    priority int not null,
+    creationtime timestamp default CURRENT_TIMESTAMP,
+    primary key (name, workflowkey, controller)
+)`),
+		ansiSQLChange(`create table if not exists ` + syncControllerTableName + ` (
+    controller varchar(64) not null,
+    heartbeat timestamp default CURRENT_TIMESTAMP,
+    primary key (controller)
+)`),
+		ansiSQLChange(`create table if not exists ` + syncLockTableName + ` (
+    name varchar(256) not null,
+    primary key (name)
+)`),
+		// table for memoization caches
+		ternary(dbType == MySQL,
+			ansiSQLChange(memoizationCacheTable("longtext")),
+			ansiSQLChange(memoizationCacheTable("text")),
+		),
+		// table for workflow template and cluster workflow template revisions
+		ternary(dbType == MySQL,
+			ansiSQLChange(templateRevisionTable("longtext")),
+			ansiSQLChange(templateRevisionTable("text")),
+		),
+		// The argo_archived_workflows_artifacts records the artifacts produced and consumed by the nodes of archived
+		// workflows, so that the lineage of an artifact can be queried by its key.
+		// Why is the key called "artifactkey" not "key"? Key is an SQL reserved word.
+		ansiSQLChange(`create table if not exists ` + archiveArtifactsTableName + ` (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    nodeid varchar(256) not null,
+    direction varchar(8) not null,
+    artifactname varchar(256) not null,
+    artifactkey varchar(1024) not null,
+    digest varchar(128) not null,
+    primary key (clustername, uid, nodeid, direction, artifactname),
+    foreign key (clustername, uid) references argo_archived_workflows(clustername, uid) on delete cascade
+)`),
+		// MySQL limits the size of an index, so only a prefix of the key is indexed
+		ternary(dbType == MySQL,
+			ansiSQLChange(`create index `+archiveArtifactsTableName+`_i1 on `+archiveArtifactsTableName+` (clustername,artifactkey(512))`),
+			ansiSQLChange(`create index `+archiveArtifactsTableName+`_i1 on `+archiveArtifactsTableName+` (clustername,artifactkey)`),
+		),
 	} {
 		err := m.applyChange(changeSchemaVersion, change)
 		if err != nil {
@@ -285,6 +338,31 @@ func (m migrate) Exec(ctx context.Context) (err error) {
 	return nil
 }
 
+func memoizationCacheTable(outputsType string) string {
+	return `create table if not exists ` + memoizationCacheTableName + ` (
+    namespace varchar(256) not null,
+    cachename varchar(256) not null,
+    cachekey varchar(256) not null,
+    nodeid varchar(256) not null,
+    outputs ` + outputsType + ` not null,
+    creationtime timestamp default CURRENT_TIMESTAMP,
+    lasthittime timestamp default CURRENT_TIMESTAMP,
+    primary key (namespace, cachename, cachekey)
+)`
+}
+
+func templateRevisionTable(templateType string) string {
+	return `create table if not exists ` + templateRevisionTableName + ` (
+    kind varchar(64) not null,
+    namespace varchar(256) not null,
+    name varchar(256) not null,
+    revision bigint not null,
+    template ` + templateType + ` not null,
+    creationtime timestamp default CURRENT_TIMESTAMP,
+    primary key (kind, namespace, name, revision)
+)`
+}
+
 func (m migrate) applyChange(changeSchemaVersion int, c change) error {
 	// https://upper.io/blog/2020/08/29/whats-new-on-upper-v4/#transactions-enclosed-by-functions
 	err := m.session.Tx(func(tx db.Session) error {
@@ -0,0 +1,143 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	sqldb "github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MemoizationCacheRepo is an autogenerated mock type for the MemoizationCacheRepo type
+type MemoizationCacheRepo struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: namespace, cacheName, key
+func (_m *MemoizationCacheRepo) Delete(namespace string, cacheName string, key string) error {
+	ret := _m.Called(namespace, cacheName, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(namespace, cacheName, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: namespace, cacheName, key
+func (_m *MemoizationCacheRepo) Get(namespace string, cacheName string, key string) (*sqldb.MemoizationCacheRecord, error) {
+	ret := _m.Called(namespace, cacheName, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *sqldb.MemoizationCacheRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*sqldb.MemoizationCacheRecord, error)); ok {
+		return rf(namespace, cacheName, key)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *sqldb.MemoizationCacheRecord); ok {
+		r0 = rf(namespace, cacheName, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqldb.MemoizationCacheRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(namespace, cacheName, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Hit provides a mock function with given fields: namespace, cacheName, key, lastHitTime
+func (_m *MemoizationCacheRepo) Hit(namespace string, cacheName string, key string, lastHitTime time.Time) error {
+	ret := _m.Called(namespace, cacheName, key, lastHitTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, time.Time) error); ok {
+		r0 = rf(namespace, cacheName, key, lastHitTime)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: namespace, cacheName
+func (_m *MemoizationCacheRepo) List(namespace string, cacheName string) ([]sqldb.MemoizationCacheRecord, error) {
+	ret := _m.Called(namespace, cacheName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []sqldb.MemoizationCacheRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]sqldb.MemoizationCacheRecord, error)); ok {
+		return rf(namespace, cacheName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []sqldb.MemoizationCacheRecord); ok {
+		r0 = rf(namespace, cacheName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqldb.MemoizationCacheRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(namespace, cacheName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Save provides a mock function with given fields: record
+func (_m *MemoizationCacheRepo) Save(record *sqldb.MemoizationCacheRecord) error {
+	ret := _m.Called(record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*sqldb.MemoizationCacheRecord) error); ok {
+		r0 = rf(record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMemoizationCacheRepo creates a new instance of MemoizationCacheRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMemoizationCacheRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MemoizationCacheRepo {
+	mock := &MemoizationCacheRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,174 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	sqldb "github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TemplateRevisionRepo is an autogenerated mock type for the TemplateRevisionRepo type
+type TemplateRevisionRepo struct {
+	mock.Mock
+}
+
+// GetClusterWorkflowTemplate provides a mock function with given fields: name, revision
+func (_m *TemplateRevisionRepo) GetClusterWorkflowTemplate(name string, revision int64) (*v1alpha1.ClusterWorkflowTemplate, error) {
+	ret := _m.Called(name, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClusterWorkflowTemplate")
+	}
+
+	var r0 *v1alpha1.ClusterWorkflowTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) (*v1alpha1.ClusterWorkflowTemplate, error)); ok {
+		return rf(name, revision)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) *v1alpha1.ClusterWorkflowTemplate); ok {
+		r0 = rf(name, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ClusterWorkflowTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(name, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkflowTemplate provides a mock function with given fields: namespace, name, revision
+func (_m *TemplateRevisionRepo) GetWorkflowTemplate(namespace string, name string, revision int64) (*v1alpha1.WorkflowTemplate, error) {
+	ret := _m.Called(namespace, name, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflowTemplate")
+	}
+
+	var r0 *v1alpha1.WorkflowTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int64) (*v1alpha1.WorkflowTemplate, error)); ok {
+		return rf(namespace, name, revision)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int64) *v1alpha1.WorkflowTemplate); ok {
+		r0 = rf(namespace, name, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.WorkflowTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int64) error); ok {
+		r1 = rf(namespace, name, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRevisions provides a mock function with given fields: kind, namespace, name
+func (_m *TemplateRevisionRepo) ListRevisions(kind string, namespace string, name string) ([]sqldb.TemplateRevisionRecord, error) {
+	ret := _m.Called(kind, namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRevisions")
+	}
+
+	var r0 []sqldb.TemplateRevisionRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) ([]sqldb.TemplateRevisionRecord, error)); ok {
+		return rf(kind, namespace, name)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) []sqldb.TemplateRevisionRecord); ok {
+		r0 = rf(kind, namespace, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqldb.TemplateRevisionRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(kind, namespace, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveClusterWorkflowTemplate provides a mock function with given fields: cwftmpl
+func (_m *TemplateRevisionRepo) SaveClusterWorkflowTemplate(cwftmpl *v1alpha1.ClusterWorkflowTemplate) (int64, error) {
+	ret := _m.Called(cwftmpl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveClusterWorkflowTemplate")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*v1alpha1.ClusterWorkflowTemplate) (int64, error)); ok {
+		return rf(cwftmpl)
+	}
+	if rf, ok := ret.Get(0).(func(*v1alpha1.ClusterWorkflowTemplate) int64); ok {
+		r0 = rf(cwftmpl)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(*v1alpha1.ClusterWorkflowTemplate) error); ok {
+		r1 = rf(cwftmpl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveWorkflowTemplate provides a mock function with given fields: wftmpl
+func (_m *TemplateRevisionRepo) SaveWorkflowTemplate(wftmpl *v1alpha1.WorkflowTemplate) (int64, error) {
+	ret := _m.Called(wftmpl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveWorkflowTemplate")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*v1alpha1.WorkflowTemplate) (int64, error)); ok {
+		return rf(wftmpl)
+	}
+	if rf, ok := ret.Get(0).(func(*v1alpha1.WorkflowTemplate) int64); ok {
+		r0 = rf(wftmpl)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(*v1alpha1.WorkflowTemplate) error); ok {
+		r1 = rf(wftmpl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTemplateRevisionRepo creates a new instance of TemplateRevisionRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTemplateRevisionRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TemplateRevisionRepo {
+	mock := &TemplateRevisionRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -6,6 +6,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 	labels "k8s.io/apimachinery/pkg/labels"
 
+	sqldb "github.com/argoproj/argo-workflows/v3/persist/sqldb"
+
 	time "time"
 
 	utils "github.com/argoproj/argo-workflows/v3/server/utils"
@@ -178,6 +180,36 @@ func (_m *WorkflowArchive) IsEnabled() bool {
 	return r0
 }
 
+// ListArtifactLineage provides a mock function with given fields: namespace, key
+func (_m *WorkflowArchive) ListArtifactLineage(namespace string, key string) ([]sqldb.ArtifactLineageRecord, error) {
+	ret := _m.Called(namespace, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListArtifactLineage")
+	}
+
+	var r0 []sqldb.ArtifactLineageRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]sqldb.ArtifactLineageRecord, error)); ok {
+		return rf(namespace, key)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []sqldb.ArtifactLineageRecord); ok {
+		r0 = rf(namespace, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqldb.ArtifactLineageRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(namespace, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListWorkflows provides a mock function with given fields: options
 func (_m *WorkflowArchive) ListWorkflows(options utils.ListOptions) (v1alpha1.Workflows, error) {
 	ret := _m.Called(options)
@@ -208,6 +240,36 @@ func (_m *WorkflowArchive) ListWorkflows(options utils.ListOptions) (v1alpha1.Wo
 	return r0, r1
 }
 
+// ListWorkflowsFinishedBefore provides a mock function with given fields: finishedBefore, limit, offset
+func (_m *WorkflowArchive) ListWorkflowsFinishedBefore(finishedBefore time.Time, limit int, offset int) (v1alpha1.Workflows, error) {
+	ret := _m.Called(finishedBefore, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkflowsFinishedBefore")
+	}
+
+	var r0 v1alpha1.Workflows
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, int, int) (v1alpha1.Workflows, error)); ok {
+		return rf(finishedBefore, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, int, int) v1alpha1.Workflows); ok {
+		r0 = rf(finishedBefore, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(v1alpha1.Workflows)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, int, int) error); ok {
+		r1 = rf(finishedBefore, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListWorkflowsLabelKeys provides a mock function with given fields:
 func (_m *WorkflowArchive) ListWorkflowsLabelKeys() (*v1alpha1.LabelKeys, error) {
 	ret := _m.Called()
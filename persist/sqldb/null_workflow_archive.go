@@ -38,6 +38,10 @@ func (r *nullWorkflowArchive) GetWorkflowForEstimator(namespace string, requirem
 	return nil, fmt.Errorf("getting archived workflow for estimator not supported")
 }
 
+func (r *nullWorkflowArchive) ListWorkflowsFinishedBefore(time.Time, int, int) (wfv1.Workflows, error) {
+	return wfv1.Workflows{}, nil
+}
+
 func (r *nullWorkflowArchive) DeleteWorkflow(string) error {
 	return fmt.Errorf("deleting archived workflows not supported")
 }
@@ -53,3 +57,7 @@ func (r *nullWorkflowArchive) ListWorkflowsLabelKeys() (*wfv1.LabelKeys, error)
 func (r *nullWorkflowArchive) ListWorkflowsLabelValues(string) (*wfv1.LabelValues, error) {
 	return &wfv1.LabelValues{}, nil
 }
+
+func (r *nullWorkflowArchive) ListArtifactLineage(string, string) ([]ArtifactLineageRecord, error) {
+	return []ArtifactLineageRecord{}, nil
+}
@@ -0,0 +1,267 @@
+package sqldb
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/upper/db/v4"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+)
+
+const (
+	syncLimitTableName      = "argo_sync_limit"
+	syncStateTableName      = "argo_sync_state"
+	syncControllerTableName = "argo_sync_controller"
+	syncLockTableName       = "argo_sync_lock"
+)
+
+// SyncStateRecord is a workflow holding, or waiting for, a database lock
+type SyncStateRecord struct {
+	Name         string    `db:"name"`
+	Key          string    `db:"workflowkey"`
+	Controller   string    `db:"controller"`
+	Held         bool      `db:"held"`
+	Priority     int32     `db:"priority"`
+	CreationTime time.Time `db:"creationtime"`
+}
+
+type syncLimitRecord struct {
+	Name      string `db:"name"`
+	SizeLimit int    `db:"sizelimit"`
+}
+
+type syncControllerRecord struct {
+	Controller string    `db:"controller"`
+	Heartbeat  time.Time `db:"heartbeat"`
+}
+
+type syncLockRecord struct {
+	Name string `db:"name"`
+}
+
+// SyncRepo holds the state of semaphores and mutexes shared between controllers.
+// Records belonging to controllers that have not sent a heartbeat since a given time are considered expired.
+type SyncRepo interface {
+	// GetLimit returns the size of the named semaphore
+	GetLimit(name string) (int, error)
+	// Heartbeat records that the controller is still alive
+	Heartbeat(controller string) error
+	// ListStates returns the workflows holding, or waiting for, the named lock, from controllers alive since the given time
+	ListStates(name string, since time.Time) ([]SyncStateRecord, error)
+	// AddPending records that the workflow is waiting for the lock, unless it is already holding or waiting for it
+	AddPending(record SyncStateRecord) error
+	// RemovePending removes the workflow from the lock's queue
+	RemovePending(name, key, controller string) error
+	// Acquire atomically gives the lock to the workflow if there are fewer than limit holders alive since the given time
+	Acquire(name, key, controller string, limit int, since time.Time) (bool, error)
+	// Release releases the lock held by the workflow
+	Release(name, key, controller string) error
+	// DeleteExpired removes all records of controllers that have not sent a heartbeat since the given time
+	DeleteExpired(since time.Time) error
+}
+
+type syncRepo struct {
+	session db.Session
+}
+
+// NewSyncRepo returns a new SyncRepo
+func NewSyncRepo(session db.Session) SyncRepo {
+	return &syncRepo{session: session}
+}
+
+func (r *syncRepo) GetLimit(name string) (int, error) {
+	record := &syncLimitRecord{}
+	err := r.session.SQL().
+		Select("name", "sizelimit").
+		From(syncLimitTableName).
+		Where(db.Cond{"name": name}).
+		One(record)
+	if err == db.ErrNoMoreRows {
+		return 0, errors.New(errors.CodeBadRequest, fmt.Sprintf("Semaphore '%s' not found in table %s", name, syncLimitTableName))
+	}
+	if err != nil {
+		return 0, err
+	}
+	return record.SizeLimit, nil
+}
+
+func (r *syncRepo) Heartbeat(controller string) error {
+	now := time.Now().UTC()
+	res, err := r.session.SQL().
+		Update(syncControllerTableName).
+		Set("heartbeat", now).
+		Where(db.Cond{"controller": controller}).
+		Exec()
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		_, err = r.session.Collection(syncControllerTableName).
+			Insert(&syncControllerRecord{Controller: controller, Heartbeat: now})
+	}
+	return err
+}
+
+func (r *syncRepo) ListStates(name string, since time.Time) ([]SyncStateRecord, error) {
+	return listSyncStates(r.session, name, since)
+}
+
+func listSyncStates(session db.Session, name string, since time.Time) ([]SyncStateRecord, error) {
+	var records []SyncStateRecord
+	err := session.SQL().
+		Select("s.name", "s.workflowkey", "s.controller", "s.held", "s.priority", "s.creationtime").
+		From(syncStateTableName + " s").
+		Join(syncControllerTableName + " c").On("s.controller = c.controller").
+		Where(db.Cond{"s.name": name}).
+		And(db.Cond{"c.heartbeat >": since.UTC()}).
+		All(&records)
+	return records, err
+}
+
+func (r *syncRepo) AddPending(record SyncStateRecord) error {
+	exists, err := r.session.Collection(syncStateTableName).
+		Find(db.Cond{"name": record.Name}).
+		And(db.Cond{"workflowkey": record.Key}).
+		And(db.Cond{"controller": record.Controller}).
+		Exists()
+	if err != nil || exists {
+		return err
+	}
+	record.Held = false
+	record.CreationTime = record.CreationTime.UTC()
+	_, err = r.session.Collection(syncStateTableName).Insert(&record)
+	return err
+}
+
+func (r *syncRepo) RemovePending(name, key, controller string) error {
+	return r.deleteState(name, key, controller, false)
+}
+
+func (r *syncRepo) Release(name, key, controller string) error {
+	return r.deleteState(name, key, controller, true)
+}
+
+func (r *syncRepo) deleteState(name, key, controller string, held bool) error {
+	_, err := r.session.SQL().
+		DeleteFrom(syncStateTableName).
+		Where(db.Cond{"name": name}).
+		And(db.Cond{"workflowkey": key}).
+		And(db.Cond{"controller": controller}).
+		And(db.Cond{"held": held}).
+		Exec()
+	return err
+}
+
+// ensureLock creates the row that controllers lock on while acquiring the named lock
+func (r *syncRepo) ensureLock(name string) error {
+	collection := r.session.Collection(syncLockTableName)
+	exists, err := collection.Find(db.Cond{"name": name}).Exists()
+	if err != nil || exists {
+		return err
+	}
+	_, err = collection.Insert(&syncLockRecord{Name: name})
+	if err != nil {
+		// another controller may have created it at the same time
+		if exists, _ := collection.Find(db.Cond{"name": name}).Exists(); exists {
+			return nil
+		}
+	}
+	return err
+}
+
+func (r *syncRepo) Acquire(name, key, controller string, limit int, since time.Time) (bool, error) {
+	if err := r.ensureLock(name); err != nil {
+		return false, err
+	}
+	acquired := false
+	err := r.session.Tx(func(sess db.Session) error {
+		// serialize acquisition of this lock between controllers
+		err := sess.SQL().
+			Select("name").
+			From(syncLockTableName).
+			Where(db.Cond{"name": name}).
+			Amend(func(query string) string { return query + " for update" }).
+			One(&syncLockRecord{})
+		if err != nil {
+			return err
+		}
+		records, err := listSyncStates(sess, name, since)
+		if err != nil {
+			return err
+		}
+		holders := 0
+		for _, record := range records {
+			if !record.Held {
+				continue
+			}
+			if record.Key == key && record.Controller == controller {
+				acquired = true
+				return nil
+			}
+			holders++
+		}
+		if holders >= limit {
+			return nil
+		}
+		res, err := sess.SQL().
+			Update(syncStateTableName).
+			Set("held", true).
+			Where(db.Cond{"name": name}).
+			And(db.Cond{"workflowkey": key}).
+			And(db.Cond{"controller": controller}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			_, err = sess.Collection(syncStateTableName).
+				Insert(&SyncStateRecord{Name: name, Key: key, Controller: controller, Held: true, CreationTime: time.Now().UTC()})
+			if err != nil {
+				return err
+			}
+		}
+		acquired = true
+		return nil
+	})
+	return acquired, err
+}
+
+func (r *syncRepo) DeleteExpired(since time.Time) error {
+	var expired []syncControllerRecord
+	err := r.session.SQL().
+		Select("controller", "heartbeat").
+		From(syncControllerTableName).
+		Where(db.Cond{"heartbeat <": since.UTC()}).
+		All(&expired)
+	if err != nil || len(expired) == 0 {
+		return err
+	}
+	var controllers []string
+	for _, record := range expired {
+		controllers = append(controllers, record.Controller)
+	}
+	return r.session.Tx(func(sess db.Session) error {
+		_, err := sess.SQL().
+			DeleteFrom(syncStateTableName).
+			Where(db.Cond{"controller IN": controllers}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		_, err = sess.SQL().
+			DeleteFrom(syncControllerTableName).
+			Where(db.Cond{"controller IN": controllers}).
+			And(db.Cond{"heartbeat <": since.UTC()}).
+			Exec()
+		return err
+	})
+}
@@ -0,0 +1,159 @@
+package sqldb
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/upper/db/v4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const templateRevisionTableName = "argo_template_revisions"
+
+// TemplateRevisionRecord is an immutable revision of a workflow template or cluster workflow template, held as JSON.
+// Cluster workflow templates have an empty namespace.
+type TemplateRevisionRecord struct {
+	Kind         string    `db:"kind"`
+	Namespace    string    `db:"namespace"`
+	Name         string    `db:"name"`
+	Revision     int64     `db:"revision"`
+	Template     string    `db:"template"`
+	CreationTime time.Time `db:"creationtime"`
+}
+
+//go:generate mockery --name=TemplateRevisionRepo
+
+// TemplateRevisionRepo holds revisions of workflow templates and cluster workflow templates in the persistence database
+type TemplateRevisionRepo interface {
+	// SaveWorkflowTemplate records the template as its next revision, unless its latest revision is already of this
+	// generation of the template, and returns the latest revision
+	SaveWorkflowTemplate(wftmpl *wfv1.WorkflowTemplate) (int64, error)
+	// SaveClusterWorkflowTemplate records the template as its next revision, unless its latest revision is already of
+	// this generation of the template, and returns the latest revision
+	SaveClusterWorkflowTemplate(cwftmpl *wfv1.ClusterWorkflowTemplate) (int64, error)
+	// GetWorkflowTemplate returns the revision of the template, or a not found error if there is none
+	GetWorkflowTemplate(namespace, name string, revision int64) (*wfv1.WorkflowTemplate, error)
+	// GetClusterWorkflowTemplate returns the revision of the template, or a not found error if there is none
+	GetClusterWorkflowTemplate(name string, revision int64) (*wfv1.ClusterWorkflowTemplate, error)
+	// ListRevisions returns the revisions of the template, newest first, without the templates themselves
+	ListRevisions(kind, namespace, name string) ([]TemplateRevisionRecord, error)
+}
+
+type templateRevisionRepo struct {
+	session db.Session
+}
+
+// NewTemplateRevisionRepo returns a new TemplateRevisionRepo
+func NewTemplateRevisionRepo(session db.Session) TemplateRevisionRepo {
+	return &templateRevisionRepo{session: session}
+}
+
+func (r *templateRevisionRepo) SaveWorkflowTemplate(wftmpl *wfv1.WorkflowTemplate) (int64, error) {
+	return r.save(workflow.WorkflowTemplateKind, wftmpl.ObjectMeta, wftmpl)
+}
+
+func (r *templateRevisionRepo) SaveClusterWorkflowTemplate(cwftmpl *wfv1.ClusterWorkflowTemplate) (int64, error) {
+	return r.save(workflow.ClusterWorkflowTemplateKind, cwftmpl.ObjectMeta, cwftmpl)
+}
+
+func (r *templateRevisionRepo) save(kind string, meta metav1.ObjectMeta, template interface{}) (int64, error) {
+	data, err := json.Marshal(template)
+	if err != nil {
+		return 0, err
+	}
+	var revision int64
+	err = r.session.Tx(func(sess db.Session) error {
+		latest := &TemplateRevisionRecord{}
+		err := sess.SQL().
+			Select("revision", "template").
+			From(templateRevisionTableName).
+			Where(db.Cond{"kind": kind}).
+			And(db.Cond{"namespace": meta.Namespace}).
+			And(db.Cond{"name": meta.Name}).
+			OrderBy("-revision").
+			Limit(1).
+			One(latest)
+		switch {
+		case err == db.ErrNoMoreRows:
+		case err != nil:
+			return err
+		default:
+			latestMeta := &struct {
+				metav1.ObjectMeta `json:"metadata"`
+			}{}
+			if err := json.Unmarshal([]byte(latest.Template), latestMeta); err != nil {
+				return err
+			}
+			// the template is recorded each time it is seen, so it may already be
+			if latestMeta.UID == meta.UID && latestMeta.Generation == meta.Generation {
+				revision = latest.Revision
+				return nil
+			}
+		}
+		revision = latest.Revision + 1
+		_, err = sess.Collection(templateRevisionTableName).Insert(&TemplateRevisionRecord{
+			Kind:         kind,
+			Namespace:    meta.Namespace,
+			Name:         meta.Name,
+			Revision:     revision,
+			Template:     string(data),
+			CreationTime: time.Now().UTC(),
+		})
+		return err
+	})
+	return revision, err
+}
+
+func (r *templateRevisionRepo) GetWorkflowTemplate(namespace, name string, revision int64) (*wfv1.WorkflowTemplate, error) {
+	wftmpl := &wfv1.WorkflowTemplate{}
+	err := r.get(workflow.WorkflowTemplateKind, namespace, name, revision, wftmpl)
+	if err != nil {
+		return nil, err
+	}
+	return wftmpl, nil
+}
+
+func (r *templateRevisionRepo) GetClusterWorkflowTemplate(name string, revision int64) (*wfv1.ClusterWorkflowTemplate, error) {
+	cwftmpl := &wfv1.ClusterWorkflowTemplate{}
+	err := r.get(workflow.ClusterWorkflowTemplateKind, "", name, revision, cwftmpl)
+	if err != nil {
+		return nil, err
+	}
+	return cwftmpl, nil
+}
+
+func (r *templateRevisionRepo) get(kind, namespace, name string, revision int64, template interface{}) error {
+	record := &TemplateRevisionRecord{}
+	err := r.session.SQL().
+		Select("template").
+		From(templateRevisionTableName).
+		Where(db.Cond{"kind": kind}).
+		And(db.Cond{"namespace": namespace}).
+		And(db.Cond{"name": name}).
+		And(db.Cond{"revision": revision}).
+		One(record)
+	if err == db.ErrNoMoreRows {
+		return errors.Errorf(errors.CodeNotFound, "revision %d of %s %s not found", revision, kind, name)
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(record.Template), template)
+}
+
+func (r *templateRevisionRepo) ListRevisions(kind, namespace, name string) ([]TemplateRevisionRecord, error) {
+	var records []TemplateRevisionRecord
+	err := r.session.SQL().
+		Select("kind", "namespace", "name", "revision", "creationtime").
+		From(templateRevisionTableName).
+		Where(db.Cond{"kind": kind}).
+		And(db.Cond{"namespace": namespace}).
+		And(db.Cond{"name": name}).
+		OrderBy("-revision").
+		All(&records)
+	return records, err
+}
@@ -71,11 +71,14 @@ type WorkflowArchive interface {
 	CountWorkflows(options sutils.ListOptions) (int64, error)
 	GetWorkflow(uid string, namespace string, name string) (*wfv1.Workflow, error)
 	GetWorkflowForEstimator(namespace string, requirements []labels.Requirement) (*wfv1.Workflow, error)
+	// list workflows, including their status, which finished before the time, with the earliest finished workflows at the beginning
+	ListWorkflowsFinishedBefore(finishedBefore time.Time, limit, offset int) (wfv1.Workflows, error)
 	DeleteWorkflow(uid string) error
 	DeleteExpiredWorkflows(ttl time.Duration) error
 	IsEnabled() bool
 	ListWorkflowsLabelKeys() (*wfv1.LabelKeys, error)
 	ListWorkflowsLabelValues(key string) (*wfv1.LabelValues, error)
+	ListArtifactLineage(namespace, key string) ([]ArtifactLineageRecord, error)
 }
 
 type workflowArchive struct {
@@ -151,6 +154,22 @@ func (r *workflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
 				return err
 			}
 		}
+
+		_, err = sess.SQL().
+			DeleteFrom(archiveArtifactsTableName).
+			Where(db.Cond{"clustername": r.clusterName}).
+			And(db.Cond{"uid": wf.UID}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		// insert the artifacts produced and consumed by the nodes, for their lineage
+		for _, record := range artifactRecords(r.clusterName, wf) {
+			_, err := sess.Collection(archiveArtifactsTableName).Insert(&record)
+			if err != nil {
+				return err
+			}
+		}
 		return nil
 	})
 }
@@ -396,6 +415,31 @@ func (r *workflowArchive) GetWorkflow(uid string, namespace string, name string)
 	return wf, nil
 }
 
+func (r *workflowArchive) ListWorkflowsFinishedBefore(finishedBefore time.Time, limit, offset int) (wfv1.Workflows, error) {
+	var archivedWfs []archivedWorkflowRecord
+	err := r.session.SQL().
+		Select("workflow").
+		From(archiveTableName).
+		Where(r.clusterManagedNamespaceAndInstanceID()).
+		And(db.Raw("finishedat < ?", finishedBefore)).
+		OrderBy("finishedat").
+		Limit(limit).
+		Offset(offset).
+		All(&archivedWfs)
+	if err != nil {
+		return nil, err
+	}
+	wfs := make(wfv1.Workflows, len(archivedWfs))
+	for i, archivedWf := range archivedWfs {
+		if err := json.Unmarshal([]byte(archivedWf.Workflow), &wfs[i]); err != nil {
+			return nil, err
+		}
+		// For backward compatibility, we should label workflow retrieved from DB as Persisted.
+		wfs[i].Labels[common.LabelKeyWorkflowArchivingStatus] = "Persisted"
+	}
+	return wfs, nil
+}
+
 func (r *workflowArchive) GetWorkflowForEstimator(namespace string, requirements []labels.Requirement) (*wfv1.Workflow, error) {
 	selector := r.session.SQL().
 		Select("name", "namespace", "uid", "startedat", "finishedat").
@@ -0,0 +1,31 @@
+package http1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+)
+
+type MemoizationCacheServiceClient = Facade
+
+func (h MemoizationCacheServiceClient) ListMemoizationCacheEntries(ctx context.Context, in *memoizationcachepkg.ListMemoizationCacheEntriesRequest, _ ...grpc.CallOption) (*memoizationcachepkg.MemoizationCacheEntryList, error) {
+	out := &memoizationcachepkg.MemoizationCacheEntryList{}
+	return out, h.Get(ctx, in, out, "/api/v1/memoization-caches/{namespace}/{name}")
+}
+
+func (h MemoizationCacheServiceClient) GetMemoizationCacheEntry(ctx context.Context, in *memoizationcachepkg.GetMemoizationCacheEntryRequest, _ ...grpc.CallOption) (*memoizationcachepkg.MemoizationCacheEntry, error) {
+	out := &memoizationcachepkg.MemoizationCacheEntry{}
+	return out, h.Get(ctx, in, out, "/api/v1/memoization-caches/{namespace}/{name}/{key}")
+}
+
+func (h MemoizationCacheServiceClient) DeleteMemoizationCacheEntry(ctx context.Context, in *memoizationcachepkg.DeleteMemoizationCacheEntryRequest, _ ...grpc.CallOption) (*memoizationcachepkg.MemoizationCacheEntryDeletedResponse, error) {
+	out := &memoizationcachepkg.MemoizationCacheEntryDeletedResponse{}
+	return out, h.Delete(ctx, in, out, "/api/v1/memoization-caches/{namespace}/{name}/{key}")
+}
+
+func (h MemoizationCacheServiceClient) PruneMemoizationCache(ctx context.Context, in *memoizationcachepkg.PruneMemoizationCacheRequest, _ ...grpc.CallOption) (*memoizationcachepkg.MemoizationCachePrunedResponse, error) {
+	out := &memoizationcachepkg.MemoizationCachePrunedResponse{}
+	return out, h.Delete(ctx, in, out, "/api/v1/memoization-caches/{namespace}/{name}")
+}
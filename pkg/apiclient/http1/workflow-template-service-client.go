@@ -40,3 +40,13 @@ func (h WorkflowTemplateServiceClient) LintWorkflowTemplate(ctx context.Context,
 	out := &wfv1.WorkflowTemplate{}
 	return out, h.Post(ctx, in, out, "/api/v1/workflow-templates/{namespace}/lint")
 }
+
+func (h WorkflowTemplateServiceClient) ListWorkflowTemplateRevisions(ctx context.Context, in *workflowtemplatepkg.WorkflowTemplateRevisionsRequest, _ ...grpc.CallOption) (*workflowtemplatepkg.WorkflowTemplateRevisionList, error) {
+	out := &workflowtemplatepkg.WorkflowTemplateRevisionList{}
+	return out, h.Get(ctx, in, out, "/api/v1/workflow-templates/{namespace}/{name}/revisions")
+}
+
+func (h WorkflowTemplateServiceClient) RollbackWorkflowTemplate(ctx context.Context, in *workflowtemplatepkg.WorkflowTemplateRollbackRequest, _ ...grpc.CallOption) (*wfv1.WorkflowTemplate, error) {
+	out := &wfv1.WorkflowTemplate{}
+	return out, h.Put(ctx, in, out, "/api/v1/workflow-templates/{namespace}/{name}/rollback")
+}
@@ -0,0 +1,2581 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pkg/apiclient/memoizationcache/memoization-cache.proto
+
+package memoizationcache
+
+import (
+	context "context"
+	fmt "fmt"
+	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MemoizationCacheEntry struct {
+	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	NodeID               string            `protobuf:"bytes,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Outputs              *v1alpha1.Outputs `protobuf:"bytes,3,opt,name=outputs,proto3" json:"outputs,omitempty"`
+	CreationTimestamp    *v1.Time          `protobuf:"bytes,4,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
+	LastHitTimestamp     *v1.Time          `protobuf:"bytes,5,opt,name=lastHitTimestamp,proto3" json:"lastHitTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MemoizationCacheEntry) Reset()         { *m = MemoizationCacheEntry{} }
+func (m *MemoizationCacheEntry) String() string { return proto.CompactTextString(m) }
+func (*MemoizationCacheEntry) ProtoMessage()    {}
+func (*MemoizationCacheEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{0}
+}
+func (m *MemoizationCacheEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemoizationCacheEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemoizationCacheEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemoizationCacheEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemoizationCacheEntry.Merge(m, src)
+}
+func (m *MemoizationCacheEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemoizationCacheEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemoizationCacheEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemoizationCacheEntry proto.InternalMessageInfo
+
+func (m *MemoizationCacheEntry) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *MemoizationCacheEntry) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+func (m *MemoizationCacheEntry) GetOutputs() *v1alpha1.Outputs {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *MemoizationCacheEntry) GetCreationTimestamp() *v1.Time {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return nil
+}
+
+func (m *MemoizationCacheEntry) GetLastHitTimestamp() *v1.Time {
+	if m != nil {
+		return m.LastHitTimestamp
+	}
+	return nil
+}
+
+type MemoizationCacheEntryList struct {
+	Items                []*MemoizationCacheEntry `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *MemoizationCacheEntryList) Reset()         { *m = MemoizationCacheEntryList{} }
+func (m *MemoizationCacheEntryList) String() string { return proto.CompactTextString(m) }
+func (*MemoizationCacheEntryList) ProtoMessage()    {}
+func (*MemoizationCacheEntryList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{1}
+}
+func (m *MemoizationCacheEntryList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemoizationCacheEntryList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemoizationCacheEntryList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemoizationCacheEntryList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemoizationCacheEntryList.Merge(m, src)
+}
+func (m *MemoizationCacheEntryList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemoizationCacheEntryList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemoizationCacheEntryList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemoizationCacheEntryList proto.InternalMessageInfo
+
+func (m *MemoizationCacheEntryList) GetItems() []*MemoizationCacheEntry {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListMemoizationCacheEntriesRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// ConfigMap (default), SQL, or Artifact
+	CacheType            string   `protobuf:"bytes,3,opt,name=cacheType,proto3" json:"cacheType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListMemoizationCacheEntriesRequest) Reset()         { *m = ListMemoizationCacheEntriesRequest{} }
+func (m *ListMemoizationCacheEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMemoizationCacheEntriesRequest) ProtoMessage()    {}
+func (*ListMemoizationCacheEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{2}
+}
+func (m *ListMemoizationCacheEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListMemoizationCacheEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListMemoizationCacheEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListMemoizationCacheEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMemoizationCacheEntriesRequest.Merge(m, src)
+}
+func (m *ListMemoizationCacheEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListMemoizationCacheEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMemoizationCacheEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMemoizationCacheEntriesRequest proto.InternalMessageInfo
+
+func (m *ListMemoizationCacheEntriesRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ListMemoizationCacheEntriesRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListMemoizationCacheEntriesRequest) GetCacheType() string {
+	if m != nil {
+		return m.CacheType
+	}
+	return ""
+}
+
+type GetMemoizationCacheEntryRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Key                  string   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	CacheType            string   `protobuf:"bytes,4,opt,name=cacheType,proto3" json:"cacheType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMemoizationCacheEntryRequest) Reset()         { *m = GetMemoizationCacheEntryRequest{} }
+func (m *GetMemoizationCacheEntryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMemoizationCacheEntryRequest) ProtoMessage()    {}
+func (*GetMemoizationCacheEntryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{3}
+}
+func (m *GetMemoizationCacheEntryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMemoizationCacheEntryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMemoizationCacheEntryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetMemoizationCacheEntryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMemoizationCacheEntryRequest.Merge(m, src)
+}
+func (m *GetMemoizationCacheEntryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetMemoizationCacheEntryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMemoizationCacheEntryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMemoizationCacheEntryRequest proto.InternalMessageInfo
+
+func (m *GetMemoizationCacheEntryRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetMemoizationCacheEntryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetMemoizationCacheEntryRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetMemoizationCacheEntryRequest) GetCacheType() string {
+	if m != nil {
+		return m.CacheType
+	}
+	return ""
+}
+
+type DeleteMemoizationCacheEntryRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Key                  string   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	CacheType            string   `protobuf:"bytes,4,opt,name=cacheType,proto3" json:"cacheType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteMemoizationCacheEntryRequest) Reset()         { *m = DeleteMemoizationCacheEntryRequest{} }
+func (m *DeleteMemoizationCacheEntryRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteMemoizationCacheEntryRequest) ProtoMessage()    {}
+func (*DeleteMemoizationCacheEntryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{4}
+}
+func (m *DeleteMemoizationCacheEntryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteMemoizationCacheEntryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteMemoizationCacheEntryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteMemoizationCacheEntryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMemoizationCacheEntryRequest.Merge(m, src)
+}
+func (m *DeleteMemoizationCacheEntryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteMemoizationCacheEntryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMemoizationCacheEntryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteMemoizationCacheEntryRequest proto.InternalMessageInfo
+
+func (m *DeleteMemoizationCacheEntryRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DeleteMemoizationCacheEntryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeleteMemoizationCacheEntryRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *DeleteMemoizationCacheEntryRequest) GetCacheType() string {
+	if m != nil {
+		return m.CacheType
+	}
+	return ""
+}
+
+type MemoizationCacheEntryDeletedResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MemoizationCacheEntryDeletedResponse) Reset()         { *m = MemoizationCacheEntryDeletedResponse{} }
+func (m *MemoizationCacheEntryDeletedResponse) String() string { return proto.CompactTextString(m) }
+func (*MemoizationCacheEntryDeletedResponse) ProtoMessage()    {}
+func (*MemoizationCacheEntryDeletedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{5}
+}
+func (m *MemoizationCacheEntryDeletedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemoizationCacheEntryDeletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemoizationCacheEntryDeletedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemoizationCacheEntryDeletedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemoizationCacheEntryDeletedResponse.Merge(m, src)
+}
+func (m *MemoizationCacheEntryDeletedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemoizationCacheEntryDeletedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemoizationCacheEntryDeletedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemoizationCacheEntryDeletedResponse proto.InternalMessageInfo
+
+type PruneMemoizationCacheRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CacheType string `protobuf:"bytes,3,opt,name=cacheType,proto3" json:"cacheType,omitempty"`
+	// entries created longer ago than this duration, e.g. "24h", are deleted
+	OlderThan            string   `protobuf:"bytes,4,opt,name=olderThan,proto3" json:"olderThan,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PruneMemoizationCacheRequest) Reset()         { *m = PruneMemoizationCacheRequest{} }
+func (m *PruneMemoizationCacheRequest) String() string { return proto.CompactTextString(m) }
+func (*PruneMemoizationCacheRequest) ProtoMessage()    {}
+func (*PruneMemoizationCacheRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{6}
+}
+func (m *PruneMemoizationCacheRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PruneMemoizationCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PruneMemoizationCacheRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PruneMemoizationCacheRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruneMemoizationCacheRequest.Merge(m, src)
+}
+func (m *PruneMemoizationCacheRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PruneMemoizationCacheRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruneMemoizationCacheRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruneMemoizationCacheRequest proto.InternalMessageInfo
+
+func (m *PruneMemoizationCacheRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *PruneMemoizationCacheRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PruneMemoizationCacheRequest) GetCacheType() string {
+	if m != nil {
+		return m.CacheType
+	}
+	return ""
+}
+
+func (m *PruneMemoizationCacheRequest) GetOlderThan() string {
+	if m != nil {
+		return m.OlderThan
+	}
+	return ""
+}
+
+type MemoizationCachePrunedResponse struct {
+	Keys                 []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MemoizationCachePrunedResponse) Reset()         { *m = MemoizationCachePrunedResponse{} }
+func (m *MemoizationCachePrunedResponse) String() string { return proto.CompactTextString(m) }
+func (*MemoizationCachePrunedResponse) ProtoMessage()    {}
+func (*MemoizationCachePrunedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{7}
+}
+func (m *MemoizationCachePrunedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemoizationCachePrunedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemoizationCachePrunedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemoizationCachePrunedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemoizationCachePrunedResponse.Merge(m, src)
+}
+func (m *MemoizationCachePrunedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemoizationCachePrunedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemoizationCachePrunedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemoizationCachePrunedResponse proto.InternalMessageInfo
+
+func (m *MemoizationCachePrunedResponse) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MemoizationCacheEntry)(nil), "memoizationcache.MemoizationCacheEntry")
+	proto.RegisterType((*MemoizationCacheEntryList)(nil), "memoizationcache.MemoizationCacheEntryList")
+	proto.RegisterType((*ListMemoizationCacheEntriesRequest)(nil), "memoizationcache.ListMemoizationCacheEntriesRequest")
+	proto.RegisterType((*GetMemoizationCacheEntryRequest)(nil), "memoizationcache.GetMemoizationCacheEntryRequest")
+	proto.RegisterType((*DeleteMemoizationCacheEntryRequest)(nil), "memoizationcache.DeleteMemoizationCacheEntryRequest")
+	proto.RegisterType((*MemoizationCacheEntryDeletedResponse)(nil), "memoizationcache.MemoizationCacheEntryDeletedResponse")
+	proto.RegisterType((*PruneMemoizationCacheRequest)(nil), "memoizationcache.PruneMemoizationCacheRequest")
+	proto.RegisterType((*MemoizationCachePrunedResponse)(nil), "memoizationcache.MemoizationCachePrunedResponse")
+}
+
+func init() {
+	proto.RegisterFile("pkg/apiclient/memoizationcache/memoization-cache.proto", fileDescriptor_c9acfa579c248340)
+}
+
+var fileDescriptor_c9acfa579c248340 = []byte{
+	// 693 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0xcd, 0x6a, 0x14, 0x4b,
+	0x14, 0xc7, 0xa9, 0x4c, 0x92, 0xcb, 0x54, 0x36, 0xb9, 0x05, 0xb9, 0x77, 0xee, 0x24, 0xcc, 0x0d,
+	0x8d, 0x68, 0x88, 0xa4, 0xda, 0xc9, 0x17, 0x82, 0xb8, 0x50, 0x23, 0x1a, 0x30, 0x44, 0xda, 0x20,
+	0x92, 0x8d, 0x54, 0x7a, 0x8e, 0x3d, 0x6d, 0x7f, 0x54, 0xdb, 0x55, 0x33, 0x61, 0x0c, 0xd9, 0x28,
+	0xe8, 0xc6, 0x9d, 0x2f, 0x22, 0xb8, 0xf0, 0x15, 0xb2, 0x14, 0xdc, 0xb8, 0x94, 0xe0, 0x83, 0x48,
+	0x55, 0x77, 0x4f, 0x27, 0xd3, 0x9d, 0xcc, 0x24, 0x20, 0xee, 0xce, 0x9c, 0xaa, 0xf3, 0xaf, 0x5f,
+	0x9f, 0xfa, 0x9f, 0xee, 0xc1, 0xeb, 0x91, 0xe7, 0x98, 0x2c, 0x72, 0x6d, 0xdf, 0x85, 0x50, 0x9a,
+	0x01, 0x04, 0xdc, 0x7d, 0xcd, 0xa4, 0xcb, 0x43, 0x9b, 0xd9, 0x6d, 0x38, 0x99, 0x58, 0xd2, 0x19,
+	0x1a, 0xc5, 0x5c, 0x72, 0x32, 0x3d, 0xb8, 0xb3, 0x3e, 0xe7, 0x70, 0xee, 0xf8, 0xa0, 0xc4, 0x4c,
+	0x16, 0x86, 0x5c, 0xea, 0x35, 0x91, 0xec, 0xaf, 0xaf, 0x7a, 0x37, 0x05, 0x75, 0xb9, 0x5a, 0x0d,
+	0x98, 0xdd, 0x76, 0x43, 0x88, 0x7b, 0x66, 0x7a, 0xb6, 0x30, 0x03, 0x90, 0xcc, 0xec, 0x36, 0x4d,
+	0x07, 0x42, 0x88, 0x99, 0x84, 0x56, 0x5a, 0xb5, 0xe5, 0xb8, 0xb2, 0xdd, 0xd9, 0xa3, 0x36, 0x0f,
+	0x4c, 0x16, 0x3b, 0x3c, 0x8a, 0xf9, 0x4b, 0x1d, 0x2c, 0xed, 0xf3, 0xd8, 0x7b, 0xe1, 0xf3, 0x7d,
+	0x91, 0x8b, 0x64, 0x29, 0xb3, 0xdb, 0x64, 0x7e, 0xd4, 0x66, 0x05, 0x39, 0xe3, 0xfb, 0x18, 0x9e,
+	0xd9, 0xca, 0xb9, 0xef, 0x29, 0xee, 0xfb, 0xa1, 0x8c, 0x7b, 0x64, 0x1a, 0x57, 0x3c, 0xe8, 0xd5,
+	0xd0, 0x3c, 0x5a, 0xa8, 0x5a, 0x2a, 0x24, 0xff, 0xe0, 0xc9, 0x90, 0xb7, 0x60, 0x73, 0xa3, 0x36,
+	0xa6, 0x93, 0xe9, 0x2f, 0x62, 0xe3, 0xbf, 0x78, 0x47, 0x46, 0x1d, 0x29, 0x6a, 0x95, 0x79, 0xb4,
+	0x30, 0xb5, 0xbc, 0x49, 0x73, 0x48, 0x9a, 0x41, 0xea, 0xe0, 0x79, 0x1f, 0x92, 0x76, 0x57, 0x68,
+	0xe4, 0x39, 0x54, 0x71, 0xd2, 0x2c, 0x4b, 0x33, 0x4e, 0xba, 0x9d, 0x08, 0x5a, 0x99, 0x32, 0x79,
+	0x86, 0xff, 0xb6, 0x63, 0xd0, 0x90, 0x3b, 0x6e, 0x00, 0x42, 0xb2, 0x20, 0xaa, 0x8d, 0xeb, 0xe3,
+	0x16, 0x69, 0xd2, 0x49, 0x7a, 0xb2, 0x93, 0xb9, 0xb8, 0xea, 0x24, 0xed, 0x36, 0xa9, 0x2a, 0xb3,
+	0x8a, 0x22, 0xe4, 0x29, 0x9e, 0xf6, 0x99, 0x90, 0x0f, 0x5d, 0x99, 0x0b, 0x4f, 0x5c, 0x58, 0xb8,
+	0xa0, 0x61, 0xec, 0xe2, 0xff, 0x4a, 0x3b, 0xfb, 0xc8, 0x15, 0x92, 0xdc, 0xc6, 0x13, 0xae, 0x84,
+	0x40, 0xd4, 0xd0, 0x7c, 0x65, 0x61, 0x6a, 0xf9, 0x1a, 0x1d, 0x34, 0x0f, 0x2d, 0xad, 0xb5, 0x92,
+	0x2a, 0x43, 0x62, 0x43, 0xc9, 0x94, 0xed, 0x71, 0x41, 0x58, 0xf0, 0xaa, 0x03, 0x42, 0x92, 0x39,
+	0x5c, 0x0d, 0x59, 0x00, 0x22, 0x62, 0x36, 0xa4, 0x17, 0x99, 0x27, 0x08, 0xc1, 0xe3, 0xea, 0x47,
+	0x7a, 0x99, 0x3a, 0x56, 0x15, 0xfa, 0xf4, 0x9d, 0x5e, 0x04, 0xfa, 0x32, 0xab, 0x56, 0x9e, 0x30,
+	0xde, 0x22, 0xfc, 0xff, 0x03, 0x90, 0xe5, 0x64, 0x97, 0x3e, 0x33, 0x35, 0x5a, 0x25, 0x37, 0xda,
+	0x29, 0x8a, 0xf1, 0x41, 0x8a, 0x77, 0x08, 0x1b, 0x1b, 0xe0, 0x83, 0x84, 0x3f, 0x0c, 0x72, 0x15,
+	0x5f, 0x29, 0x25, 0x48, 0xe0, 0x5a, 0x16, 0x88, 0x88, 0x87, 0x02, 0x8c, 0x0f, 0x08, 0xcf, 0x3d,
+	0x8e, 0x3b, 0x61, 0x81, 0xf7, 0x37, 0xdd, 0x93, 0x5a, 0xe5, 0x7e, 0x0b, 0xe2, 0x9d, 0x36, 0x0b,
+	0x33, 0xec, 0x7e, 0xc2, 0x58, 0xc5, 0x8d, 0x41, 0x10, 0x4d, 0xd7, 0x07, 0x56, 0x27, 0x7a, 0xd0,
+	0x4b, 0xbc, 0x59, 0xb5, 0x74, 0xbc, 0xfc, 0x7e, 0x12, 0xff, 0x3b, 0x58, 0xf6, 0x04, 0xe2, 0xae,
+	0x6b, 0x03, 0xf9, 0x82, 0xf0, 0xec, 0x39, 0x76, 0x24, 0xab, 0x45, 0x77, 0x0f, 0x77, 0x6f, 0xfd,
+	0xfa, 0x88, 0x33, 0xa1, 0xa4, 0x8c, 0xb5, 0x37, 0xdf, 0x7e, 0x7e, 0x1c, 0x33, 0xc9, 0x92, 0x7e,
+	0xd9, 0x76, 0x9b, 0xc5, 0xb7, 0xb4, 0x30, 0x0f, 0xfa, 0x3d, 0x3d, 0x4c, 0xe2, 0x43, 0xf2, 0x19,
+	0xe1, 0xda, 0x59, 0x8e, 0x26, 0xcd, 0x22, 0xc0, 0x10, 0xf7, 0xd7, 0x47, 0x9d, 0x63, 0xe3, 0x96,
+	0xe6, 0x5d, 0x23, 0x2b, 0x17, 0xe2, 0x35, 0x0f, 0x3c, 0xe8, 0x1d, 0x92, 0x23, 0x84, 0x67, 0xcf,
+	0x99, 0x80, 0xb2, 0x7e, 0x0f, 0x1f, 0x98, 0xfa, 0xfa, 0x88, 0xec, 0x83, 0xf6, 0x4e, 0x1f, 0x65,
+	0xf1, 0x52, 0x8f, 0xf2, 0x09, 0xe1, 0x99, 0xd2, 0xd9, 0x20, 0xb4, 0x88, 0x73, 0xde, 0x10, 0xd5,
+	0x6f, 0x0c, 0xc7, 0x3f, 0x6d, 0xf3, 0xcc, 0x33, 0x8b, 0x17, 0xf3, 0xcc, 0xdd, 0xed, 0xa3, 0xe3,
+	0x06, 0xfa, 0x7a, 0xdc, 0x40, 0x3f, 0x8e, 0x1b, 0x68, 0xf7, 0xce, 0xe8, 0xdf, 0xe3, 0x33, 0xfe,
+	0x50, 0xec, 0x4d, 0xea, 0x4f, 0xf1, 0xca, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xd1, 0x25,
+	0x59, 0x79, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MemoizationCacheServiceClient is the client API for MemoizationCacheService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MemoizationCacheServiceClient interface {
+	ListMemoizationCacheEntries(ctx context.Context, in *ListMemoizationCacheEntriesRequest, opts ...grpc.CallOption) (*MemoizationCacheEntryList, error)
+	GetMemoizationCacheEntry(ctx context.Context, in *GetMemoizationCacheEntryRequest, opts ...grpc.CallOption) (*MemoizationCacheEntry, error)
+	DeleteMemoizationCacheEntry(ctx context.Context, in *DeleteMemoizationCacheEntryRequest, opts ...grpc.CallOption) (*MemoizationCacheEntryDeletedResponse, error)
+	PruneMemoizationCache(ctx context.Context, in *PruneMemoizationCacheRequest, opts ...grpc.CallOption) (*MemoizationCachePrunedResponse, error)
+}
+
+type memoizationCacheServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMemoizationCacheServiceClient(cc *grpc.ClientConn) MemoizationCacheServiceClient {
+	return &memoizationCacheServiceClient{cc}
+}
+
+func (c *memoizationCacheServiceClient) ListMemoizationCacheEntries(ctx context.Context, in *ListMemoizationCacheEntriesRequest, opts ...grpc.CallOption) (*MemoizationCacheEntryList, error) {
+	out := new(MemoizationCacheEntryList)
+	err := c.cc.Invoke(ctx, "/memoizationcache.MemoizationCacheService/ListMemoizationCacheEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoizationCacheServiceClient) GetMemoizationCacheEntry(ctx context.Context, in *GetMemoizationCacheEntryRequest, opts ...grpc.CallOption) (*MemoizationCacheEntry, error) {
+	out := new(MemoizationCacheEntry)
+	err := c.cc.Invoke(ctx, "/memoizationcache.MemoizationCacheService/GetMemoizationCacheEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoizationCacheServiceClient) DeleteMemoizationCacheEntry(ctx context.Context, in *DeleteMemoizationCacheEntryRequest, opts ...grpc.CallOption) (*MemoizationCacheEntryDeletedResponse, error) {
+	out := new(MemoizationCacheEntryDeletedResponse)
+	err := c.cc.Invoke(ctx, "/memoizationcache.MemoizationCacheService/DeleteMemoizationCacheEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoizationCacheServiceClient) PruneMemoizationCache(ctx context.Context, in *PruneMemoizationCacheRequest, opts ...grpc.CallOption) (*MemoizationCachePrunedResponse, error) {
+	out := new(MemoizationCachePrunedResponse)
+	err := c.cc.Invoke(ctx, "/memoizationcache.MemoizationCacheService/PruneMemoizationCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MemoizationCacheServiceServer is the server API for MemoizationCacheService service.
+type MemoizationCacheServiceServer interface {
+	ListMemoizationCacheEntries(context.Context, *ListMemoizationCacheEntriesRequest) (*MemoizationCacheEntryList, error)
+	GetMemoizationCacheEntry(context.Context, *GetMemoizationCacheEntryRequest) (*MemoizationCacheEntry, error)
+	DeleteMemoizationCacheEntry(context.Context, *DeleteMemoizationCacheEntryRequest) (*MemoizationCacheEntryDeletedResponse, error)
+	PruneMemoizationCache(context.Context, *PruneMemoizationCacheRequest) (*MemoizationCachePrunedResponse, error)
+}
+
+// UnimplementedMemoizationCacheServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMemoizationCacheServiceServer struct {
+}
+
+func (*UnimplementedMemoizationCacheServiceServer) ListMemoizationCacheEntries(ctx context.Context, req *ListMemoizationCacheEntriesRequest) (*MemoizationCacheEntryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoizationCacheEntries not implemented")
+}
+func (*UnimplementedMemoizationCacheServiceServer) GetMemoizationCacheEntry(ctx context.Context, req *GetMemoizationCacheEntryRequest) (*MemoizationCacheEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoizationCacheEntry not implemented")
+}
+func (*UnimplementedMemoizationCacheServiceServer) DeleteMemoizationCacheEntry(ctx context.Context, req *DeleteMemoizationCacheEntryRequest) (*MemoizationCacheEntryDeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemoizationCacheEntry not implemented")
+}
+func (*UnimplementedMemoizationCacheServiceServer) PruneMemoizationCache(ctx context.Context, req *PruneMemoizationCacheRequest) (*MemoizationCachePrunedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneMemoizationCache not implemented")
+}
+
+func RegisterMemoizationCacheServiceServer(s *grpc.Server, srv MemoizationCacheServiceServer) {
+	s.RegisterService(&_MemoizationCacheService_serviceDesc, srv)
+}
+
+func _MemoizationCacheService_ListMemoizationCacheEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoizationCacheEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoizationCacheServiceServer).ListMemoizationCacheEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/memoizationcache.MemoizationCacheService/ListMemoizationCacheEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoizationCacheServiceServer).ListMemoizationCacheEntries(ctx, req.(*ListMemoizationCacheEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoizationCacheService_GetMemoizationCacheEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoizationCacheEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoizationCacheServiceServer).GetMemoizationCacheEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/memoizationcache.MemoizationCacheService/GetMemoizationCacheEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoizationCacheServiceServer).GetMemoizationCacheEntry(ctx, req.(*GetMemoizationCacheEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoizationCacheService_DeleteMemoizationCacheEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoizationCacheEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoizationCacheServiceServer).DeleteMemoizationCacheEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/memoizationcache.MemoizationCacheService/DeleteMemoizationCacheEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoizationCacheServiceServer).DeleteMemoizationCacheEntry(ctx, req.(*DeleteMemoizationCacheEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoizationCacheService_PruneMemoizationCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneMemoizationCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoizationCacheServiceServer).PruneMemoizationCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/memoizationcache.MemoizationCacheService/PruneMemoizationCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoizationCacheServiceServer).PruneMemoizationCache(ctx, req.(*PruneMemoizationCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MemoizationCacheService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "memoizationcache.MemoizationCacheService",
+	HandlerType: (*MemoizationCacheServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMemoizationCacheEntries",
+			Handler:    _MemoizationCacheService_ListMemoizationCacheEntries_Handler,
+		},
+		{
+			MethodName: "GetMemoizationCacheEntry",
+			Handler:    _MemoizationCacheService_GetMemoizationCacheEntry_Handler,
+		},
+		{
+			MethodName: "DeleteMemoizationCacheEntry",
+			Handler:    _MemoizationCacheService_DeleteMemoizationCacheEntry_Handler,
+		},
+		{
+			MethodName: "PruneMemoizationCache",
+			Handler:    _MemoizationCacheService_PruneMemoizationCache_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/apiclient/memoizationcache/memoization-cache.proto",
+}
+
+func (m *MemoizationCacheEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoizationCacheEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemoizationCacheEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastHitTimestamp != nil {
+		{
+			size, err := m.LastHitTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMemoizationCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CreationTimestamp != nil {
+		{
+			size, err := m.CreationTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMemoizationCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Outputs != nil {
+		{
+			size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMemoizationCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NodeID) > 0 {
+		i -= len(m.NodeID)
+		copy(dAtA[i:], m.NodeID)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.NodeID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MemoizationCacheEntryList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoizationCacheEntryList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemoizationCacheEntryList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMemoizationCache(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListMemoizationCacheEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListMemoizationCacheEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListMemoizationCacheEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CacheType) > 0 {
+		i -= len(m.CacheType)
+		copy(dAtA[i:], m.CacheType)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.CacheType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMemoizationCacheEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMemoizationCacheEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMemoizationCacheEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CacheType) > 0 {
+		i -= len(m.CacheType)
+		copy(dAtA[i:], m.CacheType)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.CacheType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteMemoizationCacheEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteMemoizationCacheEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteMemoizationCacheEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CacheType) > 0 {
+		i -= len(m.CacheType)
+		copy(dAtA[i:], m.CacheType)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.CacheType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MemoizationCacheEntryDeletedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoizationCacheEntryDeletedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemoizationCacheEntryDeletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PruneMemoizationCacheRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PruneMemoizationCacheRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PruneMemoizationCacheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.OlderThan) > 0 {
+		i -= len(m.OlderThan)
+		copy(dAtA[i:], m.OlderThan)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.OlderThan)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CacheType) > 0 {
+		i -= len(m.CacheType)
+		copy(dAtA[i:], m.CacheType)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.CacheType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MemoizationCachePrunedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoizationCachePrunedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemoizationCachePrunedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMemoizationCache(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMemoizationCache(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MemoizationCacheEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.Outputs != nil {
+		l = m.Outputs.Size()
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.CreationTimestamp != nil {
+		l = m.CreationTimestamp.Size()
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.LastHitTimestamp != nil {
+		l = m.LastHitTimestamp.Size()
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MemoizationCacheEntryList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovMemoizationCache(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListMemoizationCacheEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.CacheType)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetMemoizationCacheEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.CacheType)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteMemoizationCacheEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.CacheType)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MemoizationCacheEntryDeletedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PruneMemoizationCacheRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.CacheType)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.OlderThan)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MemoizationCachePrunedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovMemoizationCache(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMemoizationCache(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMemoizationCache(x uint64) (n int) {
+	return sovMemoizationCache(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MemoizationCacheEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoizationCacheEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoizationCacheEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Outputs == nil {
+				m.Outputs = &v1alpha1.Outputs{}
+			}
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreationTimestamp == nil {
+				m.CreationTimestamp = &v1.Time{}
+			}
+			if err := m.CreationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHitTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastHitTimestamp == nil {
+				m.LastHitTimestamp = &v1.Time{}
+			}
+			if err := m.LastHitTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoizationCacheEntryList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoizationCacheEntryList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoizationCacheEntryList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &MemoizationCacheEntry{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListMemoizationCacheEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListMemoizationCacheEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListMemoizationCacheEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMemoizationCacheEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMemoizationCacheEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMemoizationCacheEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteMemoizationCacheEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteMemoizationCacheEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteMemoizationCacheEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoizationCacheEntryDeletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoizationCacheEntryDeletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoizationCacheEntryDeletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PruneMemoizationCacheRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PruneMemoizationCacheRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PruneMemoizationCacheRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OlderThan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OlderThan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoizationCachePrunedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoizationCachePrunedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoizationCachePrunedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMemoizationCache(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMemoizationCache
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMemoizationCache
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMemoizationCache
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMemoizationCache        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMemoizationCache          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMemoizationCache = fmt.Errorf("proto: unexpected end of group")
+)
@@ -7,6 +7,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
 	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
@@ -136,6 +137,10 @@ func (c *offlineClient) NewInfoServiceClient() (infopkg.InfoServiceClient, error
 	return nil, NoArgoServerErr
 }
 
+func (c *offlineClient) NewMemoizationCacheServiceClient() (memoizationcachepkg.MemoizationCacheServiceClient, error) {
+	return nil, NoArgoServerErr
+}
+
 type offlineWorkflowTemplateNamespacedGetter struct {
 	namespace         string
 	workflowTemplates map[string]*wfv1.WorkflowTemplate
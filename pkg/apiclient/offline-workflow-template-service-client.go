@@ -45,3 +45,11 @@ func (o OfflineWorkflowTemplateServiceClient) LintWorkflowTemplate(ctx context.C
 	}
 	return req.Template, nil
 }
+
+func (o OfflineWorkflowTemplateServiceClient) ListWorkflowTemplateRevisions(ctx context.Context, req *workflowtemplatepkg.WorkflowTemplateRevisionsRequest, _ ...grpc.CallOption) (*workflowtemplatepkg.WorkflowTemplateRevisionList, error) {
+	return nil, OfflineErr
+}
+
+func (o OfflineWorkflowTemplateServiceClient) RollbackWorkflowTemplate(ctx context.Context, req *workflowtemplatepkg.WorkflowTemplateRollbackRequest, _ ...grpc.CallOption) (*v1alpha1.WorkflowTemplate, error) {
+	return nil, OfflineErr
+}
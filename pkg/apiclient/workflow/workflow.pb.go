@@ -337,6 +337,8 @@ type WorkflowRetryRequest struct {
 	RestartSuccessful    bool     `protobuf:"varint,3,opt,name=restartSuccessful,proto3" json:"restartSuccessful,omitempty"`
 	NodeFieldSelector    string   `protobuf:"bytes,4,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
 	Parameters           []string `protobuf:"bytes,5,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	NodeParameters       []string `protobuf:"bytes,6,rep,name=nodeParameters,proto3" json:"nodeParameters,omitempty"`
+	NodeArtifacts        []string `protobuf:"bytes,7,rep,name=nodeArtifacts,proto3" json:"nodeArtifacts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -410,6 +412,20 @@ func (m *WorkflowRetryRequest) GetParameters() []string {
 	return nil
 }
 
+func (m *WorkflowRetryRequest) GetNodeParameters() []string {
+	if m != nil {
+		return m.NodeParameters
+	}
+	return nil
+}
+
+func (m *WorkflowRetryRequest) GetNodeArtifacts() []string {
+	if m != nil {
+		return m.NodeArtifacts
+	}
+	return nil
+}
+
 type WorkflowResumeRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -1321,98 +1337,100 @@ func init() {
 }
 
 var fileDescriptor_1f6bb75f9e833cb6 = []byte{
-	// 1451 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1c, 0x35,
-	0x14, 0xc7, 0xe5, 0x4d, 0x9b, 0x26, 0xce, 0x8f, 0xb6, 0xa6, 0x2d, 0xcb, 0xa8, 0x4d, 0x53, 0x97,
-	0x42, 0x9a, 0x36, 0x33, 0xf9, 0x51, 0xa0, 0x45, 0x02, 0x89, 0x36, 0x6d, 0x44, 0x59, 0x4a, 0x35,
-	0x8b, 0x84, 0xe0, 0x82, 0x26, 0xb3, 0xce, 0x64, 0x9a, 0x99, 0xf1, 0x60, 0x7b, 0xb7, 0x0a, 0xa5,
-	0x48, 0x70, 0x81, 0x03, 0x12, 0x07, 0x8e, 0xdc, 0x90, 0x10, 0x1c, 0x10, 0x20, 0x24, 0x24, 0x04,
-	0x12, 0xea, 0x81, 0x03, 0xc7, 0x4a, 0xbd, 0x72, 0x40, 0x15, 0xff, 0x00, 0xff, 0x01, 0xb2, 0xe7,
-	0x97, 0x27, 0xbb, 0xdd, 0x0e, 0xc9, 0x06, 0x7a, 0x1b, 0x7b, 0xc6, 0x7e, 0x1f, 0x7f, 0x9f, 0xfd,
-	0xde, 0xf3, 0xc0, 0x53, 0xf1, 0x86, 0x67, 0x39, 0xb1, 0xef, 0x06, 0x3e, 0x89, 0x84, 0x75, 0x93,
-	0xb2, 0x8d, 0xb5, 0x80, 0xde, 0xcc, 0x1f, 0xcc, 0x98, 0x51, 0x41, 0xd1, 0x48, 0xd6, 0x36, 0x8e,
-	0x7a, 0x94, 0x7a, 0x01, 0x91, 0x63, 0x2c, 0x27, 0x8a, 0xa8, 0x70, 0x84, 0x4f, 0x23, 0x9e, 0x7c,
-	0x67, 0x9c, 0xdb, 0x38, 0xcf, 0x4d, 0x9f, 0xca, 0xb7, 0xa1, 0xe3, 0xae, 0xfb, 0x11, 0x61, 0x9b,
-	0x56, 0x6a, 0x82, 0x5b, 0x21, 0x11, 0x8e, 0xd5, 0x59, 0xb0, 0x3c, 0x12, 0x11, 0xe6, 0x08, 0xd2,
-	0x4a, 0x47, 0xbd, 0xea, 0xf9, 0x62, 0xbd, 0xbd, 0x6a, 0xba, 0x34, 0xb4, 0x1c, 0xe6, 0xd1, 0x98,
-	0xd1, 0x1b, 0xea, 0x61, 0x2e, 0x33, 0xcb, 0x8b, 0x49, 0x72, 0xc4, 0xce, 0x82, 0x13, 0xc4, 0xeb,
-	0x4e, 0xf7, 0x74, 0xb8, 0x80, 0xb0, 0x5c, 0xca, 0x48, 0x0f, 0x93, 0xf8, 0x4e, 0x0d, 0x1e, 0x7e,
-	0x23, 0x9d, 0xe9, 0x12, 0x23, 0x8e, 0x20, 0x36, 0x79, 0xa7, 0x4d, 0xb8, 0x40, 0x47, 0xe1, 0x68,
-	0xe4, 0x84, 0x84, 0xc7, 0x8e, 0x4b, 0xea, 0x60, 0x1a, 0xcc, 0x8c, 0xda, 0x45, 0x07, 0x5a, 0x83,
-	0xb9, 0x14, 0xf5, 0xda, 0x34, 0x98, 0x19, 0x5b, 0xbc, 0x6a, 0x16, 0xf4, 0x66, 0x46, 0xaf, 0x1e,
-	0xde, 0xce, 0xe9, 0xcd, 0xce, 0x92, 0x19, 0x6f, 0x78, 0xa6, 0x5c, 0x80, 0x99, 0x4b, 0x9b, 0x2d,
-	0xc0, 0xcc, 0x40, 0xec, 0x7c, 0x6e, 0x84, 0x21, 0xf4, 0x23, 0x2e, 0x9c, 0xc8, 0x25, 0x2f, 0x2f,
-	0xd7, 0x87, 0x24, 0xc6, 0xc5, 0x5a, 0x1d, 0xd8, 0x5a, 0x2f, 0xc2, 0x70, 0x9c, 0x13, 0xd6, 0x21,
-	0x6c, 0x99, 0x6d, 0xda, 0xed, 0xa8, 0xbe, 0x67, 0x1a, 0xcc, 0x8c, 0xd8, 0xa5, 0x3e, 0xf4, 0x26,
-	0x9c, 0x70, 0xd5, 0xf2, 0x5e, 0x8b, 0x95, 0x9f, 0xea, 0x7b, 0x15, 0xf4, 0x92, 0x99, 0x68, 0x64,
-	0xea, 0x8e, 0x2a, 0x10, 0xa5, 0xa3, 0xcc, 0xce, 0x82, 0x79, 0x49, 0x1f, 0x6a, 0x97, 0x67, 0xc2,
-	0xdf, 0x03, 0x88, 0x32, 0xf2, 0x15, 0x22, 0x32, 0xfd, 0x10, 0xdc, 0x23, 0xe5, 0x4a, 0xa5, 0x53,
-	0xcf, 0x65, 0x4d, 0x6b, 0x5b, 0x35, 0xbd, 0x0e, 0xa1, 0x47, 0x44, 0x06, 0x38, 0xa4, 0x00, 0xe7,
-	0xab, 0x01, 0xae, 0xe4, 0xe3, 0x6c, 0x6d, 0x0e, 0x74, 0x04, 0x0e, 0xaf, 0xf9, 0x24, 0x68, 0x71,
-	0xa5, 0xc9, 0xa8, 0x9d, 0xb6, 0xf0, 0x1d, 0x00, 0x1f, 0xcb, 0x90, 0x1b, 0x3e, 0x17, 0xd5, 0x7c,
-	0xde, 0x84, 0x63, 0x81, 0xcf, 0x73, 0xc0, 0xc4, 0xed, 0x0b, 0xd5, 0x00, 0x1b, 0xc5, 0x40, 0x5b,
-	0x9f, 0x45, 0x43, 0x1c, 0xd2, 0x11, 0xd1, 0x14, 0x84, 0xd2, 0xf2, 0x15, 0x3f, 0x10, 0x84, 0xa5,
-	0xf8, 0x5a, 0x0f, 0xfe, 0x08, 0xc0, 0xc7, 0xf3, 0xfd, 0x42, 0x78, 0x7b, 0x35, 0xf4, 0x77, 0x20,
-	0xbd, 0x01, 0x47, 0x42, 0x12, 0x52, 0xff, 0x5d, 0xd2, 0x52, 0x1c, 0x23, 0x76, 0xde, 0x96, 0x24,
-	0xb1, 0xc3, 0x9c, 0x90, 0x08, 0xc2, 0xe4, 0xbe, 0x19, 0x92, 0x24, 0x45, 0x0f, 0xfe, 0x0d, 0xc0,
-	0x43, 0x05, 0x89, 0x60, 0x9b, 0xdb, 0xc7, 0x38, 0x0b, 0x0f, 0x32, 0xc2, 0x85, 0xc3, 0x44, 0xb3,
-	0xed, 0xba, 0x84, 0xf3, 0xb5, 0x76, 0x90, 0xf2, 0x74, 0xbf, 0x90, 0x5f, 0x47, 0xb4, 0x45, 0xae,
-	0x48, 0xc1, 0x9a, 0x24, 0x20, 0xae, 0xa0, 0x99, 0x52, 0xdd, 0x2f, 0x1e, 0xba, 0x8c, 0x9b, 0x45,
-	0x20, 0x90, 0x7a, 0x86, 0x64, 0x47, 0xcb, 0xe8, 0x06, 0x1b, 0x7a, 0x00, 0x18, 0x6e, 0xc0, 0x7a,
-	0x66, 0xf8, 0x75, 0xc2, 0x42, 0x3f, 0xd2, 0x82, 0xd0, 0xbf, 0xb6, 0x8d, 0x3f, 0xd5, 0xb6, 0x76,
-	0x53, 0xd0, 0xf8, 0x3f, 0x5a, 0x05, 0xaa, 0xc3, 0x7d, 0x21, 0xe1, 0xdc, 0xf1, 0x48, 0xea, 0x82,
-	0xac, 0x89, 0xef, 0x6a, 0xf1, 0xa1, 0xb9, 0x93, 0xf8, 0x30, 0x20, 0x20, 0x74, 0x08, 0xee, 0x8d,
-	0xd7, 0x1d, 0x4e, 0x54, 0x0c, 0x1c, 0xb5, 0x93, 0x06, 0x9a, 0x85, 0x07, 0x68, 0x5b, 0xc4, 0x6d,
-	0x71, 0xbd, 0xd8, 0x25, 0xc3, 0xea, 0x83, 0xae, 0x7e, 0x7c, 0x15, 0x1e, 0xc9, 0x57, 0xd4, 0xe6,
-	0x31, 0x89, 0x5a, 0xdb, 0x77, 0xd8, 0x3d, 0x4d, 0x9e, 0x06, 0xf5, 0xb6, 0x2f, 0x4f, 0x1d, 0xee,
-	0x8b, 0x69, 0xeb, 0x9a, 0x1c, 0x94, 0x88, 0x92, 0x35, 0xd1, 0x4b, 0x10, 0x06, 0xd4, 0xcb, 0xe2,
-	0xd6, 0x1e, 0x15, 0xb7, 0x4e, 0x68, 0x71, 0xcb, 0x94, 0xd9, 0x51, 0x46, 0xa9, 0xeb, 0xb4, 0xd5,
-	0xc8, 0x3f, 0xb4, 0xb5, 0x41, 0x12, 0xc7, 0x63, 0x24, 0x4e, 0x25, 0x53, 0xcf, 0x32, 0x68, 0xf0,
-	0xcc, 0x0d, 0x89, 0x52, 0x79, 0x1b, 0xff, 0x0c, 0x8a, 0xe3, 0xb4, 0x4c, 0x02, 0xb2, 0x83, 0x2d,
-	0x2d, 0x73, 0x57, 0x4b, 0x4d, 0x51, 0x4e, 0x0d, 0x15, 0x73, 0xd7, 0xb2, 0x3e, 0xd4, 0x2e, 0xcf,
-	0x24, 0xb7, 0xc2, 0x1a, 0x65, 0x2e, 0x49, 0x73, 0x66, 0xd2, 0xc0, 0xf5, 0xc2, 0xbd, 0x19, 0x3b,
-	0x8f, 0x69, 0xc4, 0x09, 0xfe, 0x42, 0x2e, 0xcb, 0x11, 0xee, 0x7a, 0xf6, 0x9e, 0x3f, 0x7a, 0xa9,
-	0x03, 0x7f, 0xa2, 0xed, 0x28, 0x05, 0x7b, 0xb9, 0x43, 0x22, 0x25, 0xbc, 0xd8, 0x8c, 0x73, 0xe1,
-	0xe5, 0x33, 0x5a, 0x85, 0xc3, 0x74, 0xf5, 0x06, 0x71, 0xc5, 0x2e, 0x14, 0x31, 0xe9, 0xcc, 0x32,
-	0x53, 0xa1, 0x02, 0xe3, 0x7f, 0x14, 0x0c, 0xbf, 0x08, 0x47, 0x1a, 0xd4, 0xbb, 0x1c, 0x09, 0xb6,
-	0x29, 0x4f, 0x8b, 0x4b, 0x23, 0x41, 0x22, 0x91, 0x1a, 0xcf, 0x9a, 0xfa, 0x39, 0xaa, 0x95, 0xce,
-	0x11, 0xfe, 0xbc, 0x54, 0x36, 0x44, 0xe2, 0x91, 0x2a, 0x15, 0xf1, 0xdf, 0xda, 0x91, 0x6b, 0x96,
-	0xea, 0x81, 0xfe, 0x7c, 0x18, 0x8e, 0x33, 0xc2, 0x69, 0x9b, 0xb9, 0xe4, 0x15, 0x3f, 0x6a, 0xa5,
-	0x8b, 0x2e, 0xf5, 0xe9, 0xdf, 0x68, 0x01, 0xa6, 0xd4, 0x87, 0x18, 0x9c, 0x48, 0xca, 0x90, 0x72,
-	0xa0, 0x69, 0xec, 0x7c, 0xb1, 0xcd, 0x6c, 0x5a, 0x6e, 0x97, 0x4d, 0x2c, 0xfe, 0x71, 0x18, 0xee,
-	0x2f, 0x72, 0x0b, 0xeb, 0xf8, 0x2e, 0x41, 0x5f, 0x01, 0x38, 0x99, 0x14, 0xac, 0xd9, 0x1b, 0x74,
-	0xbc, 0x98, 0xb4, 0x67, 0xb1, 0x6f, 0x0c, 0xd0, 0x23, 0x78, 0xe6, 0xc3, 0x7b, 0x7f, 0x7d, 0x56,
-	0xc3, 0xf8, 0x98, 0xba, 0x78, 0x74, 0x16, 0xac, 0xe2, 0xf2, 0x72, 0x2b, 0x57, 0xfd, 0xf6, 0xf3,
-	0x60, 0x16, 0x7d, 0x09, 0xe0, 0xd8, 0x0a, 0x11, 0x39, 0xe6, 0xd1, 0x6e, 0xcc, 0xa2, 0xa0, 0x1e,
-	0x28, 0xe3, 0x59, 0xc5, 0xf8, 0x14, 0x7a, 0xb2, 0x2f, 0x63, 0xf2, 0x7c, 0x5b, 0x72, 0x4e, 0xc8,
-	0x43, 0x95, 0x07, 0x3d, 0x74, 0xac, 0x9b, 0x54, 0xab, 0xa3, 0x8d, 0x6b, 0x83, 0x43, 0x95, 0xd3,
-	0xe2, 0x53, 0x0a, 0xf7, 0x38, 0xea, 0x2f, 0x29, 0x7a, 0x1f, 0x4e, 0x96, 0x83, 0x73, 0xc9, 0xf1,
-	0xbd, 0xc2, 0xb6, 0xd1, 0x43, 0xf2, 0x22, 0x56, 0xe1, 0x33, 0xca, 0xee, 0x29, 0x74, 0x72, 0xab,
-	0xdd, 0x39, 0xa2, 0x62, 0x99, 0x6e, 0x7d, 0x1e, 0x20, 0x0e, 0xc7, 0xb4, 0x40, 0x57, 0x72, 0x67,
-	0x57, 0xfc, 0x33, 0x9e, 0xe8, 0x95, 0x80, 0x13, 0xb3, 0xa7, 0x95, 0xd9, 0x93, 0xe8, 0x44, 0x66,
-	0x96, 0x0b, 0x46, 0x9c, 0xd0, 0xea, 0x69, 0xf4, 0x03, 0x00, 0x27, 0x93, 0x2c, 0xd5, 0x6f, 0xbb,
-	0x97, 0x72, 0xb0, 0x31, 0xfd, 0xe0, 0x0f, 0xd2, 0x44, 0x97, 0x6e, 0x90, 0xd9, 0x6a, 0x1b, 0xe4,
-	0x07, 0x00, 0x27, 0x54, 0xe9, 0x9f, 0x23, 0x4c, 0x75, 0x5b, 0xd0, 0xef, 0x06, 0x03, 0xdd, 0xcc,
-	0xcf, 0x28, 0x56, 0xcb, 0x98, 0xad, 0xc2, 0x6a, 0x31, 0x89, 0x21, 0x4f, 0xdf, 0x2f, 0x00, 0x1e,
-	0xc8, 0x6e, 0x4e, 0x39, 0xf7, 0x89, 0x5e, 0xdc, 0xa5, 0xdb, 0xd5, 0x40, 0xd1, 0xcf, 0x2b, 0xf4,
-	0x45, 0x63, 0xae, 0x22, 0x7a, 0x42, 0x22, 0xe9, 0x7f, 0x04, 0x70, 0x32, 0xb9, 0xa7, 0xf4, 0x73,
-	0x7b, 0xe9, 0x26, 0x33, 0x50, 0xf2, 0x67, 0x15, 0xf9, 0xbc, 0x71, 0xa6, 0x32, 0x79, 0x48, 0x24,
-	0xf7, 0x4f, 0x00, 0xee, 0x4f, 0x6b, 0xe6, 0x1c, 0xbc, 0xc7, 0x76, 0x2c, 0x97, 0xd5, 0x03, 0x25,
-	0x7f, 0x4e, 0x91, 0x2f, 0x18, 0x67, 0x2b, 0x91, 0xf3, 0x04, 0x44, 0xa2, 0xff, 0x0a, 0xe0, 0xc1,
-	0xfc, 0x86, 0x96, 0xc3, 0xe3, 0x6e, 0xf8, 0xad, 0xd7, 0xb8, 0x81, 0xe2, 0x5f, 0x50, 0xf8, 0x4b,
-	0x86, 0x59, 0x09, 0x5f, 0x64, 0x28, 0x72, 0x01, 0xdf, 0x01, 0x38, 0x2e, 0xef, 0x84, 0x39, 0x7b,
-	0x8f, 0x30, 0xae, 0xdd, 0x19, 0x07, 0x8a, 0x7d, 0x4e, 0x61, 0x9b, 0xc6, 0xe9, 0x6a, 0xaa, 0x0b,
-	0x1a, 0x4b, 0xe2, 0x6f, 0x00, 0x1c, 0x6b, 0xf6, 0xcf, 0x90, 0xcd, 0xdd, 0xc9, 0x90, 0x4b, 0x8a,
-	0x77, 0xce, 0x98, 0xa9, 0xc6, 0x4b, 0xd4, 0xa1, 0xfc, 0x1a, 0xc0, 0x71, 0x59, 0x18, 0xf6, 0x13,
-	0x58, 0x2b, 0x1c, 0x07, 0x0a, 0x3c, 0xa7, 0x80, 0x9f, 0xc6, 0xb8, 0x3f, 0x70, 0xe0, 0x47, 0x0a,
-	0xf5, 0x3d, 0xb8, 0x2f, 0xb9, 0xed, 0xf1, 0x5e, 0xa2, 0x16, 0x17, 0x51, 0x03, 0x15, 0x6f, 0xb3,
-	0xe2, 0x19, 0xbf, 0xa0, 0x6c, 0x9d, 0x43, 0x8b, 0x95, 0xc4, 0xb9, 0x95, 0xd6, 0xcf, 0xb7, 0xad,
-	0x80, 0x7a, 0x1f, 0xd7, 0xc0, 0x3c, 0x40, 0x02, 0x8e, 0x6b, 0xa6, 0xb6, 0x83, 0x30, 0xaf, 0x10,
-	0x66, 0x51, 0x35, 0xff, 0x04, 0xd4, 0x9b, 0x07, 0xe8, 0x5b, 0x00, 0x27, 0x9b, 0xe5, 0x78, 0x7f,
-	0xbc, 0x57, 0xe8, 0xd9, 0xad, 0x68, 0x6f, 0x29, 0xe6, 0xd3, 0xf8, 0x21, 0x49, 0x35, 0x0f, 0xf2,
-	0x17, 0x57, 0x7e, 0xbf, 0x3f, 0x05, 0xee, 0xde, 0x9f, 0x02, 0x7f, 0xde, 0x9f, 0x02, 0x6f, 0x5d,
-	0xa8, 0xfe, 0x7b, 0x7c, 0xcb, 0x6f, 0xfc, 0xd5, 0x61, 0xf5, 0xb7, 0x7b, 0xe9, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x07, 0xe3, 0x32, 0x3c, 0xe7, 0x17, 0x00, 0x00,
+	// 1483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xdb, 0x6f, 0x1c, 0xb5,
+	0x1a, 0xc0, 0xe5, 0x4d, 0x9b, 0x8b, 0x73, 0x69, 0xeb, 0xd3, 0xf6, 0xec, 0x19, 0xb5, 0x69, 0xea,
+	0x5e, 0x4e, 0x9a, 0x36, 0x33, 0xb9, 0xf4, 0x1c, 0x5a, 0x24, 0x90, 0xda, 0xa6, 0x8d, 0x28, 0x4b,
+	0xa9, 0x66, 0x91, 0x10, 0xbc, 0xa0, 0xc9, 0xac, 0x33, 0x99, 0x66, 0x66, 0x3c, 0xd8, 0xde, 0xad,
+	0x42, 0x29, 0x12, 0xbc, 0xc0, 0x03, 0x12, 0x12, 0x3c, 0xf2, 0x86, 0x84, 0xe0, 0x01, 0x01, 0x42,
+	0x42, 0x42, 0x20, 0xa1, 0x3e, 0xf2, 0x58, 0xa9, 0xaf, 0x3c, 0xa0, 0x8a, 0x7f, 0x80, 0xff, 0x00,
+	0xd9, 0x73, 0xf3, 0x64, 0xb7, 0xdb, 0x21, 0xd9, 0x42, 0xdf, 0x6c, 0x8f, 0xed, 0xef, 0xe7, 0xef,
+	0xb3, 0xbf, 0xcb, 0x2e, 0x3c, 0x15, 0x6f, 0x7a, 0x96, 0x13, 0xfb, 0x6e, 0xe0, 0x93, 0x48, 0x58,
+	0xb7, 0x29, 0xdb, 0x5c, 0x0f, 0xe8, 0xed, 0xbc, 0x61, 0xc6, 0x8c, 0x0a, 0x8a, 0x46, 0xb3, 0xbe,
+	0x71, 0xc4, 0xa3, 0xd4, 0x0b, 0x88, 0x5c, 0x63, 0x39, 0x51, 0x44, 0x85, 0x23, 0x7c, 0x1a, 0xf1,
+	0x64, 0x9e, 0x71, 0x7e, 0xf3, 0x02, 0x37, 0x7d, 0x2a, 0xbf, 0x86, 0x8e, 0xbb, 0xe1, 0x47, 0x84,
+	0x6d, 0x59, 0xa9, 0x08, 0x6e, 0x85, 0x44, 0x38, 0x56, 0x67, 0xd1, 0xf2, 0x48, 0x44, 0x98, 0x23,
+	0x48, 0x2b, 0x5d, 0xf5, 0x92, 0xe7, 0x8b, 0x8d, 0xf6, 0x9a, 0xe9, 0xd2, 0xd0, 0x72, 0x98, 0x47,
+	0x63, 0x46, 0x6f, 0xa9, 0xc6, 0x7c, 0x26, 0x96, 0x17, 0x9b, 0xe4, 0x88, 0x9d, 0x45, 0x27, 0x88,
+	0x37, 0x9c, 0xee, 0xed, 0x70, 0x01, 0x61, 0xb9, 0x94, 0x91, 0x1e, 0x22, 0xf1, 0xbd, 0x1a, 0x3c,
+	0xf4, 0x6a, 0xba, 0xd3, 0x15, 0x46, 0x1c, 0x41, 0x6c, 0xf2, 0x66, 0x9b, 0x70, 0x81, 0x8e, 0xc0,
+	0xb1, 0xc8, 0x09, 0x09, 0x8f, 0x1d, 0x97, 0xd4, 0xc1, 0x0c, 0x98, 0x1d, 0xb3, 0x8b, 0x01, 0xb4,
+	0x0e, 0x73, 0x55, 0xd4, 0x6b, 0x33, 0x60, 0x76, 0x7c, 0xe9, 0xba, 0x59, 0xd0, 0x9b, 0x19, 0xbd,
+	0x6a, 0xbc, 0x91, 0xd3, 0x9b, 0x9d, 0x65, 0x33, 0xde, 0xf4, 0x4c, 0x79, 0x00, 0x33, 0x57, 0x6d,
+	0x76, 0x00, 0x33, 0x03, 0xb1, 0xf3, 0xbd, 0x11, 0x86, 0xd0, 0x8f, 0xb8, 0x70, 0x22, 0x97, 0xbc,
+	0xb0, 0x52, 0x1f, 0x92, 0x18, 0x97, 0x6b, 0x75, 0x60, 0x6b, 0xa3, 0x08, 0xc3, 0x09, 0x4e, 0x58,
+	0x87, 0xb0, 0x15, 0xb6, 0x65, 0xb7, 0xa3, 0xfa, 0x9e, 0x19, 0x30, 0x3b, 0x6a, 0x97, 0xc6, 0xd0,
+	0x6b, 0x70, 0xd2, 0x55, 0xc7, 0x7b, 0x39, 0x56, 0x76, 0xaa, 0xef, 0x55, 0xd0, 0xcb, 0x66, 0xa2,
+	0x23, 0x53, 0x37, 0x54, 0x81, 0x28, 0x0d, 0x65, 0x76, 0x16, 0xcd, 0x2b, 0xfa, 0x52, 0xbb, 0xbc,
+	0x13, 0xfe, 0x16, 0x40, 0x94, 0x91, 0xaf, 0x12, 0x91, 0xe9, 0x0f, 0xc1, 0x3d, 0x52, 0x5d, 0xa9,
+	0xea, 0x54, 0xbb, 0xac, 0xd3, 0xda, 0x76, 0x9d, 0xde, 0x84, 0xd0, 0x23, 0x22, 0x03, 0x1c, 0x52,
+	0x80, 0x0b, 0xd5, 0x00, 0x57, 0xf3, 0x75, 0xb6, 0xb6, 0x07, 0x3a, 0x0c, 0x87, 0xd7, 0x7d, 0x12,
+	0xb4, 0xb8, 0xd2, 0xc9, 0x98, 0x9d, 0xf6, 0xf0, 0x3d, 0x00, 0xff, 0x95, 0x21, 0x37, 0x7c, 0x2e,
+	0xaa, 0xd9, 0xbc, 0x09, 0xc7, 0x03, 0x9f, 0xe7, 0x80, 0x89, 0xd9, 0x17, 0xab, 0x01, 0x36, 0x8a,
+	0x85, 0xb6, 0xbe, 0x8b, 0x86, 0x38, 0xa4, 0x23, 0xa2, 0x69, 0x08, 0xa5, 0xe4, 0x6b, 0x7e, 0x20,
+	0x08, 0x4b, 0xf1, 0xb5, 0x11, 0xfc, 0x3e, 0x80, 0xff, 0xce, 0xef, 0x0b, 0xe1, 0xed, 0xb5, 0xd0,
+	0xdf, 0x85, 0xea, 0x0d, 0x38, 0x1a, 0x92, 0x90, 0xfa, 0x6f, 0x91, 0x96, 0xe2, 0x18, 0xb5, 0xf3,
+	0xbe, 0x24, 0x89, 0x1d, 0xe6, 0x84, 0x44, 0x10, 0x26, 0xef, 0xcd, 0x90, 0x24, 0x29, 0x46, 0xf0,
+	0xc7, 0x35, 0x78, 0xb0, 0x20, 0x11, 0x6c, 0x6b, 0xe7, 0x18, 0xe7, 0xe0, 0x01, 0x46, 0xb8, 0x70,
+	0x98, 0x68, 0xb6, 0x5d, 0x97, 0x70, 0xbe, 0xde, 0x0e, 0x52, 0x9e, 0xee, 0x0f, 0x72, 0x76, 0x44,
+	0x5b, 0xe4, 0x9a, 0x54, 0x58, 0x93, 0x04, 0xc4, 0x15, 0x34, 0xd3, 0x54, 0xf7, 0x87, 0xc7, 0x1d,
+	0x03, 0x9d, 0x86, 0x53, 0x72, 0xd1, 0xcd, 0x62, 0xce, 0xb0, 0x9a, 0xb3, 0x6d, 0x14, 0x9d, 0x84,
+	0x93, 0x72, 0xe4, 0x12, 0x13, 0xfe, 0xba, 0xe3, 0x0a, 0x5e, 0x1f, 0x51, 0xd3, 0xca, 0x83, 0xf8,
+	0x76, 0xe1, 0x56, 0xa4, 0x75, 0x42, 0xb2, 0x2b, 0xa5, 0x74, 0x1f, 0x73, 0xe8, 0x11, 0xc7, 0xc4,
+	0x0d, 0x58, 0xcf, 0x04, 0xbf, 0x42, 0x58, 0xe8, 0x47, 0x9a, 0x4b, 0xfb, 0xcb, 0xb2, 0xf1, 0x47,
+	0xda, 0x43, 0x69, 0x0a, 0x1a, 0xff, 0x4d, 0xa7, 0x40, 0x75, 0x38, 0x12, 0x12, 0xce, 0x1d, 0x8f,
+	0xa4, 0x06, 0xcd, 0xba, 0xf8, 0xbe, 0xe6, 0x6d, 0x9a, 0xbb, 0xf1, 0x36, 0x03, 0x02, 0x42, 0x07,
+	0xe1, 0xde, 0x78, 0xc3, 0xe1, 0x44, 0x79, 0xd4, 0x31, 0x3b, 0xe9, 0xa0, 0x39, 0xb8, 0x9f, 0xb6,
+	0x45, 0xdc, 0x16, 0xa5, 0xfb, 0x24, 0x27, 0x74, 0x8d, 0xe3, 0xeb, 0xf0, 0x70, 0x7e, 0xa2, 0x36,
+	0x8f, 0x49, 0xd4, 0xda, 0xb9, 0xc1, 0x1e, 0x68, 0xea, 0x69, 0x50, 0x6f, 0xe7, 0xea, 0xa9, 0xc3,
+	0x91, 0x98, 0xb6, 0x6e, 0xc8, 0x45, 0x89, 0x52, 0xb2, 0x2e, 0xba, 0x04, 0x61, 0x40, 0xbd, 0xcc,
+	0x0b, 0xee, 0x51, 0x5e, 0xf0, 0xb8, 0xe6, 0x05, 0x4d, 0x19, 0x6b, 0xa5, 0xcf, 0xbb, 0x49, 0x5b,
+	0x8d, 0x7c, 0xa2, 0xad, 0x2d, 0x92, 0x38, 0x1e, 0x23, 0x71, 0xaa, 0x32, 0xd5, 0x96, 0x2e, 0x88,
+	0x67, 0x66, 0x48, 0x34, 0x95, 0xf7, 0xf1, 0x8f, 0xa0, 0x78, 0x4e, 0x2b, 0x24, 0x20, 0xbb, 0xb8,
+	0xd2, 0x32, 0x12, 0xb6, 0xd4, 0x16, 0xe5, 0x40, 0x53, 0x31, 0x12, 0xae, 0xe8, 0x4b, 0xed, 0xf2,
+	0x4e, 0xf2, 0x2a, 0xac, 0x53, 0xe6, 0x92, 0x34, 0x02, 0x27, 0x1d, 0x5c, 0x2f, 0xcc, 0x9b, 0xb1,
+	0xf3, 0x98, 0x46, 0x9c, 0xe0, 0xcf, 0xe4, 0xb1, 0x1c, 0xe1, 0x6e, 0x64, 0xdf, 0xf9, 0xd3, 0x17,
+	0x88, 0xf0, 0x87, 0xda, 0x8d, 0x52, 0xb0, 0x57, 0x3b, 0x24, 0x52, 0x8a, 0x17, 0x5b, 0x71, 0xae,
+	0x78, 0xd9, 0x46, 0x6b, 0x70, 0x98, 0xae, 0xdd, 0x22, 0xae, 0x78, 0x02, 0x29, 0x51, 0xba, 0xb3,
+	0x8c, 0x7b, 0xa8, 0xc0, 0xf8, 0x07, 0x15, 0x86, 0x9f, 0x87, 0xa3, 0x0d, 0xea, 0x5d, 0x8d, 0x04,
+	0xdb, 0x92, 0xaf, 0xc5, 0xa5, 0x91, 0x20, 0x91, 0x48, 0x85, 0x67, 0x5d, 0xfd, 0x1d, 0xd5, 0x4a,
+	0xef, 0x08, 0x7f, 0x5a, 0x4a, 0x42, 0x22, 0xf1, 0x54, 0x25, 0x9e, 0xf8, 0x0f, 0xed, 0xc9, 0x35,
+	0x4b, 0xd9, 0x45, 0x7f, 0x3e, 0x0c, 0x27, 0x18, 0xe1, 0xb4, 0xcd, 0x5c, 0xf2, 0xa2, 0x1f, 0xb5,
+	0xd2, 0x43, 0x97, 0xc6, 0xf4, 0x39, 0x9a, 0x83, 0x29, 0x8d, 0x21, 0x06, 0x27, 0x93, 0xa4, 0xa6,
+	0xec, 0x68, 0x1a, 0xbb, 0x3f, 0x6c, 0x33, 0xdb, 0x96, 0xdb, 0x65, 0x11, 0x4b, 0xbf, 0x1e, 0x82,
+	0xfb, 0x8a, 0xd8, 0xc2, 0x3a, 0xbe, 0x4b, 0xd0, 0x17, 0x00, 0x4e, 0x25, 0xe9, 0x6f, 0xf6, 0x05,
+	0x1d, 0x2b, 0x36, 0xed, 0x59, 0x3a, 0x18, 0x03, 0xb4, 0x08, 0x9e, 0x7d, 0xef, 0xc1, 0xef, 0x9f,
+	0xd4, 0x30, 0x3e, 0xaa, 0xca, 0x98, 0xce, 0xa2, 0x55, 0x94, 0x42, 0x77, 0x72, 0xad, 0xdf, 0x7d,
+	0x16, 0xcc, 0xa1, 0xcf, 0x01, 0x1c, 0x5f, 0x25, 0x22, 0xc7, 0x3c, 0xd2, 0x8d, 0x59, 0xa4, 0xe7,
+	0x03, 0x65, 0x3c, 0xa7, 0x18, 0x4f, 0xa3, 0x93, 0x7d, 0x19, 0x93, 0xf6, 0x5d, 0xc9, 0x39, 0x29,
+	0x1f, 0x55, 0xee, 0xf4, 0xd0, 0xd1, 0x6e, 0x52, 0x2d, 0x2b, 0x37, 0x6e, 0x0c, 0x0e, 0x55, 0x6e,
+	0x8b, 0x4f, 0x29, 0xdc, 0x63, 0xa8, 0xbf, 0x4a, 0xd1, 0x3b, 0x70, 0xaa, 0xec, 0x9c, 0x4b, 0x86,
+	0xef, 0xe5, 0xb6, 0x8d, 0x1e, 0x2a, 0x2f, 0x7c, 0x15, 0x3e, 0xab, 0xe4, 0x9e, 0x42, 0x27, 0xb6,
+	0xcb, 0x9d, 0x27, 0xca, 0x97, 0xe9, 0xd2, 0x17, 0x00, 0xe2, 0x70, 0x5c, 0x73, 0x74, 0x25, 0x73,
+	0x76, 0xf9, 0x3f, 0xe3, 0x3f, 0xbd, 0x02, 0x70, 0x22, 0xf6, 0x8c, 0x12, 0x7b, 0x02, 0x1d, 0xcf,
+	0xc4, 0x72, 0xc1, 0x88, 0x13, 0x5a, 0x3d, 0x85, 0xbe, 0x0b, 0xe0, 0x54, 0x12, 0xa5, 0xfa, 0x5d,
+	0xf7, 0x52, 0x0c, 0x36, 0x66, 0x1e, 0x3d, 0x21, 0x0d, 0x74, 0xe9, 0x05, 0x99, 0xab, 0x76, 0x41,
+	0xbe, 0x03, 0x70, 0x52, 0x15, 0x12, 0x39, 0xc2, 0x74, 0xb7, 0x04, 0xbd, 0xd2, 0x18, 0xe8, 0x65,
+	0xfe, 0x9f, 0x62, 0xb5, 0x8c, 0xb9, 0x2a, 0xac, 0x16, 0x93, 0x18, 0xf2, 0xf5, 0xfd, 0x04, 0xe0,
+	0xfe, 0xac, 0x0e, 0xcb, 0xb9, 0x8f, 0xf7, 0xe2, 0x2e, 0xd5, 0x6a, 0x03, 0x45, 0xbf, 0xa0, 0xd0,
+	0x97, 0x8c, 0xf9, 0x8a, 0xe8, 0x09, 0x89, 0xa4, 0xff, 0x1e, 0xc0, 0xa9, 0xa4, 0x4e, 0xe9, 0x67,
+	0xf6, 0x52, 0x25, 0x33, 0x50, 0xf2, 0xff, 0x2b, 0xf2, 0x05, 0xe3, 0x6c, 0x65, 0xf2, 0x90, 0x48,
+	0xee, 0x1f, 0x00, 0xdc, 0x97, 0xe6, 0xcc, 0x39, 0x78, 0x8f, 0xeb, 0x58, 0x4e, 0xab, 0x07, 0x4a,
+	0xfe, 0x8c, 0x22, 0x5f, 0x34, 0xce, 0x55, 0x22, 0xe7, 0x09, 0x88, 0x44, 0xff, 0x19, 0xc0, 0x03,
+	0x79, 0x85, 0x96, 0xc3, 0xe3, 0x6e, 0xf8, 0xed, 0x65, 0xdc, 0x40, 0xf1, 0x2f, 0x2a, 0xfc, 0x65,
+	0xc3, 0xac, 0x84, 0x2f, 0x32, 0x14, 0x79, 0x80, 0x6f, 0x00, 0x9c, 0x90, 0x35, 0x61, 0xce, 0xde,
+	0xc3, 0x8d, 0x6b, 0x35, 0xe3, 0x40, 0xb1, 0xcf, 0x2b, 0x6c, 0xd3, 0x38, 0x53, 0x4d, 0xeb, 0x82,
+	0xc6, 0x92, 0xf8, 0x2b, 0x00, 0xc7, 0x9b, 0xfd, 0x23, 0x64, 0xf3, 0xc9, 0x44, 0xc8, 0x65, 0xc5,
+	0x3b, 0x6f, 0xcc, 0x56, 0xe3, 0x25, 0xea, 0x51, 0x7e, 0x09, 0xe0, 0x84, 0x4c, 0x0c, 0xfb, 0x29,
+	0x58, 0x4b, 0x1c, 0x07, 0x0a, 0x3c, 0xaf, 0x80, 0xff, 0x8b, 0x71, 0x7f, 0xe0, 0xc0, 0x8f, 0x14,
+	0xea, 0xdb, 0x70, 0x24, 0xa9, 0xf6, 0x78, 0x2f, 0xa5, 0x16, 0x85, 0xa8, 0x81, 0x8a, 0xaf, 0x59,
+	0xf2, 0x8c, 0x9f, 0x53, 0xb2, 0xce, 0xa3, 0xa5, 0x4a, 0xca, 0xb9, 0x93, 0xe6, 0xcf, 0x77, 0xad,
+	0x80, 0x7a, 0x1f, 0xd4, 0xc0, 0x02, 0x40, 0x02, 0x4e, 0x68, 0xa2, 0x76, 0x82, 0xb0, 0xa0, 0x10,
+	0xe6, 0x50, 0x35, 0xfb, 0x04, 0xd4, 0x5b, 0x00, 0xe8, 0x6b, 0x00, 0xa7, 0x9a, 0x65, 0x7f, 0x7f,
+	0xac, 0x97, 0xeb, 0x79, 0x52, 0xde, 0xde, 0x52, 0xcc, 0x67, 0xf0, 0x63, 0x82, 0x6a, 0xee, 0xe4,
+	0x2f, 0xaf, 0xfe, 0xf2, 0x70, 0x1a, 0xdc, 0x7f, 0x38, 0x0d, 0x7e, 0x7b, 0x38, 0x0d, 0x5e, 0xbf,
+	0x58, 0xfd, 0xc7, 0xf6, 0x6d, 0x7f, 0x0a, 0xac, 0x0d, 0xab, 0xdf, 0xce, 0x97, 0xff, 0x0c, 0x00,
+	0x00, 0xff, 0xff, 0xee, 0x91, 0x3b, 0xa1, 0x35, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2462,6 +2480,24 @@ func (m *WorkflowRetryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.NodeArtifacts) > 0 {
+		for iNdEx := len(m.NodeArtifacts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NodeArtifacts[iNdEx])
+			copy(dAtA[i:], m.NodeArtifacts[iNdEx])
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeArtifacts[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.NodeParameters) > 0 {
+		for iNdEx := len(m.NodeParameters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NodeParameters[iNdEx])
+			copy(dAtA[i:], m.NodeParameters[iNdEx])
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(m.NodeParameters[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Parameters) > 0 {
 		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Parameters[iNdEx])
@@ -3369,6 +3405,18 @@ func (m *WorkflowRetryRequest) Size() (n int) {
 			n += 1 + l + sovWorkflow(uint64(l))
 		}
 	}
+	if len(m.NodeParameters) > 0 {
+		for _, s := range m.NodeParameters {
+			l = len(s)
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
+	if len(m.NodeArtifacts) > 0 {
+		for _, s := range m.NodeArtifacts {
+			l = len(s)
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4633,6 +4681,70 @@ func (m *WorkflowRetryRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeParameters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeParameters = append(m.NodeParameters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeArtifacts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeArtifacts = append(m.NodeArtifacts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
@@ -360,6 +360,8 @@ type RetryArchivedWorkflowRequest struct {
 	RestartSuccessful    bool     `protobuf:"varint,4,opt,name=restartSuccessful,proto3" json:"restartSuccessful,omitempty"`
 	NodeFieldSelector    string   `protobuf:"bytes,5,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
 	Parameters           []string `protobuf:"bytes,6,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	NodeParameters       []string `protobuf:"bytes,7,rep,name=nodeParameters,proto3" json:"nodeParameters,omitempty"`
+	NodeArtifacts        []string `protobuf:"bytes,8,rep,name=nodeArtifacts,proto3" json:"nodeArtifacts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -440,6 +442,20 @@ func (m *RetryArchivedWorkflowRequest) GetParameters() []string {
 	return nil
 }
 
+func (m *RetryArchivedWorkflowRequest) GetNodeParameters() []string {
+	if m != nil {
+		return m.NodeParameters
+	}
+	return nil
+}
+
+func (m *RetryArchivedWorkflowRequest) GetNodeArtifacts() []string {
+	if m != nil {
+		return m.NodeArtifacts
+	}
+	return nil
+}
+
 type ResubmitArchivedWorkflowRequest struct {
 	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -519,6 +535,219 @@ func (m *ResubmitArchivedWorkflowRequest) GetParameters() []string {
 	return nil
 }
 
+type ListArtifactLineageRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListArtifactLineageRequest) Reset()         { *m = ListArtifactLineageRequest{} }
+func (m *ListArtifactLineageRequest) String() string { return proto.CompactTextString(m) }
+func (*ListArtifactLineageRequest) ProtoMessage()    {}
+func (*ListArtifactLineageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{8}
+}
+func (m *ListArtifactLineageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListArtifactLineageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListArtifactLineageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListArtifactLineageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListArtifactLineageRequest.Merge(m, src)
+}
+func (m *ListArtifactLineageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListArtifactLineageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListArtifactLineageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListArtifactLineageRequest proto.InternalMessageInfo
+
+func (m *ListArtifactLineageRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ListArtifactLineageRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ArtifactLineageNode struct {
+	WorkflowUID          string   `protobuf:"bytes,1,opt,name=workflowUID,proto3" json:"workflowUID,omitempty"`
+	WorkflowName         string   `protobuf:"bytes,2,opt,name=workflowName,proto3" json:"workflowName,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NodeID               string   `protobuf:"bytes,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	ArtifactName         string   `protobuf:"bytes,5,opt,name=artifactName,proto3" json:"artifactName,omitempty"`
+	Digest               string   `protobuf:"bytes,6,opt,name=digest,proto3" json:"digest,omitempty"`
+	FinishedAt           *v1.Time `protobuf:"bytes,7,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArtifactLineageNode) Reset()         { *m = ArtifactLineageNode{} }
+func (m *ArtifactLineageNode) String() string { return proto.CompactTextString(m) }
+func (*ArtifactLineageNode) ProtoMessage()    {}
+func (*ArtifactLineageNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{9}
+}
+func (m *ArtifactLineageNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactLineageNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArtifactLineageNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArtifactLineageNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactLineageNode.Merge(m, src)
+}
+func (m *ArtifactLineageNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactLineageNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactLineageNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactLineageNode proto.InternalMessageInfo
+
+func (m *ArtifactLineageNode) GetWorkflowUID() string {
+	if m != nil {
+		return m.WorkflowUID
+	}
+	return ""
+}
+
+func (m *ArtifactLineageNode) GetWorkflowName() string {
+	if m != nil {
+		return m.WorkflowName
+	}
+	return ""
+}
+
+func (m *ArtifactLineageNode) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ArtifactLineageNode) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+func (m *ArtifactLineageNode) GetArtifactName() string {
+	if m != nil {
+		return m.ArtifactName
+	}
+	return ""
+}
+
+func (m *ArtifactLineageNode) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+func (m *ArtifactLineageNode) GetFinishedAt() *v1.Time {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return nil
+}
+
+type ArtifactLineage struct {
+	Key                  string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Producers            []*ArtifactLineageNode `protobuf:"bytes,2,rep,name=producers,proto3" json:"producers,omitempty"`
+	Consumers            []*ArtifactLineageNode `protobuf:"bytes,3,rep,name=consumers,proto3" json:"consumers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ArtifactLineage) Reset()         { *m = ArtifactLineage{} }
+func (m *ArtifactLineage) String() string { return proto.CompactTextString(m) }
+func (*ArtifactLineage) ProtoMessage()    {}
+func (*ArtifactLineage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ca9a2d33e8bb19, []int{10}
+}
+func (m *ArtifactLineage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactLineage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArtifactLineage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArtifactLineage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactLineage.Merge(m, src)
+}
+func (m *ArtifactLineage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactLineage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactLineage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactLineage proto.InternalMessageInfo
+
+func (m *ArtifactLineage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ArtifactLineage) GetProducers() []*ArtifactLineageNode {
+	if m != nil {
+		return m.Producers
+	}
+	return nil
+}
+
+func (m *ArtifactLineage) GetConsumers() []*ArtifactLineageNode {
+	if m != nil {
+		return m.Consumers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ListArchivedWorkflowsRequest)(nil), "workflowarchive.ListArchivedWorkflowsRequest")
 	proto.RegisterType((*GetArchivedWorkflowRequest)(nil), "workflowarchive.GetArchivedWorkflowRequest")
@@ -528,6 +757,9 @@ func init() {
 	proto.RegisterType((*ListArchivedWorkflowLabelValuesRequest)(nil), "workflowarchive.ListArchivedWorkflowLabelValuesRequest")
 	proto.RegisterType((*RetryArchivedWorkflowRequest)(nil), "workflowarchive.RetryArchivedWorkflowRequest")
 	proto.RegisterType((*ResubmitArchivedWorkflowRequest)(nil), "workflowarchive.ResubmitArchivedWorkflowRequest")
+	proto.RegisterType((*ListArtifactLineageRequest)(nil), "workflowarchive.ListArtifactLineageRequest")
+	proto.RegisterType((*ArtifactLineageNode)(nil), "workflowarchive.ArtifactLineageNode")
+	proto.RegisterType((*ArtifactLineage)(nil), "workflowarchive.ArtifactLineage")
 }
 
 func init() {
@@ -535,57 +767,72 @@ func init() {
 }
 
 var fileDescriptor_95ca9a2d33e8bb19 = []byte{
-	// 793 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x6f, 0xf3, 0x34,
-	0x18, 0xc7, 0xe5, 0xee, 0x7d, 0x5f, 0xbd, 0xf3, 0x0e, 0x80, 0xd1, 0xa0, 0x8a, 0xba, 0xae, 0x44,
-	0xb0, 0x75, 0x1b, 0x75, 0xd6, 0x6d, 0x08, 0xb4, 0x13, 0xa0, 0x09, 0x24, 0xf6, 0x0b, 0xa5, 0x12,
-	0x48, 0x5c, 0xc0, 0x4d, 0x9e, 0xb5, 0xa6, 0x49, 0x1c, 0x6c, 0x27, 0x63, 0x20, 0x2e, 0xf0, 0x27,
-	0x70, 0xe4, 0x84, 0xc4, 0x3f, 0xc0, 0x0d, 0x71, 0x47, 0xe2, 0x84, 0x10, 0xdc, 0x38, 0x20, 0x34,
-	0xf1, 0x87, 0xa0, 0xa4, 0x4d, 0xb3, 0xa5, 0xe9, 0x0f, 0x89, 0xee, 0x66, 0x3f, 0x76, 0x9e, 0xe7,
-	0xf3, 0xb5, 0x9f, 0x7c, 0x65, 0x7c, 0x14, 0x0e, 0x7a, 0x16, 0x0b, 0xb9, 0xe3, 0x71, 0x08, 0xb4,
-	0x75, 0x2d, 0xe4, 0xe0, 0xca, 0x13, 0xd7, 0x4c, 0x3a, 0x7d, 0x1e, 0xc3, 0x78, 0xde, 0x1a, 0x05,
-	0x68, 0x28, 0x85, 0x16, 0xe4, 0x99, 0xc2, 0x3e, 0xa3, 0xd6, 0x13, 0xa2, 0xe7, 0x41, 0x92, 0xc9,
-	0x62, 0x41, 0x20, 0x34, 0xd3, 0x5c, 0x04, 0x6a, 0xb8, 0xdd, 0x38, 0x1a, 0xbc, 0xa1, 0x28, 0x17,
-	0xc9, 0xaa, 0xcf, 0x9c, 0x3e, 0x0f, 0x40, 0xde, 0x58, 0xa3, 0xc2, 0xca, 0xf2, 0x41, 0x33, 0x2b,
-	0x6e, 0x5b, 0x3d, 0x08, 0x40, 0x32, 0x0d, 0xee, 0xe8, 0xab, 0xf3, 0x1e, 0xd7, 0xfd, 0xa8, 0x4b,
-	0x1d, 0xe1, 0x5b, 0x4c, 0xf6, 0x44, 0x28, 0xc5, 0xa7, 0xe9, 0xa0, 0x95, 0x55, 0x57, 0x79, 0x92,
-	0x2c, 0x64, 0xc5, 0x6d, 0xe6, 0x85, 0x7d, 0x36, 0x91, 0xce, 0xfc, 0x11, 0xe1, 0xda, 0x19, 0x57,
-	0xfa, 0xad, 0x21, 0xb2, 0xfb, 0x61, 0x96, 0xc4, 0x86, 0xcf, 0x22, 0x50, 0x9a, 0x74, 0xf0, 0x9a,
-	0xc7, 0x95, 0xbe, 0x0c, 0x53, 0xf4, 0x2a, 0x6a, 0xa0, 0xe6, 0xda, 0x41, 0x9b, 0x0e, 0xd9, 0xe9,
-	0x5d, 0x76, 0x1a, 0x0e, 0x7a, 0x49, 0x40, 0xd1, 0x84, 0x9d, 0xc6, 0x6d, 0x7a, 0x96, 0x7f, 0x68,
-	0xdf, 0xcd, 0x42, 0xea, 0x18, 0x07, 0xcc, 0x87, 0xf7, 0x25, 0x5c, 0xf1, 0xcf, 0xab, 0x95, 0x06,
-	0x6a, 0xae, 0xda, 0x77, 0x22, 0xa4, 0x86, 0x57, 0x93, 0x99, 0x0a, 0x99, 0x03, 0xd5, 0x95, 0x74,
-	0x39, 0x0f, 0x98, 0x9f, 0x60, 0xe3, 0x5d, 0x98, 0x20, 0xce, 0x80, 0x9f, 0xc5, 0x2b, 0x11, 0x77,
-	0x53, 0xd0, 0x55, 0x3b, 0x19, 0xde, 0xcf, 0x56, 0x29, 0x64, 0x23, 0x04, 0x3f, 0x4a, 0x26, 0xa3,
-	0x32, 0xe9, 0xd8, 0xbc, 0xc4, 0x1b, 0x27, 0xe0, 0x81, 0x86, 0x25, 0x15, 0x31, 0x5f, 0xc2, 0x9b,
-	0xc5, 0x54, 0xc3, 0x02, 0xae, 0x0d, 0x2a, 0x14, 0x81, 0x02, 0xf3, 0x04, 0xbf, 0x5c, 0x76, 0x11,
-	0x67, 0xac, 0x0b, 0xde, 0x29, 0xdc, 0x8c, 0x2f, 0xe4, 0x5e, 0x21, 0x54, 0x2c, 0xf4, 0x1d, 0xc2,
-	0x5b, 0x53, 0xd3, 0x7c, 0xc0, 0xbc, 0x08, 0x1e, 0xf6, 0x66, 0x67, 0x1f, 0xc3, 0xdf, 0x08, 0xd7,
-	0x6c, 0xd0, 0xf2, 0x66, 0xf1, 0x73, 0xcd, 0xae, 0xa7, 0x92, 0x5f, 0xcf, 0xec, 0xf6, 0x20, 0xaf,
-	0xe2, 0xe7, 0x24, 0x28, 0xcd, 0xa4, 0xee, 0x44, 0x8e, 0x03, 0x4a, 0x5d, 0x45, 0x5e, 0xf5, 0x51,
-	0x03, 0x35, 0x9f, 0xda, 0x93, 0x0b, 0xc9, 0xee, 0x40, 0xb8, 0xf0, 0x0e, 0x07, 0xcf, 0xed, 0x80,
-	0x07, 0x8e, 0x16, 0xb2, 0xfa, 0x38, 0xcd, 0x39, 0xb9, 0x90, 0x34, 0x6e, 0xc8, 0x24, 0xf3, 0x41,
-	0x83, 0x54, 0xd5, 0x27, 0x8d, 0x95, 0xa4, 0x71, 0xf3, 0x88, 0xf9, 0x3d, 0xc2, 0x9b, 0x36, 0xa8,
-	0xa8, 0xeb, 0x73, 0xfd, 0x90, 0x1a, 0x0d, 0xfc, 0xd4, 0x07, 0x5f, 0xf0, 0x2f, 0xc0, 0x1d, 0x49,
-	0x1b, 0xcf, 0x0b, 0x8c, 0x8f, 0x8b, 0x8c, 0x07, 0xdf, 0xac, 0xe1, 0x17, 0x8b, 0x6c, 0x1d, 0x90,
-	0x31, 0x77, 0x80, 0xfc, 0x8c, 0xf0, 0x7a, 0xa9, 0x1d, 0x90, 0x16, 0x2d, 0xb8, 0x1b, 0x9d, 0x65,
-	0x1b, 0xc6, 0x05, 0xcd, 0x7d, 0x8a, 0x66, 0x3e, 0x95, 0x0e, 0x3e, 0x1e, 0xfb, 0x14, 0x8d, 0x0f,
-	0xf3, 0xce, 0xca, 0xa2, 0x34, 0xb3, 0x2a, 0x3a, 0x6e, 0x5d, 0xae, 0xb4, 0x69, 0x7e, 0xfd, 0xe7,
-	0xbf, 0xdf, 0x56, 0x6a, 0xc4, 0x48, 0xcd, 0x34, 0x6e, 0x5b, 0x23, 0x0a, 0x37, 0xb7, 0x3d, 0xf2,
-	0x13, 0xc2, 0xcf, 0x97, 0x18, 0x03, 0xd9, 0x9b, 0x40, 0x9f, 0x6e, 0x1f, 0xc6, 0x7b, 0xcb, 0x03,
-	0x37, 0x9b, 0x29, 0xb4, 0x49, 0x1a, 0xd3, 0xa1, 0xad, 0x2f, 0x23, 0xee, 0x7e, 0x45, 0x7e, 0x40,
-	0xf8, 0x85, 0x72, 0xc7, 0x21, 0x74, 0x82, 0x7e, 0xa6, 0x35, 0x19, 0xfb, 0x13, 0xfb, 0xe7, 0x39,
-	0xcf, 0x08, 0x73, 0x77, 0x3e, 0xe6, 0x1f, 0x08, 0x6f, 0xcc, 0x34, 0x29, 0xf2, 0xda, 0x42, 0x6d,
-	0x52, 0x34, 0x35, 0xe3, 0xf4, 0xff, 0x9f, 0xfa, 0x38, 0xa7, 0xd9, 0x4a, 0xf5, 0x6c, 0x93, 0x57,
-	0xa6, 0xeb, 0x69, 0x79, 0xc9, 0xee, 0xd6, 0x20, 0x41, 0xfe, 0x0b, 0xe1, 0xcd, 0x39, 0x96, 0x49,
-	0x5e, 0x5f, 0x5c, 0xd6, 0x3d, 0x93, 0x35, 0xce, 0x97, 0x24, 0x6c, 0x98, 0xd5, 0xb4, 0x52, 0x69,
-	0x3b, 0x64, 0x7b, 0xae, 0xb4, 0x78, 0x08, 0xfe, 0x0b, 0xc2, 0xeb, 0xa5, 0x8e, 0x5b, 0xf2, 0x43,
-	0xcf, 0x72, 0xe6, 0xa5, 0xfe, 0x17, 0xed, 0x54, 0xc5, 0x9e, 0xb1, 0x35, 0xaf, 0xe1, 0x2c, 0x99,
-	0x20, 0x1d, 0xa3, 0x5d, 0xf2, 0x1b, 0xc2, 0xd5, 0x69, 0xc6, 0x4a, 0xf6, 0x4b, 0xa4, 0xcc, 0xf4,
-	0xe0, 0xa5, 0xaa, 0x39, 0x4a, 0xd5, 0x50, 0x63, 0x67, 0x01, 0x35, 0x43, 0xaa, 0x63, 0xb4, 0xfb,
-	0xf6, 0xc5, 0xaf, 0xb7, 0x75, 0xf4, 0xfb, 0x6d, 0x1d, 0xfd, 0x73, 0x5b, 0x47, 0x1f, 0xbd, 0xb9,
-	0xf8, 0xab, 0xae, 0xfc, 0x4d, 0xda, 0x7d, 0x92, 0xbe, 0xe7, 0x0e, 0xff, 0x0b, 0x00, 0x00, 0xff,
-	0xff, 0xd4, 0x50, 0x60, 0x0e, 0xbb, 0x0a, 0x00, 0x00,
+	// 1025 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0xd7, 0x6c, 0xda, 0xb4, 0x99, 0x14, 0x0a, 0x13, 0xb5, 0x58, 0x56, 0x9a, 0x2c, 0x56, 0x48,
+	0xd3, 0x84, 0xb5, 0xbb, 0x49, 0x10, 0xa8, 0x27, 0x52, 0x45, 0xa0, 0xb6, 0x69, 0x5a, 0x39, 0x7c,
+	0x48, 0x5c, 0x60, 0x62, 0xbf, 0xec, 0x0e, 0xeb, 0x2f, 0x3c, 0xe3, 0x2d, 0x0b, 0xe2, 0xc2, 0xbf,
+	0xc0, 0xa9, 0xe2, 0x80, 0x90, 0xb8, 0xc1, 0x85, 0x1b, 0xe2, 0x8e, 0xc4, 0x09, 0x21, 0xb8, 0x71,
+	0x42, 0x11, 0x7f, 0x08, 0x9a, 0xb1, 0xbd, 0xde, 0xf5, 0x7a, 0x3f, 0x10, 0xdb, 0x9b, 0xe7, 0xcd,
+	0xbc, 0xdf, 0xfb, 0xbd, 0x37, 0x6f, 0xe6, 0x37, 0xc6, 0xfb, 0x51, 0xa7, 0x65, 0xd1, 0x88, 0x39,
+	0x1e, 0x83, 0x40, 0x58, 0x4f, 0xc2, 0xb8, 0x73, 0xe6, 0x85, 0x4f, 0x68, 0xec, 0xb4, 0x59, 0x17,
+	0xfa, 0xe3, 0x46, 0x66, 0x30, 0xa3, 0x38, 0x14, 0x21, 0xb9, 0x5a, 0x5a, 0xa7, 0xaf, 0xb6, 0xc2,
+	0xb0, 0xe5, 0x81, 0x44, 0xb2, 0x68, 0x10, 0x84, 0x82, 0x0a, 0x16, 0x06, 0x3c, 0x5d, 0xae, 0xef,
+	0x77, 0xde, 0xe0, 0x26, 0x0b, 0xe5, 0xac, 0x4f, 0x9d, 0x36, 0x0b, 0x20, 0xee, 0x59, 0x59, 0x60,
+	0x6e, 0xf9, 0x20, 0xa8, 0xd5, 0x6d, 0x5a, 0x2d, 0x08, 0x20, 0xa6, 0x02, 0xdc, 0xcc, 0xeb, 0x61,
+	0x8b, 0x89, 0x76, 0x72, 0x6a, 0x3a, 0xa1, 0x6f, 0xd1, 0xb8, 0x15, 0x46, 0x71, 0xf8, 0xb1, 0xfa,
+	0x68, 0xe4, 0xd1, 0x79, 0x01, 0x92, 0x9b, 0xac, 0x6e, 0x93, 0x7a, 0x51, 0x9b, 0x8e, 0xc0, 0x19,
+	0x3f, 0x22, 0xbc, 0x7a, 0xc4, 0xb8, 0x38, 0x48, 0x29, 0xbb, 0xef, 0xe7, 0x20, 0x36, 0x7c, 0x92,
+	0x00, 0x17, 0xe4, 0x04, 0x2f, 0x7b, 0x8c, 0x8b, 0x47, 0x91, 0xa2, 0xae, 0xa1, 0x3a, 0xda, 0x5a,
+	0xde, 0x6d, 0x9a, 0x29, 0x77, 0x73, 0x90, 0xbb, 0x19, 0x75, 0x5a, 0xd2, 0xc0, 0x4d, 0xc9, 0xdd,
+	0xec, 0x36, 0xcd, 0xa3, 0xc2, 0xd1, 0x1e, 0x44, 0x21, 0x6b, 0x18, 0x07, 0xd4, 0x87, 0xc7, 0x31,
+	0x9c, 0xb1, 0x4f, 0xb5, 0x5a, 0x1d, 0x6d, 0x2d, 0xd9, 0x03, 0x16, 0xb2, 0x8a, 0x97, 0xe4, 0x88,
+	0x47, 0xd4, 0x01, 0x6d, 0x41, 0x4d, 0x17, 0x06, 0xe3, 0x23, 0xac, 0xbf, 0x0d, 0x23, 0x8c, 0x73,
+	0xc2, 0x2f, 0xe0, 0x85, 0x84, 0xb9, 0x8a, 0xe8, 0x92, 0x2d, 0x3f, 0x87, 0xd1, 0x6a, 0x25, 0x34,
+	0x42, 0xf0, 0x05, 0x39, 0xc8, 0xc2, 0xa8, 0x6f, 0xe3, 0x11, 0xbe, 0x71, 0x08, 0x1e, 0x08, 0x98,
+	0x53, 0x10, 0xe3, 0x65, 0xbc, 0x5e, 0x86, 0x4a, 0x03, 0xb8, 0x36, 0xf0, 0x28, 0x0c, 0x38, 0x18,
+	0x87, 0x78, 0xa3, 0x6a, 0x23, 0x8e, 0xe8, 0x29, 0x78, 0x0f, 0xa0, 0xd7, 0xdf, 0x90, 0xa1, 0x40,
+	0xa8, 0x1c, 0xe8, 0x6b, 0x84, 0x37, 0xc7, 0xc2, 0xbc, 0x47, 0xbd, 0x04, 0x9e, 0xed, 0xce, 0x4e,
+	0x2e, 0xc3, 0x0f, 0x35, 0xbc, 0x6a, 0x83, 0x88, 0x7b, 0xb3, 0xd7, 0x35, 0xdf, 0x9e, 0x5a, 0xb1,
+	0x3d, 0x93, 0xdb, 0x83, 0xbc, 0x8a, 0x5f, 0x8c, 0x81, 0x0b, 0x1a, 0x8b, 0x93, 0xc4, 0x71, 0x80,
+	0xf3, 0xb3, 0xc4, 0xd3, 0x2e, 0xd4, 0xd1, 0xd6, 0x65, 0x7b, 0x74, 0x42, 0xae, 0x0e, 0x42, 0x17,
+	0xde, 0x62, 0xe0, 0xb9, 0x27, 0xe0, 0x81, 0x23, 0xc2, 0x58, 0xbb, 0xa8, 0x30, 0x47, 0x27, 0x64,
+	0xe3, 0x46, 0x34, 0xa6, 0x3e, 0x08, 0x88, 0xb9, 0xb6, 0x58, 0x5f, 0x90, 0x8d, 0x5b, 0x58, 0xc8,
+	0x26, 0x7e, 0x5e, 0x3a, 0x3d, 0x2e, 0xd6, 0x5c, 0x52, 0x6b, 0x4a, 0x56, 0xb2, 0x81, 0x9f, 0x93,
+	0x96, 0x83, 0x58, 0xb0, 0x33, 0xea, 0x08, 0xae, 0x5d, 0x56, 0xcb, 0x86, 0x8d, 0xc6, 0xb7, 0x08,
+	0xaf, 0xdb, 0xc0, 0x93, 0x53, 0x9f, 0x89, 0x67, 0x59, 0x31, 0x1d, 0x5f, 0xf6, 0xc1, 0x0f, 0xd9,
+	0x67, 0xe0, 0x66, 0x85, 0xea, 0x8f, 0x4b, 0x19, 0x5f, 0x2c, 0x67, 0x6c, 0x1c, 0x61, 0x3d, 0xed,
+	0xb7, 0x94, 0xf4, 0x11, 0x0b, 0x80, 0xb6, 0x60, 0x80, 0x5d, 0x07, 0x7a, 0x39, 0xbb, 0x0e, 0xf4,
+	0xa6, 0x34, 0xc8, 0xd3, 0x1a, 0x5e, 0x29, 0x41, 0x1d, 0x87, 0x2e, 0x90, 0x3a, 0x5e, 0xce, 0xef,
+	0xb2, 0x77, 0xef, 0x1d, 0x66, 0x78, 0x83, 0x26, 0x62, 0xe0, 0x2b, 0xf9, 0xf0, 0xb8, 0xc8, 0x7e,
+	0xc8, 0x36, 0xa5, 0x0a, 0xd7, 0xf1, 0xa2, 0x2c, 0xff, 0xbd, 0x43, 0x55, 0x83, 0x25, 0x3b, 0x1b,
+	0x49, 0x64, 0x9a, 0x51, 0x52, 0xc8, 0x69, 0x73, 0x0c, 0xd9, 0xa4, 0xaf, 0xcb, 0x5a, 0xc0, 0x85,
+	0xb6, 0x98, 0xfa, 0xa6, 0x23, 0x72, 0x1f, 0xe3, 0x33, 0x16, 0x30, 0xde, 0x06, 0xf7, 0x40, 0x68,
+	0x97, 0xd4, 0x11, 0xdb, 0x9e, 0xed, 0x88, 0xbd, 0xc3, 0x7c, 0xb0, 0x07, 0xbc, 0x8d, 0xef, 0x11,
+	0xbe, 0x5a, 0xaa, 0x4d, 0x45, 0x7d, 0xef, 0xe2, 0xa5, 0x28, 0x0e, 0xdd, 0xc4, 0x91, 0xdb, 0x55,
+	0xab, 0x2f, 0x6c, 0x2d, 0xef, 0x6e, 0x98, 0x25, 0x61, 0x32, 0x2b, 0x4a, 0x6c, 0x17, 0x6e, 0x12,
+	0xc3, 0x09, 0x03, 0x9e, 0xf8, 0x12, 0x63, 0xe1, 0xbf, 0x60, 0xf4, 0xdd, 0x76, 0xbf, 0xb9, 0x82,
+	0x5f, 0x2a, 0xf7, 0xec, 0x09, 0xc4, 0x5d, 0xe6, 0x00, 0xf9, 0x19, 0xe1, 0x6b, 0x95, 0xa2, 0x43,
+	0x1a, 0x23, 0x61, 0x26, 0x89, 0x93, 0x7e, 0x6c, 0x16, 0x6a, 0x68, 0xe6, 0x6a, 0xa8, 0x3e, 0x3e,
+	0xec, 0xab, 0xa1, 0xd9, 0xdd, 0x2b, 0x8a, 0x9b, 0x5b, 0xcd, 0x5c, 0x10, 0xcd, 0xfe, 0x05, 0xc9,
+	0xb8, 0x30, 0x8c, 0x2f, 0xff, 0xfc, 0xe7, 0xab, 0xda, 0x2a, 0xd1, 0x95, 0x64, 0x77, 0x9b, 0x56,
+	0xc6, 0xc2, 0x2d, 0xc4, 0x95, 0xfc, 0x84, 0xf0, 0x4a, 0x85, 0xfc, 0x90, 0x9d, 0x11, 0xea, 0xe3,
+	0x45, 0x4a, 0xbf, 0x3f, 0x3f, 0xe2, 0xc6, 0x96, 0x22, 0x6d, 0x90, 0xfa, 0x78, 0xd2, 0xd6, 0xe7,
+	0x09, 0x73, 0xbf, 0x20, 0xdf, 0x21, 0x7c, 0xbd, 0x5a, 0xd7, 0x88, 0x39, 0xc2, 0x7e, 0xa2, 0x00,
+	0xea, 0xb7, 0x2b, 0xfa, 0x61, 0xb2, 0xbe, 0x65, 0x34, 0xb7, 0xa7, 0xd3, 0xfc, 0x03, 0xe1, 0x1b,
+	0x13, 0xa5, 0x90, 0xbc, 0x36, 0x53, 0x9b, 0x94, 0xa5, 0x53, 0x7f, 0xf0, 0xff, 0xab, 0xde, 0xc7,
+	0x34, 0x1a, 0x2a, 0x9f, 0x9b, 0xe4, 0x95, 0xf1, 0xf9, 0x34, 0x3c, 0xb9, 0xba, 0xd1, 0x91, 0x94,
+	0xff, 0x42, 0x78, 0x7d, 0x8a, 0x30, 0x93, 0xd7, 0x67, 0x4f, 0x6b, 0x48, 0xca, 0xf5, 0x87, 0x73,
+	0x4a, 0x2c, 0x45, 0x35, 0x2c, 0x95, 0xda, 0x2d, 0x72, 0x73, 0x6a, 0x6a, 0xdd, 0x94, 0xf8, 0x53,
+	0x84, 0x57, 0x2a, 0x54, 0xa0, 0xe2, 0x4c, 0x8c, 0xd7, 0x0a, 0xbd, 0x3e, 0xed, 0x8a, 0x31, 0xf6,
+	0x14, 0xaf, 0x06, 0xd9, 0x99, 0xc0, 0x2b, 0xbf, 0x96, 0x1b, 0x5e, 0xc6, 0xe1, 0x17, 0x84, 0xaf,
+	0x55, 0xbe, 0x39, 0x2a, 0x2e, 0x9b, 0x49, 0x6f, 0x93, 0xb9, 0x9e, 0xd9, 0xa6, 0xca, 0x64, 0x47,
+	0xdf, 0x9c, 0x76, 0x18, 0xac, 0x58, 0x52, 0xba, 0x83, 0xb6, 0xc9, 0x6f, 0x08, 0x6b, 0xe3, 0x1e,
+	0x03, 0xe4, 0x76, 0x45, 0x2a, 0x13, 0xdf, 0x0d, 0x73, 0xcd, 0x66, 0x5f, 0x65, 0x63, 0xea, 0xb7,
+	0x66, 0xc8, 0x26, 0x65, 0x75, 0x07, 0x6d, 0xdf, 0x3d, 0xfe, 0xf5, 0x7c, 0x0d, 0xfd, 0x7e, 0xbe,
+	0x86, 0xfe, 0x3e, 0x5f, 0x43, 0x1f, 0xbc, 0x39, 0xfb, 0x7f, 0x4d, 0xf5, 0x5f, 0xd9, 0xe9, 0xa2,
+	0xfa, 0xa3, 0xd9, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0x3e, 0xe8, 0xe3, 0x5f, 0xbd, 0x0d, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -605,6 +852,7 @@ type ArchivedWorkflowServiceClient interface {
 	DeleteArchivedWorkflow(ctx context.Context, in *DeleteArchivedWorkflowRequest, opts ...grpc.CallOption) (*ArchivedWorkflowDeletedResponse, error)
 	ListArchivedWorkflowLabelKeys(ctx context.Context, in *ListArchivedWorkflowLabelKeysRequest, opts ...grpc.CallOption) (*v1alpha1.LabelKeys, error)
 	ListArchivedWorkflowLabelValues(ctx context.Context, in *ListArchivedWorkflowLabelValuesRequest, opts ...grpc.CallOption) (*v1alpha1.LabelValues, error)
+	ListArtifactLineage(ctx context.Context, in *ListArtifactLineageRequest, opts ...grpc.CallOption) (*ArtifactLineage, error)
 	RetryArchivedWorkflow(ctx context.Context, in *RetryArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 	ResubmitArchivedWorkflow(ctx context.Context, in *ResubmitArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error)
 }
@@ -662,6 +910,15 @@ func (c *archivedWorkflowServiceClient) ListArchivedWorkflowLabelValues(ctx cont
 	return out, nil
 }
 
+func (c *archivedWorkflowServiceClient) ListArtifactLineage(ctx context.Context, in *ListArtifactLineageRequest, opts ...grpc.CallOption) (*ArtifactLineage, error) {
+	out := new(ArtifactLineage)
+	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/ListArtifactLineage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *archivedWorkflowServiceClient) RetryArchivedWorkflow(ctx context.Context, in *RetryArchivedWorkflowRequest, opts ...grpc.CallOption) (*v1alpha1.Workflow, error) {
 	out := new(v1alpha1.Workflow)
 	err := c.cc.Invoke(ctx, "/workflowarchive.ArchivedWorkflowService/RetryArchivedWorkflow", in, out, opts...)
@@ -687,6 +944,7 @@ type ArchivedWorkflowServiceServer interface {
 	DeleteArchivedWorkflow(context.Context, *DeleteArchivedWorkflowRequest) (*ArchivedWorkflowDeletedResponse, error)
 	ListArchivedWorkflowLabelKeys(context.Context, *ListArchivedWorkflowLabelKeysRequest) (*v1alpha1.LabelKeys, error)
 	ListArchivedWorkflowLabelValues(context.Context, *ListArchivedWorkflowLabelValuesRequest) (*v1alpha1.LabelValues, error)
+	ListArtifactLineage(context.Context, *ListArtifactLineageRequest) (*ArtifactLineage, error)
 	RetryArchivedWorkflow(context.Context, *RetryArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
 	ResubmitArchivedWorkflow(context.Context, *ResubmitArchivedWorkflowRequest) (*v1alpha1.Workflow, error)
 }
@@ -710,6 +968,9 @@ func (*UnimplementedArchivedWorkflowServiceServer) ListArchivedWorkflowLabelKeys
 func (*UnimplementedArchivedWorkflowServiceServer) ListArchivedWorkflowLabelValues(ctx context.Context, req *ListArchivedWorkflowLabelValuesRequest) (*v1alpha1.LabelValues, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedWorkflowLabelValues not implemented")
 }
+func (*UnimplementedArchivedWorkflowServiceServer) ListArtifactLineage(ctx context.Context, req *ListArtifactLineageRequest) (*ArtifactLineage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArtifactLineage not implemented")
+}
 func (*UnimplementedArchivedWorkflowServiceServer) RetryArchivedWorkflow(ctx context.Context, req *RetryArchivedWorkflowRequest) (*v1alpha1.Workflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetryArchivedWorkflow not implemented")
 }
@@ -811,6 +1072,24 @@ func _ArchivedWorkflowService_ListArchivedWorkflowLabelValues_Handler(srv interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArchivedWorkflowService_ListArtifactLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArtifactLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchivedWorkflowServiceServer).ListArtifactLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowarchive.ArchivedWorkflowService/ListArtifactLineage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchivedWorkflowServiceServer).ListArtifactLineage(ctx, req.(*ListArtifactLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArchivedWorkflowService_RetryArchivedWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RetryArchivedWorkflowRequest)
 	if err := dec(in); err != nil {
@@ -871,6 +1150,10 @@ var _ArchivedWorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListArchivedWorkflowLabelValues",
 			Handler:    _ArchivedWorkflowService_ListArchivedWorkflowLabelValues_Handler,
 		},
+		{
+			MethodName: "ListArtifactLineage",
+			Handler:    _ArchivedWorkflowService_ListArtifactLineage_Handler,
+		},
 		{
 			MethodName: "RetryArchivedWorkflow",
 			Handler:    _ArchivedWorkflowService_RetryArchivedWorkflow_Handler,
@@ -1157,6 +1440,24 @@ func (m *RetryArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.NodeArtifacts) > 0 {
+		for iNdEx := len(m.NodeArtifacts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NodeArtifacts[iNdEx])
+			copy(dAtA[i:], m.NodeArtifacts[iNdEx])
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.NodeArtifacts[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.NodeParameters) > 0 {
+		for iNdEx := len(m.NodeParameters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NodeParameters[iNdEx])
+			copy(dAtA[i:], m.NodeParameters[iNdEx])
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.NodeParameters[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Parameters) > 0 {
 		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Parameters[iNdEx])
@@ -1274,64 +1575,248 @@ func (m *ResubmitArchivedWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintWorkflowArchive(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWorkflowArchive(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ListArtifactLineageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ListArchivedWorkflowsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ListArtifactLineageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListArtifactLineageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ListOptions != nil {
-		l = m.ListOptions.Size()
-		n += 1 + l + sovWorkflowArchive(uint64(l))
-	}
-	l = len(m.NamePrefix)
-	if l > 0 {
-		n += 1 + l + sovWorkflowArchive(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflowArchive(uint64(l))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetArchivedWorkflowRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ArtifactLineageNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactLineageNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactLineageNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Uid)
-	if l > 0 {
-		n += 1 + l + sovWorkflowArchive(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflowArchive(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovWorkflowArchive(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
+	if m.FinishedAt != nil {
+		{
+			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ArtifactName) > 0 {
+		i -= len(m.ArtifactName)
+		copy(dAtA[i:], m.ArtifactName)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.ArtifactName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NodeID) > 0 {
+		i -= len(m.NodeID)
+		copy(dAtA[i:], m.NodeID)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.NodeID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowName) > 0 {
+		i -= len(m.WorkflowName)
+		copy(dAtA[i:], m.WorkflowName)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.WorkflowName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WorkflowUID) > 0 {
+		i -= len(m.WorkflowUID)
+		copy(dAtA[i:], m.WorkflowUID)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.WorkflowUID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArtifactLineage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactLineage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactLineage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Consumers) > 0 {
+		for iNdEx := len(m.Consumers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Consumers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Producers) > 0 {
+		for iNdEx := len(m.Producers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Producers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflowArchive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWorkflowArchive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWorkflowArchive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ListArchivedWorkflowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ListOptions != nil {
+		l = m.ListOptions.Size()
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.NamePrefix)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetArchivedWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
 
 func (m *DeleteArchivedWorkflowRequest) Size() (n int) {
 	if m == nil {
@@ -1432,6 +1917,18 @@ func (m *RetryArchivedWorkflowRequest) Size() (n int) {
 			n += 1 + l + sovWorkflowArchive(uint64(l))
 		}
 	}
+	if len(m.NodeParameters) > 0 {
+		for _, s := range m.NodeParameters {
+			l = len(s)
+			n += 1 + l + sovWorkflowArchive(uint64(l))
+		}
+	}
+	if len(m.NodeArtifacts) > 0 {
+		for _, s := range m.NodeArtifacts {
+			l = len(s)
+			n += 1 + l + sovWorkflowArchive(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1471,6 +1968,94 @@ func (m *ResubmitArchivedWorkflowRequest) Size() (n int) {
 	return n
 }
 
+func (m *ListArtifactLineageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArtifactLineageNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkflowUID)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.WorkflowName)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.ArtifactName)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArtifactLineage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
+	if len(m.Producers) > 0 {
+		for _, e := range m.Producers {
+			l = e.Size()
+			n += 1 + l + sovWorkflowArchive(uint64(l))
+		}
+	}
+	if len(m.Consumers) > 0 {
+		for _, e := range m.Consumers {
+			l = e.Size()
+			n += 1 + l + sovWorkflowArchive(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovWorkflowArchive(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2352,39 +2937,103 @@ func (m *RetryArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeParameters", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthWorkflowArchive
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResubmitArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowWorkflowArchive
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
 			}
-			if iNdEx >= l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeParameters = append(m.NodeParameters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeArtifacts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeArtifacts = append(m.NodeArtifacts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResubmitArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
@@ -2573,6 +3222,551 @@ func (m *ResubmitArchivedWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ListArtifactLineageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListArtifactLineageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListArtifactLineageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactLineageNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactLineageNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactLineageNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArtifactName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactLineage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactLineage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactLineage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Producers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Producers = append(m.Producers, &ArtifactLineageNode{})
+			if err := m.Producers[len(m.Producers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumers = append(m.Consumers, &ArtifactLineageNode{})
+			if err := m.Consumers[len(m.Consumers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWorkflowArchive(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
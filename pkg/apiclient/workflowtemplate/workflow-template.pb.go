@@ -452,6 +452,226 @@ func (m *WorkflowTemplateLintRequest) GetCreateOptions() *v1.CreateOptions {
 	return nil
 }
 
+type WorkflowTemplateRevisionsRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowTemplateRevisionsRequest) Reset()         { *m = WorkflowTemplateRevisionsRequest{} }
+func (m *WorkflowTemplateRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowTemplateRevisionsRequest) ProtoMessage()    {}
+func (*WorkflowTemplateRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_215375a0ab97a62a, []int{7}
+}
+func (m *WorkflowTemplateRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTemplateRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowTemplateRevisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowTemplateRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTemplateRevisionsRequest.Merge(m, src)
+}
+func (m *WorkflowTemplateRevisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTemplateRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTemplateRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowTemplateRevisionsRequest proto.InternalMessageInfo
+
+func (m *WorkflowTemplateRevisionsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowTemplateRevisionsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type WorkflowTemplateRevision struct {
+	Revision             int64    `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	CreationTimestamp    *v1.Time `protobuf:"bytes,2,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowTemplateRevision) Reset()         { *m = WorkflowTemplateRevision{} }
+func (m *WorkflowTemplateRevision) String() string { return proto.CompactTextString(m) }
+func (*WorkflowTemplateRevision) ProtoMessage()    {}
+func (*WorkflowTemplateRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_215375a0ab97a62a, []int{8}
+}
+func (m *WorkflowTemplateRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTemplateRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowTemplateRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowTemplateRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTemplateRevision.Merge(m, src)
+}
+func (m *WorkflowTemplateRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTemplateRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTemplateRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowTemplateRevision proto.InternalMessageInfo
+
+func (m *WorkflowTemplateRevision) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *WorkflowTemplateRevision) GetCreationTimestamp() *v1.Time {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return nil
+}
+
+type WorkflowTemplateRevisionList struct {
+	Items                []*WorkflowTemplateRevision `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *WorkflowTemplateRevisionList) Reset()         { *m = WorkflowTemplateRevisionList{} }
+func (m *WorkflowTemplateRevisionList) String() string { return proto.CompactTextString(m) }
+func (*WorkflowTemplateRevisionList) ProtoMessage()    {}
+func (*WorkflowTemplateRevisionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_215375a0ab97a62a, []int{9}
+}
+func (m *WorkflowTemplateRevisionList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTemplateRevisionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowTemplateRevisionList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowTemplateRevisionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTemplateRevisionList.Merge(m, src)
+}
+func (m *WorkflowTemplateRevisionList) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTemplateRevisionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTemplateRevisionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowTemplateRevisionList proto.InternalMessageInfo
+
+func (m *WorkflowTemplateRevisionList) GetItems() []*WorkflowTemplateRevision {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type WorkflowTemplateRollbackRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Revision             int64    `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowTemplateRollbackRequest) Reset()         { *m = WorkflowTemplateRollbackRequest{} }
+func (m *WorkflowTemplateRollbackRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowTemplateRollbackRequest) ProtoMessage()    {}
+func (*WorkflowTemplateRollbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_215375a0ab97a62a, []int{10}
+}
+func (m *WorkflowTemplateRollbackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTemplateRollbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowTemplateRollbackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WorkflowTemplateRollbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTemplateRollbackRequest.Merge(m, src)
+}
+func (m *WorkflowTemplateRollbackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTemplateRollbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTemplateRollbackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowTemplateRollbackRequest proto.InternalMessageInfo
+
+func (m *WorkflowTemplateRollbackRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkflowTemplateRollbackRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WorkflowTemplateRollbackRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*WorkflowTemplateCreateRequest)(nil), "workflowtemplate.WorkflowTemplateCreateRequest")
 	proto.RegisterType((*WorkflowTemplateGetRequest)(nil), "workflowtemplate.WorkflowTemplateGetRequest")
@@ -460,6 +680,10 @@ func init() {
 	proto.RegisterType((*WorkflowTemplateDeleteRequest)(nil), "workflowtemplate.WorkflowTemplateDeleteRequest")
 	proto.RegisterType((*WorkflowTemplateDeleteResponse)(nil), "workflowtemplate.WorkflowTemplateDeleteResponse")
 	proto.RegisterType((*WorkflowTemplateLintRequest)(nil), "workflowtemplate.WorkflowTemplateLintRequest")
+	proto.RegisterType((*WorkflowTemplateRevisionsRequest)(nil), "workflowtemplate.WorkflowTemplateRevisionsRequest")
+	proto.RegisterType((*WorkflowTemplateRevision)(nil), "workflowtemplate.WorkflowTemplateRevision")
+	proto.RegisterType((*WorkflowTemplateRevisionList)(nil), "workflowtemplate.WorkflowTemplateRevisionList")
+	proto.RegisterType((*WorkflowTemplateRollbackRequest)(nil), "workflowtemplate.WorkflowTemplateRollbackRequest")
 }
 
 func init() {
@@ -467,51 +691,61 @@ func init() {
 }
 
 var fileDescriptor_215375a0ab97a62a = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0xcf, 0x4e, 0x13, 0x41,
-	0x1c, 0xce, 0x14, 0x63, 0x64, 0x08, 0x89, 0x19, 0xb5, 0x36, 0x2b, 0x36, 0xcd, 0x1e, 0x0c, 0x01,
-	0x3b, 0x43, 0x41, 0x09, 0xe1, 0x26, 0x90, 0x70, 0xc1, 0x40, 0x16, 0xff, 0x04, 0x2f, 0x66, 0x28,
-	0x3f, 0x97, 0xb5, 0xdb, 0x99, 0x75, 0x67, 0x28, 0x31, 0x86, 0x8b, 0x07, 0xe3, 0xdd, 0x17, 0xf0,
-	0x01, 0x3c, 0xf1, 0x0e, 0x26, 0x9e, 0x0c, 0xc6, 0x83, 0x57, 0x43, 0x7c, 0x01, 0xdf, 0xc0, 0xec,
-	0x74, 0xb7, 0xbb, 0xdd, 0x82, 0x2c, 0xc4, 0x9e, 0xbc, 0x4d, 0xa7, 0xf3, 0xfb, 0xfd, 0xbe, 0xef,
-	0x9b, 0x6f, 0xbf, 0x5d, 0x3c, 0x1f, 0xb4, 0x5c, 0xc6, 0x03, 0xaf, 0xe9, 0x7b, 0x20, 0x34, 0xdb,
-	0x97, 0x61, 0xeb, 0x85, 0x2f, 0xf7, 0x35, 0xb4, 0x03, 0x9f, 0x6b, 0xe8, 0x6d, 0xd4, 0x93, 0x1d,
-	0x1a, 0x84, 0x52, 0x4b, 0x72, 0x35, 0x7f, 0xd2, 0x9a, 0x70, 0xa5, 0x74, 0x7d, 0x88, 0x9a, 0x31,
-	0x2e, 0x84, 0xd4, 0x5c, 0x7b, 0x52, 0xa8, 0xee, 0x79, 0xeb, 0x5e, 0x6b, 0x41, 0x51, 0x4f, 0x46,
-	0xff, 0xb6, 0x79, 0x73, 0xd7, 0x13, 0x10, 0xbe, 0x66, 0xf1, 0x6c, 0xc5, 0xda, 0xa0, 0x39, 0xeb,
-	0x34, 0x98, 0x0b, 0x02, 0x42, 0xae, 0x61, 0x27, 0xae, 0x7a, 0xe8, 0x7a, 0x7a, 0x77, 0x6f, 0x9b,
-	0x36, 0x65, 0x9b, 0xf1, 0xd0, 0x95, 0x41, 0x28, 0x5f, 0x9a, 0x45, 0x3d, 0x19, 0xaf, 0xd2, 0x26,
-	0xc9, 0x16, 0xeb, 0x34, 0xb8, 0x1f, 0xec, 0xf2, 0x81, 0x76, 0xf6, 0xfb, 0x12, 0xbe, 0xfd, 0x34,
-	0x3e, 0xf5, 0x28, 0xc6, 0xbd, 0x1c, 0x02, 0xd7, 0xe0, 0xc0, 0xab, 0x3d, 0x50, 0x9a, 0x4c, 0xe0,
-	0x51, 0xc1, 0xdb, 0xa0, 0x02, 0xde, 0x84, 0x0a, 0xaa, 0xa1, 0xc9, 0x51, 0x27, 0xdd, 0x20, 0x02,
-	0x5f, 0x49, 0xe8, 0x56, 0x4a, 0x35, 0x34, 0x39, 0x36, 0xeb, 0xd0, 0x14, 0x21, 0x4d, 0x10, 0x9a,
-	0xc5, 0xf3, 0x1e, 0x42, 0xda, 0x99, 0xa3, 0x41, 0xcb, 0xa5, 0x11, 0x48, 0x9a, 0xec, 0xd2, 0x04,
-	0x24, 0xcd, 0x03, 0x72, 0x7a, 0x33, 0xc8, 0x16, 0x1e, 0x6f, 0x1a, 0x78, 0xeb, 0x81, 0xd1, 0xb2,
-	0x32, 0x62, 0x86, 0xce, 0xd1, 0xae, 0x98, 0x34, 0x2b, 0x66, 0x3a, 0x22, 0x12, 0x93, 0x76, 0x1a,
-	0x74, 0x39, 0x5b, 0xea, 0xf4, 0x77, 0xb2, 0x3f, 0x22, 0x6c, 0xe5, 0x27, 0xaf, 0x82, 0x4e, 0x74,
-	0x20, 0xf8, 0x52, 0x44, 0x3b, 0x96, 0xc0, 0xac, 0xfb, 0xb5, 0x29, 0xe5, 0xb5, 0xd9, 0xc0, 0xd8,
-	0x05, 0xdd, 0x0f, 0x74, 0xa6, 0x18, 0xd0, 0xd5, 0x5e, 0x9d, 0x93, 0xe9, 0x61, 0x1f, 0x22, 0x7c,
-	0x2b, 0x0f, 0x71, 0xcd, 0x53, 0xba, 0xd8, 0x5d, 0xd5, 0xf0, 0x58, 0xf4, 0x63, 0x83, 0x6b, 0x0d,
-	0xa1, 0x88, 0xf1, 0x66, 0xb7, 0xc8, 0x26, 0x1e, 0xf3, 0x3d, 0x95, 0x83, 0xdc, 0x28, 0x06, 0x79,
-	0x2d, 0x2d, 0x74, 0xb2, 0x5d, 0xec, 0xcf, 0x68, 0xd0, 0x62, 0x8f, 0x83, 0x9d, 0x8c, 0xc5, 0xca,
-	0x59, 0x69, 0x97, 0x4a, 0x15, 0x54, 0x48, 0xde, 0xac, 0xf5, 0x46, 0x86, 0x6f, 0x3d, 0xfb, 0xd3,
-	0x09, 0x3c, 0x56, 0xc0, 0x87, 0x94, 0xc7, 0xf9, 0x2d, 0xb2, 0x85, 0xc7, 0x77, 0x4c, 0x8b, 0x0b,
-	0xd9, 0x79, 0x25, 0x5b, 0xea, 0xf4, 0x77, 0xb2, 0x6b, 0xb8, 0x7a, 0x1a, 0x5a, 0x15, 0x48, 0xa1,
-	0xc0, 0x7e, 0x57, 0x3a, 0xc9, 0x4d, 0x42, 0xff, 0x6f, 0x4f, 0xfe, 0xec, 0xef, 0x51, 0x7c, 0x33,
-	0x3f, 0x79, 0x13, 0xc2, 0x8e, 0xd7, 0x04, 0x72, 0x84, 0x70, 0xb9, 0x5b, 0x9c, 0x3f, 0x41, 0x18,
-	0xcd, 0x27, 0x3e, 0xfd, 0x6b, 0x94, 0x5a, 0x43, 0x10, 0xc8, 0x6e, 0xbc, 0xfd, 0xfe, 0xeb, 0x43,
-	0x69, 0xda, 0xbe, 0x63, 0xde, 0x32, 0x9d, 0xc6, 0xe0, 0xeb, 0x49, 0xb1, 0x37, 0xbd, 0x5b, 0x3b,
-	0x58, 0x44, 0x53, 0xe4, 0x2b, 0xc2, 0xd7, 0x56, 0x41, 0x0f, 0xf0, 0xb9, 0x7b, 0x36, 0x9f, 0x34,
-	0x0f, 0x87, 0x42, 0xe6, 0xbe, 0x21, 0xc3, 0x48, 0xbd, 0x18, 0x99, 0xee, 0xfa, 0x20, 0x22, 0x74,
-	0x23, 0x8a, 0x9f, 0x7c, 0x3f, 0x45, 0xea, 0x67, 0x53, 0xca, 0xe4, 0xa7, 0xf5, 0xe4, 0xdf, 0x73,
-	0x8a, 0xda, 0xdb, 0xd4, 0xf0, 0x9a, 0x24, 0x05, 0x2f, 0x89, 0xfc, 0x40, 0xb8, 0xdc, 0x8d, 0xc8,
-	0x8b, 0x98, 0xae, 0x2f, 0x5c, 0x87, 0x72, 0x4f, 0x0b, 0x86, 0xcf, 0xac, 0x75, 0xbe, 0x7b, 0x8a,
-	0xbc, 0x77, 0x88, 0x70, 0xb9, 0x1b, 0x43, 0x17, 0x61, 0xd6, 0x17, 0xb7, 0xd6, 0x4c, 0xf1, 0x82,
-	0x38, 0xf1, 0x62, 0x7f, 0x4d, 0x9d, 0xd3, 0x5f, 0xdf, 0x10, 0xbe, 0x1e, 0x05, 0xe3, 0x00, 0xe4,
-	0x42, 0xf6, 0x12, 0x43, 0x7d, 0x64, 0xe6, 0x0d, 0xa5, 0x19, 0x7b, 0xba, 0x20, 0x25, 0xdf, 0x13,
-	0x7a, 0x11, 0x4d, 0x2d, 0xad, 0x7f, 0x39, 0xae, 0xa2, 0xa3, 0xe3, 0x2a, 0xfa, 0x79, 0x5c, 0x45,
-	0xcf, 0x1e, 0x14, 0xff, 0xaa, 0x3c, 0xe5, 0xb3, 0x78, 0xfb, 0xb2, 0xf9, 0xa0, 0x9c, 0xfb, 0x13,
-	0x00, 0x00, 0xff, 0xff, 0xa4, 0xd4, 0xed, 0x3d, 0x3f, 0x0b, 0x00, 0x00,
+	// 863 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xd5, 0x24, 0x80, 0xda, 0xa9, 0x2a, 0xc1, 0x00, 0x21, 0x32, 0x6d, 0x88, 0xbc, 0x40, 0x55,
+	0x4a, 0xc6, 0x4d, 0x0a, 0xa5, 0x94, 0x05, 0xf4, 0x21, 0x75, 0x53, 0xd4, 0xca, 0x2d, 0x8f, 0xb2,
+	0x81, 0x69, 0x3a, 0xb8, 0x26, 0xb6, 0xc7, 0xd8, 0xd3, 0x54, 0x08, 0x75, 0xc3, 0x02, 0xb1, 0x44,
+	0xe2, 0x03, 0xe0, 0x03, 0x58, 0xf5, 0x1b, 0x40, 0x62, 0x85, 0x8a, 0x58, 0xb0, 0x45, 0x55, 0x3f,
+	0x04, 0x79, 0x62, 0x3b, 0x7e, 0x34, 0xaa, 0x13, 0x91, 0x15, 0xbb, 0xf1, 0x64, 0xee, 0xbd, 0xe7,
+	0xdc, 0x7b, 0x26, 0xc7, 0x86, 0x33, 0x76, 0x53, 0x53, 0x88, 0xad, 0x37, 0x0c, 0x9d, 0x5a, 0x5c,
+	0xd9, 0x63, 0x4e, 0xf3, 0x85, 0xc1, 0xf6, 0x38, 0x35, 0x6d, 0x83, 0x70, 0x1a, 0x6e, 0x54, 0x83,
+	0x1d, 0x6c, 0x3b, 0x8c, 0x33, 0x74, 0x3e, 0x79, 0x52, 0x1a, 0xd3, 0x18, 0xd3, 0x0c, 0xea, 0x25,
+	0x53, 0x88, 0x65, 0x31, 0x4e, 0xb8, 0xce, 0x2c, 0xb7, 0x7d, 0x5e, 0xba, 0xd9, 0x9c, 0x75, 0xb1,
+	0xce, 0xbc, 0x5f, 0x4d, 0xd2, 0xd8, 0xd1, 0x2d, 0xea, 0xbc, 0x56, 0xfc, 0xda, 0xae, 0x62, 0x52,
+	0x4e, 0x94, 0x56, 0x4d, 0xd1, 0xa8, 0x45, 0x1d, 0xc2, 0xe9, 0xb6, 0x1f, 0xf5, 0x40, 0xd3, 0xf9,
+	0xce, 0xee, 0x16, 0x6e, 0x30, 0x53, 0x21, 0x8e, 0xc6, 0x6c, 0x87, 0xbd, 0x14, 0x8b, 0x6a, 0x50,
+	0xde, 0xed, 0x24, 0x09, 0xb6, 0x94, 0x56, 0x8d, 0x18, 0xf6, 0x0e, 0x49, 0xa5, 0x93, 0xdf, 0xe7,
+	0xe0, 0xf8, 0x63, 0xff, 0xd4, 0x86, 0x8f, 0x7b, 0xd1, 0xa1, 0x84, 0x53, 0x95, 0xbe, 0xda, 0xa5,
+	0x2e, 0x47, 0x63, 0x70, 0xd8, 0x22, 0x26, 0x75, 0x6d, 0xd2, 0xa0, 0x45, 0x50, 0x06, 0x13, 0xc3,
+	0x6a, 0x67, 0x03, 0x59, 0x70, 0x28, 0xa0, 0x5b, 0xcc, 0x95, 0xc1, 0xc4, 0x48, 0x5d, 0xc5, 0x1d,
+	0x84, 0x38, 0x40, 0x28, 0x16, 0xcf, 0x42, 0x84, 0xb8, 0x35, 0x8d, 0xed, 0xa6, 0x86, 0x3d, 0x90,
+	0x38, 0xd8, 0xc5, 0x01, 0x48, 0x9c, 0x04, 0xa4, 0x86, 0x35, 0xd0, 0x26, 0x1c, 0x6d, 0x08, 0x78,
+	0xab, 0xb6, 0xe8, 0x65, 0x31, 0x2f, 0x8a, 0x4e, 0xe3, 0x76, 0x33, 0x71, 0xb4, 0x99, 0x9d, 0x12,
+	0x5e, 0x33, 0x71, 0xab, 0x86, 0x17, 0xa3, 0xa1, 0x6a, 0x3c, 0x93, 0xfc, 0x19, 0x40, 0x29, 0x59,
+	0x79, 0x99, 0xf2, 0xa0, 0x0f, 0x08, 0x9e, 0xf1, 0x68, 0xfb, 0x2d, 0x10, 0xeb, 0x78, 0x6f, 0x72,
+	0xc9, 0xde, 0xac, 0x41, 0xa8, 0x51, 0x1e, 0x07, 0x3a, 0x95, 0x0d, 0xe8, 0x72, 0x18, 0xa7, 0x46,
+	0x72, 0xc8, 0x07, 0x00, 0x5e, 0x4d, 0x42, 0x5c, 0xd1, 0x5d, 0x9e, 0x6d, 0x56, 0x65, 0x38, 0xe2,
+	0x3d, 0xac, 0x11, 0xce, 0xa9, 0x63, 0xf9, 0x78, 0xa3, 0x5b, 0x68, 0x1d, 0x8e, 0x18, 0xba, 0x9b,
+	0x80, 0x5c, 0xcb, 0x06, 0x79, 0xa5, 0x13, 0xa8, 0x46, 0xb3, 0xc8, 0xdf, 0x40, 0x5a, 0x62, 0x0f,
+	0xed, 0xed, 0x88, 0xc4, 0x0a, 0xd1, 0xd6, 0x2e, 0xe4, 0x8a, 0x20, 0x53, 0x7b, 0xa3, 0xd2, 0xcb,
+	0x0f, 0x5e, 0x7a, 0xf2, 0x97, 0x13, 0x78, 0x2c, 0x51, 0x83, 0x76, 0x78, 0xf4, 0x2e, 0x91, 0x4d,
+	0x38, 0xba, 0x2d, 0x52, 0xf4, 0x25, 0xe7, 0xa5, 0x68, 0xa8, 0x1a, 0xcf, 0x24, 0x97, 0x61, 0xa9,
+	0x1b, 0x5a, 0xd7, 0x66, 0x96, 0x4b, 0xe5, 0x77, 0xb9, 0x93, 0xd4, 0x64, 0xf1, 0xff, 0xee, 0xe6,
+	0x6f, 0xc0, 0x72, 0xaa, 0x30, 0x6d, 0xe9, 0xae, 0x38, 0xdb, 0xef, 0x6c, 0xe5, 0x0f, 0x00, 0x16,
+	0xbb, 0xa5, 0x45, 0x12, 0x1c, 0x72, 0xfc, 0xb5, 0x48, 0x99, 0x57, 0xc3, 0x67, 0xf4, 0x04, 0x5e,
+	0x10, 0xf8, 0x74, 0x66, 0x6d, 0xe8, 0x26, 0x75, 0x39, 0x31, 0x6d, 0xbf, 0xc5, 0x95, 0x6c, 0x6c,
+	0xbd, 0x30, 0x35, 0x9d, 0x44, 0x7e, 0x0e, 0xc7, 0xba, 0x21, 0xf2, 0xae, 0x2f, 0xba, 0x0f, 0xcf,
+	0xea, 0x9c, 0x9a, 0x6e, 0x11, 0x94, 0xf3, 0xa2, 0x5a, 0xd2, 0xd2, 0x70, 0xb7, 0x70, 0xb5, 0x1d,
+	0x28, 0x33, 0x78, 0x2d, 0x75, 0x84, 0x19, 0xc6, 0x16, 0x69, 0x34, 0xfb, 0xbf, 0x25, 0xd1, 0x66,
+	0xe5, 0xe3, 0xcd, 0xaa, 0x7f, 0x1a, 0x85, 0x57, 0x92, 0x15, 0xd7, 0xa9, 0xd3, 0xd2, 0x1b, 0x14,
+	0x1d, 0x02, 0x58, 0x68, 0x0f, 0x3e, 0x79, 0x02, 0x29, 0xa7, 0x53, 0x8b, 0xd9, 0xa0, 0x34, 0x00,
+	0x71, 0xcb, 0xb5, 0xb7, 0xbf, 0x8e, 0x3f, 0xe6, 0x26, 0xe5, 0xeb, 0xe2, 0x0d, 0xa1, 0x55, 0x4b,
+	0xbf, 0x5a, 0xb8, 0xca, 0x9b, 0xb0, 0x0d, 0xfb, 0x73, 0xa0, 0x82, 0x7e, 0x00, 0x78, 0x71, 0x99,
+	0xf2, 0x14, 0x9f, 0x1b, 0xa7, 0xf3, 0xe9, 0x78, 0xd9, 0x40, 0xc8, 0xdc, 0x12, 0x64, 0x14, 0x54,
+	0xcd, 0x46, 0xa6, 0xbd, 0xde, 0xf7, 0x08, 0x5d, 0xf6, 0xb4, 0x97, 0xcc, 0xe7, 0xa2, 0xea, 0xe9,
+	0x94, 0x22, 0xde, 0x27, 0x3d, 0xfa, 0xf7, 0x9c, 0xbc, 0xf4, 0x32, 0x16, 0xbc, 0x26, 0x50, 0xc6,
+	0x21, 0xa1, 0xdf, 0x00, 0x16, 0xda, 0xf6, 0xd6, 0x8f, 0xe8, 0x62, 0xc6, 0x38, 0x90, 0x39, 0xcd,
+	0x0a, 0x3e, 0x75, 0xa9, 0xb7, 0x39, 0x79, 0xda, 0x3b, 0x00, 0xb0, 0xd0, 0xb6, 0x90, 0x7e, 0x98,
+	0xc5, 0xac, 0x52, 0x9a, 0xca, 0x1e, 0xe0, 0xbb, 0x95, 0xaf, 0xaf, 0x4a, 0x8f, 0xfa, 0xfa, 0x09,
+	0xe0, 0x25, 0xcf, 0xd4, 0x52, 0x90, 0x33, 0xc9, 0xcb, 0x1a, 0xe8, 0x95, 0x99, 0x11, 0x94, 0xa6,
+	0xe4, 0xc9, 0x8c, 0x94, 0x0c, 0xdd, 0xe2, 0xde, 0x20, 0xbe, 0x02, 0x38, 0x7e, 0xd2, 0x9d, 0x09,
+	0x4d, 0x0b, 0xd5, 0xb3, 0xff, 0x73, 0x07, 0x0e, 0x27, 0xe1, 0xec, 0x31, 0xe2, 0x62, 0xdc, 0x13,
+	0xe8, 0xef, 0xa0, 0xdb, 0x3d, 0x0d, 0x44, 0x71, 0x42, 0x90, 0xc7, 0x00, 0x16, 0x03, 0x73, 0x48,
+	0x8d, 0xa7, 0x96, 0x01, 0x4d, 0xdc, 0x58, 0x06, 0x32, 0xa2, 0x79, 0x41, 0xf2, 0xae, 0x34, 0xd3,
+	0x23, 0x49, 0x1f, 0xda, 0x1c, 0xa8, 0x2c, 0xac, 0x7e, 0x3f, 0x2a, 0x81, 0xc3, 0xa3, 0x12, 0xf8,
+	0x73, 0x54, 0x02, 0x4f, 0xe7, 0xb3, 0x7f, 0xbf, 0x75, 0xf9, 0x00, 0xdd, 0x3a, 0x27, 0x3e, 0xdd,
+	0xa6, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x69, 0x9c, 0xb1, 0x76, 0xa9, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -532,6 +766,8 @@ type WorkflowTemplateServiceClient interface {
 	UpdateWorkflowTemplate(ctx context.Context, in *WorkflowTemplateUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplate, error)
 	DeleteWorkflowTemplate(ctx context.Context, in *WorkflowTemplateDeleteRequest, opts ...grpc.CallOption) (*WorkflowTemplateDeleteResponse, error)
 	LintWorkflowTemplate(ctx context.Context, in *WorkflowTemplateLintRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplate, error)
+	ListWorkflowTemplateRevisions(ctx context.Context, in *WorkflowTemplateRevisionsRequest, opts ...grpc.CallOption) (*WorkflowTemplateRevisionList, error)
+	RollbackWorkflowTemplate(ctx context.Context, in *WorkflowTemplateRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplate, error)
 }
 
 type workflowTemplateServiceClient struct {
@@ -596,6 +832,24 @@ func (c *workflowTemplateServiceClient) LintWorkflowTemplate(ctx context.Context
 	return out, nil
 }
 
+func (c *workflowTemplateServiceClient) ListWorkflowTemplateRevisions(ctx context.Context, in *WorkflowTemplateRevisionsRequest, opts ...grpc.CallOption) (*WorkflowTemplateRevisionList, error) {
+	out := new(WorkflowTemplateRevisionList)
+	err := c.cc.Invoke(ctx, "/workflowtemplate.WorkflowTemplateService/ListWorkflowTemplateRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowTemplateServiceClient) RollbackWorkflowTemplate(ctx context.Context, in *WorkflowTemplateRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.WorkflowTemplate, error) {
+	out := new(v1alpha1.WorkflowTemplate)
+	err := c.cc.Invoke(ctx, "/workflowtemplate.WorkflowTemplateService/RollbackWorkflowTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkflowTemplateServiceServer is the server API for WorkflowTemplateService service.
 type WorkflowTemplateServiceServer interface {
 	CreateWorkflowTemplate(context.Context, *WorkflowTemplateCreateRequest) (*v1alpha1.WorkflowTemplate, error)
@@ -604,6 +858,8 @@ type WorkflowTemplateServiceServer interface {
 	UpdateWorkflowTemplate(context.Context, *WorkflowTemplateUpdateRequest) (*v1alpha1.WorkflowTemplate, error)
 	DeleteWorkflowTemplate(context.Context, *WorkflowTemplateDeleteRequest) (*WorkflowTemplateDeleteResponse, error)
 	LintWorkflowTemplate(context.Context, *WorkflowTemplateLintRequest) (*v1alpha1.WorkflowTemplate, error)
+	ListWorkflowTemplateRevisions(context.Context, *WorkflowTemplateRevisionsRequest) (*WorkflowTemplateRevisionList, error)
+	RollbackWorkflowTemplate(context.Context, *WorkflowTemplateRollbackRequest) (*v1alpha1.WorkflowTemplate, error)
 }
 
 // UnimplementedWorkflowTemplateServiceServer can be embedded to have forward compatible implementations.
@@ -628,6 +884,12 @@ func (*UnimplementedWorkflowTemplateServiceServer) DeleteWorkflowTemplate(ctx co
 func (*UnimplementedWorkflowTemplateServiceServer) LintWorkflowTemplate(ctx context.Context, req *WorkflowTemplateLintRequest) (*v1alpha1.WorkflowTemplate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LintWorkflowTemplate not implemented")
 }
+func (*UnimplementedWorkflowTemplateServiceServer) ListWorkflowTemplateRevisions(ctx context.Context, req *WorkflowTemplateRevisionsRequest) (*WorkflowTemplateRevisionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowTemplateRevisions not implemented")
+}
+func (*UnimplementedWorkflowTemplateServiceServer) RollbackWorkflowTemplate(ctx context.Context, req *WorkflowTemplateRollbackRequest) (*v1alpha1.WorkflowTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackWorkflowTemplate not implemented")
+}
 
 func RegisterWorkflowTemplateServiceServer(s *grpc.Server, srv WorkflowTemplateServiceServer) {
 	s.RegisterService(&_WorkflowTemplateService_serviceDesc, srv)
@@ -741,6 +1003,42 @@ func _WorkflowTemplateService_LintWorkflowTemplate_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowTemplateService_ListWorkflowTemplateRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowTemplateRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowTemplateServiceServer).ListWorkflowTemplateRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowtemplate.WorkflowTemplateService/ListWorkflowTemplateRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowTemplateServiceServer).ListWorkflowTemplateRevisions(ctx, req.(*WorkflowTemplateRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowTemplateService_RollbackWorkflowTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowTemplateRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowTemplateServiceServer).RollbackWorkflowTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workflowtemplate.WorkflowTemplateService/RollbackWorkflowTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowTemplateServiceServer).RollbackWorkflowTemplate(ctx, req.(*WorkflowTemplateRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WorkflowTemplateService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "workflowtemplate.WorkflowTemplateService",
 	HandlerType: (*WorkflowTemplateServiceServer)(nil),
@@ -769,6 +1067,14 @@ var _WorkflowTemplateService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LintWorkflowTemplate",
 			Handler:    _WorkflowTemplateService_LintWorkflowTemplate_Handler,
 		},
+		{
+			MethodName: "ListWorkflowTemplateRevisions",
+			Handler:    _WorkflowTemplateService_ListWorkflowTemplateRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackWorkflowTemplate",
+			Handler:    _WorkflowTemplateService_RollbackWorkflowTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apiclient/workflowtemplate/workflow-template.proto",
@@ -1129,51 +1435,223 @@ func (m *WorkflowTemplateLintRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintWorkflowTemplate(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWorkflowTemplate(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *WorkflowTemplateRevisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *WorkflowTemplateCreateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *WorkflowTemplateRevisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplateRevisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovWorkflowTemplate(uint64(l))
-	}
-	if m.Template != nil {
-		l = m.Template.Size()
-		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CreateOptions != nil {
-		l = m.CreateOptions.Size()
-		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflowTemplate(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflowTemplate(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *WorkflowTemplateGetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WorkflowTemplateRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTemplateRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplateRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovWorkflowTemplate(uint64(l))
-	}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CreationTimestamp != nil {
+		{
+			size, err := m.CreationTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWorkflowTemplate(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Revision != 0 {
+		i = encodeVarintWorkflowTemplate(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowTemplateRevisionList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTemplateRevisionList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplateRevisionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWorkflowTemplate(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowTemplateRollbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTemplateRollbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTemplateRollbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Revision != 0 {
+		i = encodeVarintWorkflowTemplate(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintWorkflowTemplate(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWorkflowTemplate(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWorkflowTemplate(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWorkflowTemplate(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WorkflowTemplateCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	}
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	}
+	if m.CreateOptions != nil {
+		l = m.CreateOptions.Size()
+		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowTemplateGetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	}
 	l = len(m.Namespace)
 	if l > 0 {
 		n += 1 + l + sovWorkflowTemplate(uint64(l))
@@ -1296,6 +1774,86 @@ func (m *WorkflowTemplateLintRequest) Size() (n int) {
 	return n
 }
 
+func (m *WorkflowTemplateRevisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowTemplateRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		n += 1 + sovWorkflowTemplate(uint64(m.Revision))
+	}
+	if m.CreationTimestamp != nil {
+		l = m.CreationTimestamp.Size()
+		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowTemplateRevisionList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovWorkflowTemplate(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowTemplateRollbackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovWorkflowTemplate(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovWorkflowTemplate(uint64(m.Revision))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovWorkflowTemplate(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2267,6 +2825,446 @@ func (m *WorkflowTemplateLintRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkflowTemplateRevisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowTemplate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTemplateRevisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTemplateRevisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowTemplate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowTemplateRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowTemplate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTemplateRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTemplateRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreationTimestamp == nil {
+				m.CreationTimestamp = &v1.Time{}
+			}
+			if err := m.CreationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowTemplate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowTemplateRevisionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowTemplate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTemplateRevisionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTemplateRevisionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &WorkflowTemplateRevision{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowTemplate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowTemplateRollbackRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkflowTemplate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTemplateRollbackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTemplateRollbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowTemplate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkflowTemplate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWorkflowTemplate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWorkflowTemplate(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
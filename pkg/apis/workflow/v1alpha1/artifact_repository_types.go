@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path"
 	"strings"
+	"time"
 )
 
 var (
@@ -27,12 +28,53 @@ type ArtifactRepository struct {
 	GCS *GCSArtifactRepository `json:"gcs,omitempty" protobuf:"bytes,6,opt,name=gcs"`
 	// Azure stores artifact in an Azure Storage account
 	Azure *AzureArtifactRepository `json:"azure,omitempty" protobuf:"bytes,7,opt,name=azure"`
+	// ContentAddressable stores output artifacts without a key under a key derived from their SHA-256 digest,
+	// "sha256/<digest>", so identical outputs are only stored once
+	ContentAddressable *bool `json:"contentAddressable,omitempty" protobuf:"varint,8,opt,name=contentAddressable"`
+	// OCI stores artifacts in an OCI registry
+	OCI *OCIArtifactRepository `json:"oci,omitempty" protobuf:"bytes,9,opt,name=oci"`
+	// Retention deletes the output artifacts saved to the repository by completed workflows once they are no longer retained
+	Retention *ArtifactRetention `json:"retention,omitempty" protobuf:"bytes,10,opt,name=retention"`
 }
 
 func (a *ArtifactRepository) IsArchiveLogs() bool {
 	return a != nil && a.ArchiveLogs != nil && *a.ArchiveLogs
 }
 
+// ArtifactRetention is the retention policy of the output artifacts in an artifact repository. An output artifact
+// is deleted when it is neither among the KeepLatest most recent versions of the artifact, nor younger than MaxAge.
+type ArtifactRetention struct {
+	// KeepLatest is how many of the most recent versions of each output artifact of each template are retained
+	KeepLatest *int32 `json:"keepLatest,omitempty" protobuf:"varint,1,opt,name=keepLatest"`
+	// MaxAge is how long after their workflow completed output artifacts are retained, e.g. "720h"
+	MaxAge string `json:"maxAge,omitempty" protobuf:"bytes,2,opt,name=maxAge"`
+	// KeepLabelSelector retains the output artifacts of the workflows matching the label selector, e.g. "retain=true"
+	KeepLabelSelector string `json:"keepLabelSelector,omitempty" protobuf:"bytes,3,opt,name=keepLabelSelector"`
+	// DryRun logs the output artifacts that would be deleted, rather than deleting them
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,4,opt,name=dryRun"`
+}
+
+// IsEnabled returns whether the retention policy deletes any artifacts
+func (r *ArtifactRetention) IsEnabled() bool {
+	return r != nil && (r.KeepLatest != nil || r.MaxAge != "")
+}
+
+// GetKeepLatest returns how many of the most recent versions of each artifact are retained, or zero if it is not set
+func (r *ArtifactRetention) GetKeepLatest() int {
+	if r == nil || r.KeepLatest == nil {
+		return 0
+	}
+	return int(*r.KeepLatest)
+}
+
+// GetMaxAge returns how long artifacts are retained, or zero if MaxAge is not set
+func (r *ArtifactRetention) GetMaxAge() (time.Duration, error) {
+	if r == nil || r.MaxAge == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(r.MaxAge)
+}
+
 type ArtifactRepositoryType interface {
 	IntoArtifactLocation(l *ArtifactLocation)
 }
@@ -48,6 +90,8 @@ func (a *ArtifactRepository) Get() ArtifactRepositoryType {
 		return a.GCS
 	} else if a.HDFS != nil {
 		return a.HDFS
+	} else if a.OCI != nil {
+		return a.OCI
 	} else if a.OSS != nil {
 		return a.OSS
 	} else if a.S3 != nil {
@@ -62,7 +106,7 @@ func (a *ArtifactRepository) ToArtifactLocation() *ArtifactLocation {
 	if a == nil {
 		return nil
 	}
-	l := &ArtifactLocation{ArchiveLogs: a.ArchiveLogs}
+	l := &ArtifactLocation{ArchiveLogs: a.ArchiveLogs, ContentAddressable: a.ContentAddressable}
 	v := a.Get()
 	if v != nil {
 		v.IntoArtifactLocation(l)
@@ -159,6 +203,22 @@ func (r *AzureArtifactRepository) IntoArtifactLocation(l *ArtifactLocation) {
 	l.Azure = &AzureArtifact{AzureBlobContainer: r.AzureBlobContainer, Blob: k}
 }
 
+// OCIArtifactRepository defines the controller configuration for an OCI registry artifact repository
+type OCIArtifactRepository struct {
+	OCIRegistry `json:",inline" protobuf:"bytes,1,opt,name=ociRegistry"`
+
+	// KeyFormat defines the format of how to store keys and can reference workflow variables.
+	KeyFormat string `json:"keyFormat,omitempty" protobuf:"bytes,2,opt,name=keyFormat"`
+}
+
+func (r *OCIArtifactRepository) IntoArtifactLocation(l *ArtifactLocation) {
+	k := r.KeyFormat
+	if k == "" {
+		k = DefaultArchivePattern
+	}
+	l.OCI = &OCIArtifact{OCIRegistry: r.OCIRegistry, Key: k}
+}
+
 // HDFSArtifactRepository defines the controller configuration for an HDFS artifact repository
 type HDFSArtifactRepository struct {
 	HDFSConfig `json:",inline" protobuf:"bytes,1,opt,name=hDFSConfig"`
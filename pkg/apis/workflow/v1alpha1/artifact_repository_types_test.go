@@ -48,6 +48,13 @@ func TestArtifactRepository(t *testing.T) {
 		require.NotNil(t, l.HDFS)
 		assert.Equal(t, "{{workflow.name}}/{{pod.name}}", l.HDFS.Path)
 	})
+	t.Run("OCI", func(t *testing.T) {
+		r := &ArtifactRepository{OCI: &OCIArtifactRepository{}}
+		assert.IsType(t, &OCIArtifactRepository{}, r.Get())
+		l := r.ToArtifactLocation()
+		require.NotNil(t, l.OCI)
+		assert.Equal(t, "{{workflow.name}}/{{pod.name}}", l.OCI.Key)
+	})
 	t.Run("OSS", func(t *testing.T) {
 		r := &ArtifactRepository{OSS: &OSSArtifactRepository{}}
 		assert.IsType(t, &OSSArtifactRepository{}, r.Get())
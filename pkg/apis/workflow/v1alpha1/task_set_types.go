@@ -35,6 +35,8 @@ type NodeResult struct {
 	Message  string    `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
 	Outputs  *Outputs  `json:"outputs,omitempty" protobuf:"bytes,3,opt,name=outputs"`
 	Progress Progress  `json:"progress,omitempty" protobuf:"bytes,4,opt,name=progress,casttype=Progress"`
+	// Checkpoint is the status of the checkpoints of the node's template, if it is checkpointed
+	Checkpoint *CheckpointStatus `json:"checkpoint,omitempty" protobuf:"bytes,5,opt,name=checkpoint"`
 }
 
 func (in NodeResult) Fulfilled() bool {
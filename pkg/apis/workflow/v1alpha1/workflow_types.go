@@ -13,6 +13,7 @@ import (
 	"runtime"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -84,6 +85,8 @@ const (
 	NodeTypeSuspend   NodeType = "Suspend"
 	NodeTypeHTTP      NodeType = "HTTP"
 	NodeTypePlugin    NodeType = "Plugin"
+	NodeTypeLoop      NodeType = "Loop"
+	NodeTypeCompacted NodeType = "Compacted"
 )
 
 // ArtifactGCStrategy is the strategy when to delete artifacts for GC.
@@ -757,6 +760,14 @@ type Template struct {
 	// Timeout allows to set the total node execution timeout duration counting from the node's start time.
 	// This duration also includes time in which the node spends in Pending state. This duration may not be applied to Step or DAG templates.
 	Timeout string `json:"timeout,omitempty" protobuf:"bytes,38,opt,name=timeout"`
+
+	// Gang admits the pods of this template that are expanded from the same step or task, using withItems, withParam or
+	// withSequence, only once enough of them can be placed.
+	Gang *Gang `json:"gang,omitempty" protobuf:"bytes,44,opt,name=gang"`
+
+	// Checkpoint periodically saves a directory of the main container to the artifact repository, and restores it when
+	// the template is retried, so that work resumes where it left off.
+	Checkpoint *Checkpoint `json:"checkpoint,omitempty" protobuf:"bytes,45,opt,name=checkpoint"`
 }
 
 // SetType will set the template object based on template type.
@@ -973,6 +984,41 @@ type Artifact struct {
 
 	// Has this been deleted?
 	Deleted bool `json:"deleted,omitempty" protobuf:"varint,13,opt,name=deleted"`
+
+	// Digest is the SHA-256 digest of the saved artifact, e.g. "sha256:e3b0c442...". It is set when an output
+	// artifact is saved, and verified when the artifact is loaded as an input. The digest of an archived artifact is
+	// taken over the path, type, permissions, link target and content of its entries, not the tarball itself, so it
+	// does not match the checksum of the stored object.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,14,opt,name=digest"`
+
+	// Encryption encrypts the artifact before it is saved and decrypts it after it is loaded, for any artifact repository
+	Encryption *ArtifactEncryption `json:"encryption,omitempty" protobuf:"bytes,15,opt,name=encryption"`
+
+	// Stream, for an output artifact, serves the artifact from the wait container of the pod that produced it, so
+	// that the steps or tasks it is passed to load it directly rather than from the artifact repository. The artifact
+	// is still saved to the artifact repository, which is used if it cannot be loaded from the pod.
+	Stream bool `json:"stream,omitempty" protobuf:"varint,16,opt,name=stream"`
+
+	// StreamSource is where a streamed artifact is served from. It is set when the artifact is saved.
+	StreamSource *ArtifactStreamSource `json:"streamSource,omitempty" protobuf:"bytes,17,opt,name=streamSource"`
+
+	// SizeBytes is the size in bytes of the saved artifact, or for a directory which is not archived, of its files.
+	// It is set when an output artifact is saved.
+	SizeBytes int64 `json:"sizeBytes,omitempty" protobuf:"varint,18,opt,name=sizeBytes"`
+}
+
+// ArtifactEncryption configures client-side envelope encryption of an artifact. Each file is encrypted with
+// AES-256-GCM using a random data key, which is itself encrypted with a key derived from the secret.
+type ArtifactEncryption struct {
+	// KeySecret is the secret selector to the key the data keys are encrypted with
+	KeySecret *apiv1.SecretKeySelector `json:"keySecret" protobuf:"bytes,1,opt,name=keySecret"`
+}
+
+// ArtifactStreamSource is the endpoint of the pod that serves a streamed artifact. The bearer token the artifact is
+// served with is not recorded, it is kept in a secret owned by the workflow.
+type ArtifactStreamSource struct {
+	// URL of the artifact
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
 }
 
 // ArtifactGC returns the ArtifactGC that was defined by the artifact.  If none was provided, a default value is returned.
@@ -1112,6 +1158,7 @@ type ArchiveStrategy struct {
 	Tar  *TarStrategy  `json:"tar,omitempty" protobuf:"bytes,1,opt,name=tar"`
 	None *NoneStrategy `json:"none,omitempty" protobuf:"bytes,2,opt,name=none"`
 	Zip  *ZipStrategy  `json:"zip,omitempty" protobuf:"bytes,3,opt,name=zip"`
+	Zstd *ZstdStrategy `json:"zstd,omitempty" protobuf:"bytes,4,opt,name=zstd"`
 }
 
 // TarStrategy will tar and gzip the file or directory when saving
@@ -1124,6 +1171,13 @@ type TarStrategy struct {
 // ZipStrategy will unzip zipped input artifacts
 type ZipStrategy struct{}
 
+// ZstdStrategy will tar and zstd compress the file or directory when saving
+type ZstdStrategy struct {
+	// CompressionLevel specifies the zstd compression level to use for the artifact, from 1 (fastest) to 22 (best).
+	// Defaults to 3.
+	CompressionLevel *int32 `json:"compressionLevel,omitempty" protobuf:"varint,1,opt,name=compressionLevel"`
+}
+
 // NoneStrategy indicates to skip tar process and upload the files or directory tree as independent
 // files. Note that if the artifact is a directory, the artifact driver must support the ability to
 // save/load the directory appropriately.
@@ -1169,6 +1223,16 @@ type ArtifactLocation struct {
 
 	// Azure contains Azure Storage artifact location details
 	Azure *AzureArtifact `json:"azure,omitempty" protobuf:"bytes,10,opt,name=azure"`
+
+	// ContentAddressable indicates if output artifacts without a key should be stored under a key derived
+	// from their digest, so identical artifacts are only stored once
+	ContentAddressable *bool `json:"contentAddressable,omitempty" protobuf:"varint,11,opt,name=contentAddressable"`
+
+	// OCI contains OCI registry artifact location details
+	OCI *OCIArtifact `json:"oci,omitempty" protobuf:"bytes,12,opt,name=oci"`
+
+	// SFTP contains SFTP artifact location details
+	SFTP *SFTPArtifact `json:"sftp,omitempty" protobuf:"bytes,13,opt,name=sftp"`
 }
 
 func (a *ArtifactLocation) Get() (ArtifactLocationType, error) {
@@ -1186,12 +1250,16 @@ func (a *ArtifactLocation) Get() (ArtifactLocationType, error) {
 		return a.HDFS, nil
 	} else if a.HTTP != nil {
 		return a.HTTP, nil
+	} else if a.OCI != nil {
+		return a.OCI, nil
 	} else if a.OSS != nil {
 		return a.OSS, nil
 	} else if a.Raw != nil {
 		return a.Raw, nil
 	} else if a.S3 != nil {
 		return a.S3, nil
+	} else if a.SFTP != nil {
+		return a.SFTP, nil
 	}
 	return nil, fmt.Errorf("You need to configure artifact storage. More information on how to do this can be found in the docs: https://argo-workflows.readthedocs.io/en/latest/configure-artifact-repository/")
 }
@@ -1210,12 +1278,16 @@ func (a *ArtifactLocation) SetType(x ArtifactLocationType) error {
 		a.HDFS = &HDFSArtifact{}
 	case *HTTPArtifact:
 		a.HTTP = &HTTPArtifact{}
+	case *OCIArtifact:
+		a.OCI = &OCIArtifact{}
 	case *OSSArtifact:
 		a.OSS = &OSSArtifact{}
 	case *RawArtifact:
 		a.Raw = &RawArtifact{}
 	case *S3Artifact:
 		a.S3 = &S3Artifact{}
+	case *SFTPArtifact:
+		a.SFTP = &SFTPArtifact{}
 	default:
 		return fmt.Errorf("set type not supported for type: %v", reflect.TypeOf(v))
 	}
@@ -1277,6 +1349,10 @@ func (a *ArtifactLocation) IsArchiveLogs() bool {
 	return a != nil && a.ArchiveLogs != nil && *a.ArchiveLogs
 }
 
+func (a *ArtifactLocation) IsContentAddressable() bool {
+	return a != nil && a.ContentAddressable != nil && *a.ContentAddressable
+}
+
 func (a *ArtifactLocation) GetKey() (string, error) {
 	v, err := a.Get()
 	if err != nil {
@@ -1484,6 +1560,20 @@ type Outputs struct {
 
 	// ExitCode holds the exit code of a script template
 	ExitCode *string `json:"exitCode,omitempty" protobuf:"bytes,4,opt,name=exitCode"`
+
+	// DAG holds a DAG of tasks generated by a container or script template run as a DAG task. Once the task succeeds,
+	// the generated DAG is spliced into the enclosing DAG as a child node, which the task's dependants wait for
+	DAG *DAGOutput `json:"dag,omitempty" protobuf:"bytes,5,opt,name=dag"`
+}
+
+// DAGOutput describes a DAG of tasks generated by a template at runtime
+type DAGOutput struct {
+	// Path in the container to retrieve the DAG from. The file must hold a JSON document of the same form as a DAG
+	// template, e.g. {"tasks": [{"name": "a", "template": "echo"}, {"name": "b", "template": "echo", "depends": "a"}]}
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+
+	// Value is the generated DAG document
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
 }
 
 func (o *Outputs) GetArtifacts() Artifacts {
@@ -1536,6 +1626,9 @@ type WorkflowStep struct {
 	// Hooks holds the lifecycle hook which is invoked at lifecycle of
 	// step, irrespective of the success, failure, or error status of the primary step
 	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,12,opt,name=hooks"`
+
+	// Loop runs the template of the step repeatedly, until a condition is met
+	Loop *Loop `json:"loop,omitempty" protobuf:"bytes,14,opt,name=loop"`
 }
 
 func (step *WorkflowStep) GetName() string {
@@ -1644,6 +1737,9 @@ type TemplateRef struct {
 	Template string `json:"template,omitempty" protobuf:"bytes,2,opt,name=template"`
 	// ClusterScope indicates the referred template is cluster scoped (i.e. a ClusterWorkflowTemplate).
 	ClusterScope bool `json:"clusterScope,omitempty" protobuf:"varint,4,opt,name=clusterScope"`
+	// Revision pins the reference to a revision of the template recorded by the Argo Server, rather than
+	// its latest spec. Requires persistence to be configured.
+	Revision int64 `json:"revision,omitempty" protobuf:"varint,5,opt,name=revision"`
 }
 
 // Synchronization holds synchronization lock configuration
@@ -1671,6 +1767,15 @@ type SemaphoreRef struct {
 	ConfigMapKeyRef *apiv1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty" protobuf:"bytes,1,opt,name=configMapKeyRef"`
 	// Namespace is the namespace of the configmap, default: [namespace of workflow]
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// Database is a reference to a semaphore limit held in the synchronization database,
+	// allowing the semaphore to be shared between controllers
+	Database *SyncDatabaseRef `json:"database,omitempty" protobuf:"bytes,3,opt,name=database"`
+}
+
+// SyncDatabaseRef is a reference to a semaphore held in the synchronization database
+type SyncDatabaseRef struct {
+	// Key is the name of the semaphore, its limit is looked up as "<namespace>/<key>"
+	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
 }
 
 // Mutex holds Mutex configuration
@@ -1679,6 +1784,9 @@ type Mutex struct {
 	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
 	// Namespace is the namespace of the mutex, default: [namespace of workflow]
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// Database specifies this is a mutex held in the synchronization database,
+	// allowing the mutex to be shared between controllers
+	Database bool `json:"database,omitempty" protobuf:"varint,3,opt,name=database"`
 }
 
 // WorkflowTemplateRef is a reference to a WorkflowTemplate resource.
@@ -1687,6 +1795,9 @@ type WorkflowTemplateRef struct {
 	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
 	// ClusterScope indicates the referred template is cluster scoped (i.e. a ClusterWorkflowTemplate).
 	ClusterScope bool `json:"clusterScope,omitempty" protobuf:"varint,2,opt,name=clusterScope"`
+	// Revision pins the reference to a revision of the template recorded by the Argo Server, rather than
+	// its latest spec. Requires persistence to be configured.
+	Revision int64 `json:"revision,omitempty" protobuf:"varint,3,opt,name=revision"`
 }
 
 func (ref *WorkflowTemplateRef) ToTemplateRef(template string) *TemplateRef {
@@ -1694,6 +1805,7 @@ func (ref *WorkflowTemplateRef) ToTemplateRef(template string) *TemplateRef {
 		Name:         ref.Name,
 		ClusterScope: ref.ClusterScope,
 		Template:     template,
+		Revision:     ref.Revision,
 	}
 }
 
@@ -1953,6 +2065,10 @@ type WorkflowStatus struct {
 
 	// TaskResultsCompletionStatus tracks task result completion status (mapped by node ID). Used to prevent premature archiving and garbage collection.
 	TaskResultsCompletionStatus map[string]bool `json:"taskResultsCompletionStatus,omitempty" protobuf:"bytes,20,opt,name=taskResultsCompletionStatus"`
+
+	// NodeArgumentOverrides are the input parameters and artifacts that replace those of the same name given to nodes
+	// (mapped by node name) when they are executed, set when the workflow is retried
+	NodeArgumentOverrides map[string]Arguments `json:"nodeArgumentOverrides,omitempty" protobuf:"bytes,21,rep,name=nodeArgumentOverrides"`
 }
 
 func (ws *WorkflowStatus) MarkTaskResultIncomplete(name string) {
@@ -1986,6 +2102,11 @@ func (ws *WorkflowStatus) IsTaskResultIncomplete(name string) bool {
 	return false // workflows from older versions do not have this status, so assume completed if this is missing
 }
 
+// IsTaskResultComplete returns whether the task result of the node is known to be complete
+func (ws *WorkflowStatus) IsTaskResultComplete(name string) bool {
+	return ws.TaskResultsCompletionStatus[name]
+}
+
 func (ws *WorkflowStatus) IsOffloadNodeStatus() bool {
 	return ws.OffloadNodeStatusVersion != ""
 }
@@ -2055,6 +2176,62 @@ type RetryStrategy struct {
 	// Expression is a condition expression for when a node will be retried. If it evaluates to false, the node will not
 	// be retried and the retry strategy will be ignored
 	Expression string `json:"expression,omitempty" protobuf:"bytes,5,opt,name=expression"`
+
+	// RetryRules are evaluated in order against a failed node, and the first rule that matches decides how many times,
+	// and with which backoff, it is retried. A node that matches no rule is not retried. Limit, RetryPolicy, Backoff and
+	// Expression are ignored when retry rules are set.
+	RetryRules []RetryRule `json:"retryRules,omitempty" protobuf:"bytes,6,rep,name=retryRules"`
+
+	// ResourceEscalation increases the resources of the main container each time it is retried after running out of
+	// memory
+	ResourceEscalation *ResourceEscalation `json:"resourceEscalation,omitempty" protobuf:"bytes,7,opt,name=resourceEscalation"`
+}
+
+// ResourceEscalation multiplies the resources of the main container when it is retried after being OOMKilled
+type ResourceEscalation struct {
+	// Factor multiplies the memory request and limit of the main container for each previous attempt that was
+	// OOMKilled, e.g. 2 or "1.5". Defaults to 2.
+	Factor *intstr.IntOrString `json:"factor,omitempty" protobuf:"varint,1,opt,name=factor"`
+
+	// MaxMemory is the largest memory request or limit the main container is escalated to
+	MaxMemory *resource.Quantity `json:"maxMemory,omitempty" protobuf:"bytes,2,opt,name=maxMemory"`
+
+	// CPU also multiplies the CPU request and limit of the main container
+	CPU bool `json:"cpu,omitempty" protobuf:"varint,3,opt,name=cpu"`
+
+	// MaxCPU is the largest CPU request or limit the main container is escalated to
+	MaxCPU *resource.Quantity `json:"maxCPU,omitempty" protobuf:"bytes,4,opt,name=maxCPU"`
+}
+
+// RetryRule is a retry policy for the failures it matches
+type RetryRule struct {
+	// Match selects the failures this rule applies to. A rule without a match applies to all failures.
+	Match *RetryRuleMatch `json:"match,omitempty" protobuf:"bytes,1,opt,name=match"`
+
+	// Limit is the maximum number of retries of the failures matched by this rule. Retries of failures matched by other
+	// rules are not counted against it.
+	Limit *intstr.IntOrString `json:"limit,omitempty" protobuf:"varint,2,opt,name=limit"`
+
+	// Backoff is a backoff strategy for the failures matched by this rule
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,3,opt,name=backoff,casttype=Backoff"`
+}
+
+// RetryRuleMatch matches failed nodes. A node must satisfy each of the conditions that are set.
+type RetryRuleMatch struct {
+	// ExitCodes matches nodes whose main container exited with one of these codes
+	ExitCodes []int32 `json:"exitCodes,omitempty" protobuf:"varint,1,rep,name=exitCodes"`
+
+	// Message is a regular expression matched against the message of the node
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+
+	// OOMKilled matches nodes which had a container killed for running out of memory
+	OOMKilled bool `json:"oomKilled,omitempty" protobuf:"varint,3,opt,name=oomKilled"`
+
+	// Evicted matches nodes whose pod was evicted by the kubelet, e.g. because the node was low on resources
+	Evicted bool `json:"evicted,omitempty" protobuf:"varint,4,opt,name=evicted"`
+
+	// Status matches nodes with this status, either Failed or Error
+	Status NodePhase `json:"status,omitempty" protobuf:"bytes,5,opt,name=status,casttype=NodePhase"`
 }
 
 // RetryPolicyActual gets the active retry policy for a strategy.
@@ -2275,6 +2452,12 @@ type NodeStatus struct {
 	// Inputs captures input parameter values and artifact locations supplied to this template invocation
 	Inputs *Inputs `json:"inputs,omitempty" protobuf:"bytes,14,opt,name=inputs"`
 
+	// ArgumentOverrides are the input parameters and artifacts that replaced those this template invocation was given, when the workflow was retried
+	ArgumentOverrides *Arguments `json:"argumentOverrides,omitempty" protobuf:"bytes,28,opt,name=argumentOverrides"`
+
+	// Checkpoint is the status of the checkpoints of the node's template, if it is checkpointed
+	Checkpoint *CheckpointStatus `json:"checkpoint,omitempty" protobuf:"bytes,29,opt,name=checkpoint"`
+
 	// Outputs captures output parameter values and artifact locations produced by this template invocation
 	Outputs *Outputs `json:"outputs,omitempty" protobuf:"bytes,15,opt,name=outputs"`
 
@@ -2303,6 +2486,13 @@ type NodeStatus struct {
 
 	// SynchronizationStatus is the synchronization status of the node
 	SynchronizationStatus *NodeSynchronizationStatus `json:"synchronizationStatus,omitempty" protobuf:"bytes,25,opt,name=synchronizationStatus"`
+
+	// Compacted summarizes the nodes collapsed into this node, for nodes of type Compacted
+	Compacted *CompactedNodes `json:"compacted,omitempty" protobuf:"bytes,30,opt,name=compacted"`
+
+	// EscalatedResources are the resources of the main container of this node, if they were escalated by
+	// retryStrategy.resourceEscalation
+	EscalatedResources *apiv1.ResourceRequirements `json:"escalatedResources,omitempty" protobuf:"bytes,31,opt,name=escalatedResources"`
 }
 
 func (n *NodeStatus) GetName() string {
@@ -2532,6 +2722,18 @@ type S3Bucket struct {
 
 	// CASecret specifies the secret that contains the CA, used to verify the TLS connection
 	CASecret *apiv1.SecretKeySelector `json:"caSecret,omitempty" protobuf:"bytes,11,opt,name=caSecret"`
+
+	// TransferOptions configures multipart uploads, parallel directory transfers and resumable downloads
+	TransferOptions *S3TransferOptions `json:"transferOptions,omitempty" protobuf:"bytes,13,opt,name=transferOptions"`
+}
+
+// S3TransferOptions used to determine how large artifacts are transferred during s3 operations
+type S3TransferOptions struct {
+	// PartSize is the size of each part of a multipart upload, and of each ranged request of a resumable download, e.g. "64Mi". Must be at least 5Mi. Defaults to 16Mi
+	PartSize *resource.Quantity `json:"partSize,omitempty" protobuf:"bytes,1,opt,name=partSize"`
+
+	// Concurrency is the number of parts of a file that are uploaded, and files of a directory that are transferred, in parallel. Defaults to 4
+	Concurrency int32 `json:"concurrency,omitempty" protobuf:"varint,2,opt,name=concurrency"`
 }
 
 // S3EncryptionOptions used to determine encryption options during s3 operations
@@ -2609,11 +2811,47 @@ type GitArtifact struct {
 	// SingleBranch enables single branch clone, using the `branch` parameter
 	SingleBranch bool `json:"singleBranch,omitempty" protobuf:"varint,10,opt,name=singleBranch"`
 
-	// Branch is the branch to fetch when `SingleBranch` is enabled
+	// Branch is the branch to fetch when `SingleBranch` is enabled, and the branch that output artifacts are committed to,
+	// which is created if it does not exist. Output artifacts are committed to the default branch if it is not set.
 	Branch string `json:"branch,omitempty" protobuf:"bytes,11,opt,name=branch"`
 
 	// InsecureSkipTLS disables server certificate verification resulting in insecure HTTPS connections
 	InsecureSkipTLS bool `json:"insecureSkipTLS,omitempty" protobuf:"varint,12,opt,name=insecureSkipTLS"`
+
+	// Commit configures the commit of an output artifact
+	Commit *GitCommit `json:"commit,omitempty" protobuf:"bytes,13,opt,name=commit"`
+}
+
+// GitCommit configures the commit of a git output artifact. The contents of the output artifact replace the contents of
+// the path in the repository, and are committed and pushed to the branch.
+type GitCommit struct {
+	// Path is the directory in the repository that the artifact is committed to, by default the root of the repository
+	Path string `json:"path,omitempty" protobuf:"bytes,1,opt,name=path"`
+
+	// AuthorName is the name of the author of the commit, by default "Argo Workflows"
+	AuthorName string `json:"authorName,omitempty" protobuf:"bytes,2,opt,name=authorName"`
+
+	// AuthorEmail is the email of the author of the commit
+	AuthorEmail string `json:"authorEmail,omitempty" protobuf:"bytes,3,opt,name=authorEmail"`
+
+	// Message is the commit message, which may contain variables such as {{workflow.name}}
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+
+	// Force allows the commit to replace the history of the branch. The push is rejected if the branch changed since it was
+	// cloned. Without Force, the push is rejected unless it fast-forwards the branch.
+	Force bool `json:"force,omitempty" protobuf:"varint,5,opt,name=force"`
+
+	// SigningKeySecret is the secret selector to an armored OpenPGP private key used to sign the commit
+	SigningKeySecret *apiv1.SecretKeySelector `json:"signingKeySecret,omitempty" protobuf:"bytes,6,opt,name=signingKeySecret"`
+}
+
+const defaultGitCommitAuthorName = "Argo Workflows"
+
+func (c *GitCommit) GetAuthorName() string {
+	if c == nil || c.AuthorName == "" {
+		return defaultGitCommitAuthorName
+	}
+	return c.AuthorName
 }
 
 func (g *GitArtifact) HasLocation() bool {
@@ -2712,6 +2950,88 @@ func (a *AzureArtifact) HasLocation() bool {
 	return a != nil && a.Endpoint != "" && a.Container != "" && a.Blob != ""
 }
 
+// OCIRegistry contains the access information for interfacing with an OCI registry
+type OCIRegistry struct {
+	// Registry is the host, and optionally the port, of the registry, e.g. "ghcr.io"
+	Registry string `json:"registry" protobuf:"bytes,1,opt,name=registry"`
+
+	// Repository is the repository within the registry that artifacts are pushed to, e.g. "my-org/artifacts"
+	Repository string `json:"repository" protobuf:"bytes,2,opt,name=repository"`
+
+	// Insecure will connect to the registry using plain HTTP rather than HTTPS
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,3,opt,name=insecure"`
+
+	// UsernameSecret is the secret selector to the registry username
+	UsernameSecret *apiv1.SecretKeySelector `json:"usernameSecret,omitempty" protobuf:"bytes,4,opt,name=usernameSecret"`
+
+	// PasswordSecret is the secret selector to the registry password or token
+	PasswordSecret *apiv1.SecretKeySelector `json:"passwordSecret,omitempty" protobuf:"bytes,5,opt,name=passwordSecret"`
+}
+
+// OCIArtifact is the location of an artifact in an OCI registry. Each key is stored as its own
+// ORAS-style manifest, tagged with the hex encoded SHA-256 digest of the key.
+type OCIArtifact struct {
+	OCIRegistry `json:",inline" protobuf:"bytes,1,opt,name=ociRegistry"`
+
+	// Key is the key of the artifact within the repository
+	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
+}
+
+func (o *OCIArtifact) GetKey() (string, error) {
+	return o.Key, nil
+}
+
+func (o *OCIArtifact) SetKey(key string) error {
+	o.Key = key
+	return nil
+}
+
+func (o *OCIArtifact) HasLocation() bool {
+	return o != nil && o.Registry != "" && o.Repository != "" && o.Key != ""
+}
+
+// SFTPServer contains the access information for interfacing with an SFTP server
+type SFTPServer struct {
+	// Host is the host, and optionally the port, of the server. The port defaults to 22
+	Host string `json:"host" protobuf:"bytes,1,opt,name=host"`
+
+	// UsernameSecret is the secret selector to the username
+	UsernameSecret *apiv1.SecretKeySelector `json:"usernameSecret,omitempty" protobuf:"bytes,2,opt,name=usernameSecret"`
+
+	// PasswordSecret is the secret selector to the password
+	PasswordSecret *apiv1.SecretKeySelector `json:"passwordSecret,omitempty" protobuf:"bytes,3,opt,name=passwordSecret"`
+
+	// SSHPrivateKeySecret is the secret selector to the ssh private key
+	SSHPrivateKeySecret *apiv1.SecretKeySelector `json:"sshPrivateKeySecret,omitempty" protobuf:"bytes,4,opt,name=sshPrivateKeySecret"`
+
+	// KnownHostsSecret is the secret selector to the known_hosts entries used to verify the server's host key
+	KnownHostsSecret *apiv1.SecretKeySelector `json:"knownHostsSecret,omitempty" protobuf:"bytes,5,opt,name=knownHostsSecret"`
+
+	// InsecureIgnoreHostKey disables SSH strict host key checking
+	InsecureIgnoreHostKey bool `json:"insecureIgnoreHostKey,omitempty" protobuf:"varint,6,opt,name=insecureIgnoreHostKey"`
+}
+
+// SFTPArtifact is the location of an artifact on an SFTP server
+type SFTPArtifact struct {
+	SFTPServer `json:",inline" protobuf:"bytes,1,opt,name=sftpServer"`
+
+	// Path is the path of the file or directory on the server
+	Path string `json:"path" protobuf:"bytes,2,opt,name=path"`
+}
+
+func (s *SFTPArtifact) GetKey() (string, error) {
+	return s.Path, nil
+}
+
+func (s *SFTPArtifact) SetKey(key string) error {
+	s.Path = key
+	return nil
+}
+
+func (s *SFTPArtifact) HasLocation() bool {
+	return s != nil && s.Host != "" && s.Path != ""
+}
+
 // HDFSArtifact is the location of an HDFS artifact
 type HDFSArtifact struct {
 	HDFSConfig `json:",inline" protobuf:"bytes,1,opt,name=hDFSConfig"`
@@ -3153,6 +3473,19 @@ func (t *Template) HasOutputs() bool {
 	return t != nil && t.Outputs.HasOutputs()
 }
 
+// HasStreamedOutputArtifacts returns whether any of the template's output artifacts are streamed
+func (t *Template) HasStreamedOutputArtifacts() bool {
+	if t == nil {
+		return false
+	}
+	for _, art := range t.Outputs.Artifacts {
+		if art.Stream {
+			return true
+		}
+	}
+	return false
+}
+
 // DAGTemplate is a template subtype for directed acyclic graph templates
 type DAGTemplate struct {
 	// Target are one or more names of targets to execute in a DAG
@@ -3221,6 +3554,9 @@ type DAGTask struct {
 	// Hooks hold the lifecycle hook which is invoked at lifecycle of
 	// task, irrespective of the success, failure, or error status of the primary task
 	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,13,opt,name=hooks"`
+
+	// Loop runs the template of the task repeatedly, until a condition is met
+	Loop *Loop `json:"loop,omitempty" protobuf:"bytes,15,opt,name=loop"`
 }
 
 func (t *DAGTask) GetName() string {
@@ -3267,6 +3603,19 @@ func (t *DAGTask) ShouldExpand() bool {
 	return len(t.WithItems) != 0 || t.WithParam != "" || t.WithSequence != nil
 }
 
+// Loop runs the template of a step or task repeatedly. Each iteration is a child of a single Loop node, whose outputs
+// are those of the last iteration
+type Loop struct {
+	// Until is an expression evaluated after each successful iteration, the loop completes once it is true.
+	// The outputs of the iteration are available as `outputs.parameters.<name>`, `outputs.result` and
+	// `outputs.exitCode`, and its zero-based index as `iteration`, e.g. "outputs.parameters.status == 'ready'"
+	Until string `json:"until" protobuf:"bytes,1,opt,name=until"`
+
+	// MaxIterations is the maximum number of iterations. The loop fails if the until expression is still false
+	// after the last of them
+	MaxIterations *intstr.IntOrString `json:"maxIterations" protobuf:"bytes,2,opt,name=maxIterations"`
+}
+
 // SuspendTemplate is a template subtype to suspend a workflow at a predetermined point in time
 type SuspendTemplate struct {
 	// Duration is the seconds to wait before automatically resuming a template. Must be a string. Default unit is seconds.
@@ -3320,6 +3669,9 @@ func (out *Outputs) HasOutputs() bool {
 	if len(out.Parameters) > 0 {
 		return true
 	}
+	if out.DAG != nil {
+		return true
+	}
 	return false
 }
 
@@ -3342,6 +3694,10 @@ func (out *Outputs) HasParameters() bool {
 	return out != nil && len(out.Parameters) > 0
 }
 
+func (out *Outputs) HasDAG() bool {
+	return out != nil && out.DAG != nil
+}
+
 const LogsSuffix = "-logs"
 
 func (out *Outputs) HasLogs() bool {
@@ -3494,6 +3850,9 @@ func resolveTemplateReference(callerScope ResourceScope, resourceName string, ca
 		if tmplRef.ClusterScope {
 			referenceScope = ResourceScopeCluster
 		}
+		if tmplRef.Revision > 0 {
+			return fmt.Sprintf("%s/%s@%d/%s", referenceScope, tmplRef.Name, tmplRef.Revision, tmplRef.Template), true
+		}
 		return fmt.Sprintf("%s/%s/%s", referenceScope, tmplRef.Name, tmplRef.Template), true
 	} else if callerScope != ResourceScopeLocal {
 		// Either a WorkflowTemplate or a ClusterWorkflowTemplate is calling a template inside itself. Template storage is needed
@@ -3699,6 +4058,67 @@ type Counter struct {
 	Value string `json:"value" protobuf:"bytes,1,opt,name=value"`
 }
 
+// Gang holds configuration of the all-or-nothing admission of a group of sibling pods. The pods of a group are only
+// created once there is enough resource quota headroom in the namespace for the minimum number of them.
+type Gang struct {
+	// MinAvailable is the number, or percentage, of the group's pods that must be placeable before any of them are
+	// created. Defaults to all of them.
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty" protobuf:"bytes,1,opt,name=minAvailable"`
+}
+
+// GetMinAvailable returns how many of the pods of a group of the given size must be placeable for it to be admitted
+func (g *Gang) GetMinAvailable(size int) (int, error) {
+	if g.MinAvailable == nil {
+		return size, nil
+	}
+	minAvailable := *g.MinAvailable
+	if minAvailable.Type == intstr.String && !strings.HasSuffix(minAvailable.StrVal, "%") {
+		i, err := strconv.Atoi(minAvailable.StrVal)
+		if err != nil {
+			return 0, fmt.Errorf("minAvailable must be a number or percentage, not %q", minAvailable.StrVal)
+		}
+		minAvailable = intstr.FromInt32(int32(i))
+	}
+	n, err := intstr.GetScaledValueFromIntOrPercent(&minAvailable, size, true)
+	if err != nil {
+		return 0, fmt.Errorf("minAvailable %w", err)
+	}
+	return min(n, size), nil
+}
+
+// Checkpoint holds configuration of the checkpointing of a directory of the main container. The directory is an empty
+// volume, which is restored from the latest checkpoint, if there is one, before the main container starts.
+type Checkpoint struct {
+	// Path is the directory in the main container to checkpoint
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+	// Interval is how often (e.g. "5m") the directory is saved. Defaults to 5m.
+	Interval string `json:"interval,omitempty" protobuf:"bytes,2,opt,name=interval"`
+	// Key is where checkpoints are saved in the artifact repository. Defaults to a key unique to the node, and shared
+	// by its retries.
+	Key string `json:"key,omitempty" protobuf:"bytes,3,opt,name=key"`
+}
+
+// DefaultCheckpointInterval is how often a directory is checkpointed, unless configured otherwise
+const DefaultCheckpointInterval = 5 * time.Minute
+
+// GetInterval returns how often the directory is saved
+func (c *Checkpoint) GetInterval() (time.Duration, error) {
+	if c.Interval == "" {
+		return DefaultCheckpointInterval, nil
+	}
+	return ParseStringToDuration(c.Interval)
+}
+
+// CheckpointStatus is the status of the checkpoints of a node
+type CheckpointStatus struct {
+	// Artifact is where the node's checkpoints are saved
+	Artifact Artifact `json:"artifact" protobuf:"bytes,1,opt,name=artifact"`
+	// SavedAt is when the latest checkpoint was saved
+	SavedAt *metav1.Time `json:"savedAt,omitempty" protobuf:"bytes,2,opt,name=savedAt"`
+	// RestoredAt is when the directory was restored from a checkpoint, if it was
+	RestoredAt *metav1.Time `json:"restoredAt,omitempty" protobuf:"bytes,3,opt,name=restoredAt"`
+}
+
 // Memoization enables caching for the Outputs of the template
 type Memoize struct {
 	// Key is the key to use as the caching key
@@ -3718,12 +4138,79 @@ type MemoizationStatus struct {
 	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
 	// Cache is the name of the cache that was used
 	CacheName string `json:"cacheName" protobuf:"bytes,3,opt,name=cacheName"`
+	// CacheType is the type of the cache that was used
+	CacheType MemoizationCacheType `json:"cacheType,omitempty" protobuf:"bytes,4,opt,name=cacheType,casttype=MemoizationCacheType"`
 }
 
+// GetCacheType returns the type of the cache that was used. Nodes memoized before other types of cache were
+// supported used a ConfigMap.
+func (m *MemoizationStatus) GetCacheType() MemoizationCacheType {
+	if m.CacheType == "" {
+		return MemoizationCacheTypeConfigMap
+	}
+	return m.CacheType
+}
+
+// MemoizationCacheType is the type of a memoization cache
+type MemoizationCacheType string
+
+const (
+	MemoizationCacheTypeConfigMap MemoizationCacheType = "ConfigMap"
+	MemoizationCacheTypeSQL       MemoizationCacheType = "SQL"
+	MemoizationCacheTypeArtifact  MemoizationCacheType = "Artifact"
+)
+
 // Cache is the configuration for the type of cache to be used
 type Cache struct {
 	// ConfigMap sets a ConfigMap-based cache
-	ConfigMap *apiv1.ConfigMapKeySelector `json:"configMap" protobuf:"bytes,1,opt,name=configMap"`
+	ConfigMap *apiv1.ConfigMapKeySelector `json:"configMap,omitempty" protobuf:"bytes,1,opt,name=configMap"`
+	// SQL sets a cache held in the controller's persistence database
+	SQL *SQLCache `json:"sql,omitempty" protobuf:"bytes,2,opt,name=sql"`
+	// Artifact sets a cache held in the controller's default artifact repository, with an object per key
+	Artifact *ArtifactCache `json:"artifact,omitempty" protobuf:"bytes,3,opt,name=artifact"`
+}
+
+// GetType returns the type of the cache, or an empty string if none is set
+func (c *Cache) GetType() MemoizationCacheType {
+	switch {
+	case c == nil:
+		return ""
+	case c.ConfigMap != nil:
+		return MemoizationCacheTypeConfigMap
+	case c.SQL != nil:
+		return MemoizationCacheTypeSQL
+	case c.Artifact != nil:
+		return MemoizationCacheTypeArtifact
+	}
+	return ""
+}
+
+// GetName returns the name of the cache
+func (c *Cache) GetName() string {
+	switch {
+	case c == nil:
+		return ""
+	case c.ConfigMap != nil:
+		return c.ConfigMap.Name
+	case c.SQL != nil:
+		return c.SQL.Name
+	case c.Artifact != nil:
+		return c.Artifact.Name
+	}
+	return ""
+}
+
+// SQLCache is a memoization cache held in the controller's persistence database
+type SQLCache struct {
+	// Name of the cache
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+}
+
+// ArtifactCache is a memoization cache held in the controller's default artifact repository.
+// Each entry is stored as the object "memoization/<name>/<key>.json".
+type ArtifactCache struct {
+	// Name of the cache
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 }
 
 type SynchronizationAction interface {
@@ -3926,6 +4413,22 @@ func (ss *SynchronizationStatus) GetStatus(syncType SynchronizationType) Synchro
 	}
 }
 
+// CompactedNodes summarizes the completed, successful nodes of an expanded step or task, which were collapsed into a
+// single node to reduce the size of the workflow's status
+type CompactedNodes struct {
+	// Count is the number of nodes
+	Count int32 `json:"count" protobuf:"varint,1,opt,name=count"`
+
+	// MinDurationSeconds is the duration of the shortest of the nodes
+	MinDurationSeconds int64 `json:"minDurationSeconds,omitempty" protobuf:"varint,2,opt,name=minDurationSeconds"`
+
+	// MaxDurationSeconds is the duration of the longest of the nodes
+	MaxDurationSeconds int64 `json:"maxDurationSeconds,omitempty" protobuf:"varint,3,opt,name=maxDurationSeconds"`
+
+	// Artifact holds the statuses of the nodes, including their outputs
+	Artifact Artifact `json:"artifact" protobuf:"bytes,4,opt,name=artifact"`
+}
+
 // NodeSynchronizationStatus stores the status of a node
 type NodeSynchronizationStatus struct {
 	// Waiting is the name of the lock that this node is waiting for
@@ -13,6 +13,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/ptr"
 )
@@ -458,6 +459,9 @@ func TestArtifactLocation_Get(t *testing.T) {
 	v, _ = (&ArtifactLocation{HTTP: &HTTPArtifact{}}).Get()
 	assert.IsType(t, &HTTPArtifact{}, v)
 
+	v, _ = (&ArtifactLocation{OCI: &OCIArtifact{}}).Get()
+	assert.IsType(t, &OCIArtifact{}, v)
+
 	v, _ = (&ArtifactLocation{OSS: &OSSArtifact{}}).Get()
 	assert.IsType(t, &OSSArtifact{}, v)
 
@@ -466,6 +470,9 @@ func TestArtifactLocation_Get(t *testing.T) {
 
 	v, _ = (&ArtifactLocation{S3: &S3Artifact{}}).Get()
 	assert.IsType(t, &S3Artifact{}, v)
+
+	v, _ = (&ArtifactLocation{SFTP: &SFTPArtifact{}}).Get()
+	assert.IsType(t, &SFTPArtifact{}, v)
 }
 
 func TestArtifactLocation_SetType(t *testing.T) {
@@ -498,6 +505,11 @@ func TestArtifactLocation_SetType(t *testing.T) {
 		require.NoError(t, l.SetType(&HTTPArtifact{}))
 		assert.NotNil(t, l.HTTP)
 	})
+	t.Run("OCI", func(t *testing.T) {
+		l := &ArtifactLocation{}
+		require.NoError(t, l.SetType(&OCIArtifact{}))
+		assert.NotNil(t, l.OCI)
+	})
 	t.Run("OSS", func(t *testing.T) {
 		l := &ArtifactLocation{}
 		require.NoError(t, l.SetType(&OSSArtifact{}))
@@ -513,6 +525,11 @@ func TestArtifactLocation_SetType(t *testing.T) {
 		require.NoError(t, l.SetType(&S3Artifact{}))
 		assert.NotNil(t, l.S3)
 	})
+	t.Run("SFTP", func(t *testing.T) {
+		l := &ArtifactLocation{}
+		require.NoError(t, l.SetType(&SFTPArtifact{}))
+		assert.NotNil(t, l.SFTP)
+	})
 	t.Run("Azure", func(t *testing.T) {
 		l := &ArtifactLocation{}
 		require.NoError(t, l.SetType(&AzureArtifact{}))
@@ -550,6 +567,18 @@ func TestArtifactLocation_Key(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "my-dir/my-file", l.Azure.Blob, "appends to Azure Blob name")
 	})
+	t.Run("SFTP", func(t *testing.T) {
+		l := &ArtifactLocation{SFTP: &SFTPArtifact{Path: "/my-dir"}}
+		err := l.AppendToKey("my-file")
+		require.NoError(t, err)
+		assert.Equal(t, "/my-dir/my-file", l.SFTP.Path, "appends to SFTP path")
+	})
+	t.Run("OCI", func(t *testing.T) {
+		l := &ArtifactLocation{OCI: &OCIArtifact{Key: "my-dir"}}
+		err := l.AppendToKey("my-file")
+		require.NoError(t, err)
+		assert.Equal(t, "my-dir/my-file", l.OCI.Key, "appends to OCI key")
+	})
 	t.Run("Git", func(t *testing.T) {
 		l := &ArtifactLocation{Git: &GitArtifact{}}
 		assert.False(t, l.HasKey())
@@ -1668,3 +1697,23 @@ func TestInlineStore(t *testing.T) {
 		})
 	}
 }
+
+func TestGang_GetMinAvailable(t *testing.T) {
+	for _, tt := range []struct {
+		minAvailable *intstr.IntOrString
+		expected     int
+	}{
+		{nil, 8},
+		{ptr.To(intstr.FromInt32(3)), 3},
+		{ptr.To(intstr.FromInt32(20)), 8},
+		{ptr.To(intstr.FromString("3")), 3},
+		{ptr.To(intstr.FromString("50%")), 4},
+		{ptr.To(intstr.FromString("30%")), 3},
+	} {
+		minAvailable, err := (&Gang{MinAvailable: tt.minAvailable}).GetMinAvailable(8)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, minAvailable)
+	}
+	_, err := (&Gang{MinAvailable: ptr.To(intstr.FromString("all"))}).GetMinAvailable(8)
+	require.EqualError(t, err, `minAvailable must be a number or percentage, not "all"`)
+}
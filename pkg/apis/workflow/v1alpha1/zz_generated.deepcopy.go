@@ -49,6 +49,11 @@ func (in *ArchiveStrategy) DeepCopyInto(out *ArchiveStrategy) {
 		*out = new(ZipStrategy)
 		**out = **in
 	}
+	if in.Zstd != nil {
+		in, out := &in.Zstd, &out.Zstd
+		*out = new(ZstdStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -141,6 +146,16 @@ func (in *Artifact) DeepCopyInto(out *Artifact) {
 		*out = new(ArtifactGC)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(ArtifactEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StreamSource != nil {
+		in, out := &in.StreamSource, &out.StreamSource
+		*out = new(ArtifactStreamSource)
+		**out = **in
+	}
 	return
 }
 
@@ -154,6 +169,43 @@ func (in *Artifact) DeepCopy() *Artifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactCache) DeepCopyInto(out *ArtifactCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactCache.
+func (in *ArtifactCache) DeepCopy() *ArtifactCache {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactEncryption) DeepCopyInto(out *ArtifactEncryption) {
+	*out = *in
+	if in.KeySecret != nil {
+		in, out := &in.KeySecret, &out.KeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactEncryption.
+func (in *ArtifactEncryption) DeepCopy() *ArtifactEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactGC) DeepCopyInto(out *ArtifactGC) {
 	*out = *in
@@ -274,6 +326,21 @@ func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 		*out = new(AzureArtifact)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ContentAddressable != nil {
+		in, out := &in.ContentAddressable, &out.ContentAddressable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIArtifact)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SFTP != nil {
+		in, out := &in.SFTP, &out.SFTP
+		*out = new(SFTPArtifact)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -370,6 +437,21 @@ func (in *ArtifactRepository) DeepCopyInto(out *ArtifactRepository) {
 		*out = new(AzureArtifactRepository)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ContentAddressable != nil {
+		in, out := &in.ContentAddressable, &out.ContentAddressable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIArtifactRepository)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(ArtifactRetention)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -465,6 +547,27 @@ func (in *ArtifactResultNodeStatus) DeepCopy() *ArtifactResultNodeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactRetention) DeepCopyInto(out *ArtifactRetention) {
+	*out = *in
+	if in.KeepLatest != nil {
+		in, out := &in.KeepLatest, &out.KeepLatest
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactRetention.
+func (in *ArtifactRetention) DeepCopy() *ArtifactRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactSearchQuery) DeepCopyInto(out *ArtifactSearchQuery) {
 	*out = *in
@@ -539,6 +642,22 @@ func (in ArtifactSearchResults) DeepCopy() ArtifactSearchResults {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactStreamSource) DeepCopyInto(out *ArtifactStreamSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactStreamSource.
+func (in *ArtifactStreamSource) DeepCopy() *ArtifactStreamSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactStreamSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactoryArtifact) DeepCopyInto(out *ArtifactoryArtifact) {
 	*out = *in
@@ -731,6 +850,16 @@ func (in *Cache) DeepCopyInto(out *Cache) {
 		*out = new(v1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SQL != nil {
+		in, out := &in.SQL, &out.SQL
+		*out = new(SQLCache)
+		**out = **in
+	}
+	if in.Artifact != nil {
+		in, out := &in.Artifact, &out.Artifact
+		*out = new(ArtifactCache)
+		**out = **in
+	}
 	return
 }
 
@@ -744,6 +873,47 @@ func (in *Cache) DeepCopy() *Cache {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Checkpoint) DeepCopyInto(out *Checkpoint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Checkpoint.
+func (in *Checkpoint) DeepCopy() *Checkpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(Checkpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CheckpointStatus) DeepCopyInto(out *CheckpointStatus) {
+	*out = *in
+	in.Artifact.DeepCopyInto(&out.Artifact)
+	if in.SavedAt != nil {
+		in, out := &in.SavedAt, &out.SavedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.RestoredAt != nil {
+		in, out := &in.RestoredAt, &out.RestoredAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CheckpointStatus.
+func (in *CheckpointStatus) DeepCopy() *CheckpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CheckpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientCertAuth) DeepCopyInto(out *ClientCertAuth) {
 	*out = *in
@@ -868,6 +1038,23 @@ func (in *Column) DeepCopy() *Column {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompactedNodes) DeepCopyInto(out *CompactedNodes) {
+	*out = *in
+	in.Artifact.DeepCopyInto(&out.Artifact)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompactedNodes.
+func (in *CompactedNodes) DeepCopy() *CompactedNodes {
+	if in == nil {
+		return nil
+	}
+	out := new(CompactedNodes)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -1168,6 +1355,22 @@ func (in *CronWorkflowStatus) DeepCopy() *CronWorkflowStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DAGOutput) DeepCopyInto(out *DAGOutput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DAGOutput.
+func (in *DAGOutput) DeepCopy() *DAGOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(DAGOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DAGTask) DeepCopyInto(out *DAGTask) {
 	*out = *in
@@ -1211,6 +1414,11 @@ func (in *DAGTask) DeepCopyInto(out *DAGTask) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Loop != nil {
+		in, out := &in.Loop, &out.Loop
+		*out = new(Loop)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1382,6 +1590,27 @@ func (in *GCSBucket) DeepCopy() *GCSBucket {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Gang) DeepCopyInto(out *Gang) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gang.
+func (in *Gang) DeepCopy() *Gang {
+	if in == nil {
+		return nil
+	}
+	out := new(Gang)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Gauge) DeepCopyInto(out *Gauge) {
 	*out = *in
@@ -1431,6 +1660,11 @@ func (in *GitArtifact) DeepCopyInto(out *GitArtifact) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Commit != nil {
+		in, out := &in.Commit, &out.Commit
+		*out = new(GitCommit)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1444,6 +1678,27 @@ func (in *GitArtifact) DeepCopy() *GitArtifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitCommit) DeepCopyInto(out *GitCommit) {
+	*out = *in
+	if in.SigningKeySecret != nil {
+		in, out := &in.SigningKeySecret, &out.SigningKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitCommit.
+func (in *GitCommit) DeepCopy() *GitCommit {
+	if in == nil {
+		return nil
+	}
+	out := new(GitCommit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HDFSArtifact) DeepCopyInto(out *HDFSArtifact) {
 	*out = *in
@@ -1889,6 +2144,27 @@ func (in *Link) DeepCopy() *Link {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Loop) DeepCopyInto(out *Loop) {
+	*out = *in
+	if in.MaxIterations != nil {
+		in, out := &in.MaxIterations, &out.MaxIterations
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Loop.
+func (in *Loop) DeepCopy() *Loop {
+	if in == nil {
+		return nil
+	}
+	out := new(Loop)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManifestFrom) DeepCopyInto(out *ManifestFrom) {
 	*out = *in
@@ -2102,6 +2378,11 @@ func (in *NodeResult) DeepCopyInto(out *NodeResult) {
 		*out = new(Outputs)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Checkpoint != nil {
+		in, out := &in.Checkpoint, &out.Checkpoint
+		*out = new(CheckpointStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2147,6 +2428,16 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 		*out = new(Inputs)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ArgumentOverrides != nil {
+		in, out := &in.ArgumentOverrides, &out.ArgumentOverrides
+		*out = new(Arguments)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Checkpoint != nil {
+		in, out := &in.Checkpoint, &out.Checkpoint
+		*out = new(CheckpointStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Outputs != nil {
 		in, out := &in.Outputs, &out.Outputs
 		*out = new(Outputs)
@@ -2172,6 +2463,16 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 		*out = new(NodeSynchronizationStatus)
 		**out = **in
 	}
+	if in.Compacted != nil {
+		in, out := &in.Compacted, &out.Compacted
+		*out = new(CompactedNodes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EscalatedResources != nil {
+		in, out := &in.EscalatedResources, &out.EscalatedResources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2296,6 +2597,66 @@ func (in *OAuth2EndpointParam) DeepCopy() *OAuth2EndpointParam {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifact) DeepCopyInto(out *OCIArtifact) {
+	*out = *in
+	in.OCIRegistry.DeepCopyInto(&out.OCIRegistry)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifact.
+func (in *OCIArtifact) DeepCopy() *OCIArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifactRepository) DeepCopyInto(out *OCIArtifactRepository) {
+	*out = *in
+	in.OCIRegistry.DeepCopyInto(&out.OCIRegistry)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifactRepository.
+func (in *OCIArtifactRepository) DeepCopy() *OCIArtifactRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifactRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIRegistry) DeepCopyInto(out *OCIRegistry) {
+	*out = *in
+	if in.UsernameSecret != nil {
+		in, out := &in.UsernameSecret, &out.UsernameSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIRegistry.
+func (in *OCIRegistry) DeepCopy() *OCIRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OSSArtifact) DeepCopyInto(out *OSSArtifact) {
 	*out = *in
@@ -2425,6 +2786,11 @@ func (in *Outputs) DeepCopyInto(out *Outputs) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DAG != nil {
+		in, out := &in.DAG, &out.DAG
+		*out = new(DAGOutput)
+		**out = **in
+	}
 	return
 }
 
@@ -2598,6 +2964,37 @@ func (in *RawArtifact) DeepCopy() *RawArtifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceEscalation) DeepCopyInto(out *ResourceEscalation) {
+	*out = *in
+	if in.Factor != nil {
+		in, out := &in.Factor, &out.Factor
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxMemory != nil {
+		in, out := &in.MaxMemory, &out.MaxMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxCPU != nil {
+		in, out := &in.MaxCPU, &out.MaxCPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceEscalation.
+func (in *ResourceEscalation) DeepCopy() *ResourceEscalation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceEscalation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceTemplate) DeepCopyInto(out *ResourceTemplate) {
 	*out = *in
@@ -2683,6 +3080,58 @@ func (in *RetryNodeAntiAffinity) DeepCopy() *RetryNodeAntiAffinity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryRule) DeepCopyInto(out *RetryRule) {
+	*out = *in
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(RetryRuleMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryRule.
+func (in *RetryRule) DeepCopy() *RetryRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryRuleMatch) DeepCopyInto(out *RetryRuleMatch) {
+	*out = *in
+	if in.ExitCodes != nil {
+		in, out := &in.ExitCodes, &out.ExitCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryRuleMatch.
+func (in *RetryRuleMatch) DeepCopy() *RetryRuleMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryRuleMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
 	*out = *in
@@ -2701,6 +3150,18 @@ func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
 		*out = new(RetryAffinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RetryRules != nil {
+		in, out := &in.RetryRules, &out.RetryRules
+		*out = make([]RetryRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceEscalation != nil {
+		in, out := &in.ResourceEscalation, &out.ResourceEscalation
+		*out = new(ResourceEscalation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2786,6 +3247,11 @@ func (in *S3Bucket) DeepCopyInto(out *S3Bucket) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TransferOptions != nil {
+		in, out := &in.TransferOptions, &out.TransferOptions
+		*out = new(S3TransferOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2820,6 +3286,96 @@ func (in *S3EncryptionOptions) DeepCopy() *S3EncryptionOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3TransferOptions) DeepCopyInto(out *S3TransferOptions) {
+	*out = *in
+	if in.PartSize != nil {
+		in, out := &in.PartSize, &out.PartSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3TransferOptions.
+func (in *S3TransferOptions) DeepCopy() *S3TransferOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(S3TransferOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SFTPArtifact) DeepCopyInto(out *SFTPArtifact) {
+	*out = *in
+	in.SFTPServer.DeepCopyInto(&out.SFTPServer)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SFTPArtifact.
+func (in *SFTPArtifact) DeepCopy() *SFTPArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(SFTPArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SFTPServer) DeepCopyInto(out *SFTPServer) {
+	*out = *in
+	if in.UsernameSecret != nil {
+		in, out := &in.UsernameSecret, &out.UsernameSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSHPrivateKeySecret != nil {
+		in, out := &in.SSHPrivateKeySecret, &out.SSHPrivateKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KnownHostsSecret != nil {
+		in, out := &in.KnownHostsSecret, &out.KnownHostsSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SFTPServer.
+func (in *SFTPServer) DeepCopy() *SFTPServer {
+	if in == nil {
+		return nil
+	}
+	out := new(SFTPServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLCache) DeepCopyInto(out *SQLCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLCache.
+func (in *SQLCache) DeepCopy() *SQLCache {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScriptTemplate) DeepCopyInto(out *ScriptTemplate) {
 	*out = *in
@@ -2866,6 +3422,11 @@ func (in *SemaphoreRef) DeepCopyInto(out *SemaphoreRef) {
 		*out = new(v1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(SyncDatabaseRef)
+		**out = **in
+	}
 	return
 }
 
@@ -3042,6 +3603,22 @@ func (in *SuspendTemplate) DeepCopy() *SuspendTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncDatabaseRef) DeepCopyInto(out *SyncDatabaseRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncDatabaseRef.
+func (in *SyncDatabaseRef) DeepCopy() *SyncDatabaseRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncDatabaseRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Synchronization) DeepCopyInto(out *Synchronization) {
 	*out = *in
@@ -3338,6 +3915,16 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(Memoize)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Gang != nil {
+		in, out := &in.Gang, &out.Gang
+		*out = new(Gang)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Checkpoint != nil {
+		in, out := &in.Checkpoint, &out.Checkpoint
+		*out = new(Checkpoint)
+		**out = **in
+	}
 	return
 }
 
@@ -4017,6 +4604,13 @@ func (in *WorkflowStatus) DeepCopyInto(out *WorkflowStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.NodeArgumentOverrides != nil {
+		in, out := &in.NodeArgumentOverrides, &out.NodeArgumentOverrides
+		*out = make(map[string]Arguments, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -4068,6 +4662,11 @@ func (in *WorkflowStep) DeepCopyInto(out *WorkflowStep) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Loop != nil {
+		in, out := &in.Loop, &out.Loop
+		*out = new(Loop)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4383,3 +4982,24 @@ func (in *ZipStrategy) DeepCopy() *ZipStrategy {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZstdStrategy) DeepCopyInto(out *ZstdStrategy) {
+	*out = *in
+	if in.CompressionLevel != nil {
+		in, out := &in.CompressionLevel, &out.CompressionLevel
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZstdStrategy.
+func (in *ZstdStrategy) DeepCopy() *ZstdStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ZstdStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
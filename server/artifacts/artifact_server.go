@@ -1,6 +1,8 @@
 package artifacts
 
 import (
+	"archive/tar"
+	"archive/zip"
 	"context"
 	"errors"
 	"fmt"
@@ -8,6 +10,7 @@ import (
 	"mime"
 	"net/http"
 	"path"
+	"strconv"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -23,10 +26,12 @@ import (
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	"github.com/argoproj/argo-workflows/v3/server/types"
+	"github.com/argoproj/argo-workflows/v3/util/file"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
 	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
@@ -70,8 +75,109 @@ func (a *ArtifactServer) GetInputArtifact(w http.ResponseWriter, r *http.Request
 //	/artifact-files/{namespace}/[archived-workflows|workflows]/{id}/{nodeId}/[inputs|outputs]/{artifactName}/{fileName}
 //	/artifact-files/{namespace}/[archived-workflows|workflows]/{id}/{nodeId}/[inputs|outputs]/{artifactName}/{fileDir}/.../{fileName}
 //
+// If the artifact was archived with the zstd strategy, the fileName and fileDir refer to the files within the tarball.
+//
 // 'id' field represents 'uid' for archived workflows and 'name' for non-archived
 func (a *ArtifactServer) GetArtifactFile(w http.ResponseWriter, r *http.Request) {
+	artifact, driver, fileName, ok := a.getArtifactFile(w, r)
+	if !ok {
+		return
+	}
+
+	isDir := strings.HasSuffix(r.URL.Path, "/")
+
+	if key, _ := artifact.GetKey(); isZstdTarballKey(key) && (fileName != nil || isDir) {
+		name := ""
+		if fileName != nil && *fileName != "." {
+			name = *fileName
+		}
+		err := a.returnTarballFile(w, r, artifact, driver, name, isDir)
+		if err != nil {
+			a.httpFromError(err, w)
+		}
+		return
+	}
+
+	if fileName != nil {
+		err := artifact.AppendToKey(*fileName)
+		if err != nil {
+			a.serverInternalError(fmt.Errorf("error appending filename %s to key of artifact %+v: err: %v", *fileName, artifact, err), w)
+			return
+		}
+		log.Debugf("appended key %s to artifact %+v", *fileName, artifact)
+	}
+
+	if !isDir {
+		isDir, err := driver.IsDirectory(artifact)
+		if err != nil {
+			if !argoerrors.IsCode(argoerrors.CodeNotImplemented, err) {
+				a.serverInternalError(err, w)
+				return
+			}
+		}
+		if isDir {
+			http.Redirect(w, r, r.URL.String()+"/", http.StatusTemporaryRedirect)
+			return
+		}
+	}
+
+	if isDir {
+		// return an html page to the user
+
+		objects, err := driver.ListObjects(artifact)
+		if err != nil {
+			a.httpFromError(err, w)
+			return
+		}
+		log.Debugf("this is a directory, artifact: %+v; files: %v", artifact, objects)
+
+		key, _ := artifact.GetKey()
+		files := make([]string, len(objects))
+		for i, object := range objects {
+			// object is prefixed by the key, we must trim it
+			files[i] = strings.TrimPrefix(object, key+"/")
+		}
+		writeDirectoryListing(w, r, files)
+
+	} else { // stream the file itself
+		log.Debugf("not a directory, artifact: %+v", artifact)
+
+		err := a.returnArtifact(w, r, artifact, driver)
+
+		if err != nil {
+			a.httpFromError(err, w)
+		}
+	}
+
+}
+
+// GetArtifactZip returns a ZIP of the files of a directory artifact, or of a directory within it, which are zipped as
+// they are streamed.
+// Valid requests:
+//
+//	/artifact-zips/{namespace}/[archived-workflows|workflows]/{id}/{nodeId}/[inputs|outputs]/{artifactName}
+//	/artifact-zips/{namespace}/[archived-workflows|workflows]/{id}/{nodeId}/[inputs|outputs]/{artifactName}/{fileDir}/...
+//
+// 'id' field represents 'uid' for archived workflows and 'name' for non-archived
+func (a *ArtifactServer) GetArtifactZip(w http.ResponseWriter, r *http.Request) {
+	artifact, driver, fileName, ok := a.getArtifactFile(w, r)
+	if !ok {
+		return
+	}
+	if fileName != nil && *fileName != "." {
+		if err := artifact.AppendToKey(*fileName); err != nil {
+			a.serverInternalError(fmt.Errorf("error appending filename %s to key of artifact %+v: err: %v", *fileName, artifact, err), w)
+			return
+		}
+	}
+	if err := a.returnZip(w, artifact, driver); err != nil {
+		a.httpFromError(err, w)
+	}
+}
+
+// getArtifactFile returns the artifact, and its driver, of a request to the artifact-files or artifact-zips endpoints,
+// and the name of the file within the artifact if the request has one. It writes the error response if it fails.
+func (a *ArtifactServer) getArtifactFile(w http.ResponseWriter, r *http.Request) (*wfv1.Artifact, common.ArtifactDriver, *string, bool) {
 
 	const (
 		namespaceIndex      = 2
@@ -92,7 +198,7 @@ func (a *ArtifactServer) GetArtifactFile(w http.ResponseWriter, r *http.Request)
 		fileName = &cleanedPath
 	} else if len(requestPath) < artifactNameIndex+1 {
 		a.httpBadRequestError(w)
-		return
+		return nil, nil, nil, false
 	}
 
 	namespace := requestPath[namespaceIndex]
@@ -104,14 +210,14 @@ func (a *ArtifactServer) GetArtifactFile(w http.ResponseWriter, r *http.Request)
 
 	if direction != Outputs && direction != Inputs { // for now we handle output and input artifacts
 		a.httpBadRequestError(w)
-		return
+		return nil, nil, nil, false
 	}
 
 	// verify user is authorized
 	ctx, err := a.gateKeeping(r, types.NamespaceHolder(namespace))
 	if err != nil {
 		a.unauthorizedError(w)
-		return
+		return nil, nil, nil, false
 	}
 
 	var wf *wfv1.Workflow
@@ -125,7 +231,7 @@ func (a *ArtifactServer) GetArtifactFile(w http.ResponseWriter, r *http.Request)
 		wf, err = a.getWorkflowAndValidate(ctx, namespace, workflowName)
 		if err != nil {
 			a.serverInternalError(err, w)
-			return
+			return nil, nil, nil, false
 		}
 	case "archived-workflows":
 		uid := id
@@ -134,24 +240,24 @@ func (a *ArtifactServer) GetArtifactFile(w http.ResponseWriter, r *http.Request)
 		wf, err = a.wfArchive.GetWorkflow(uid, "", "")
 		if err != nil {
 			a.serverInternalError(err, w)
-			return
+			return nil, nil, nil, false
 		}
 
 		// check that the namespace passed in matches this workflow's namespace
 		if wf.GetNamespace() != namespace {
 			a.httpBadRequestError(w)
-			return
+			return nil, nil, nil, false
 		}
 
 		// return 401 if the client does not have permission to get wf
 		err = a.validateAccess(ctx, wf)
 		if err != nil {
 			a.unauthorizedError(w)
-			return
+			return nil, nil, nil, false
 		}
 	default:
 		a.httpBadRequestError(w)
-		return
+		return nil, nil, nil, false
 	}
 
 	isInput := false
@@ -159,87 +265,12 @@ func (a *ArtifactServer) GetArtifactFile(w http.ResponseWriter, r *http.Request)
 		isInput = true
 	}
 
-	artifact, driver, err := a.getArtifactAndDriver(ctx, nodeId, artifactName, isInput, wf, fileName)
+	artifact, driver, err := a.getArtifactAndDriver(ctx, nodeId, artifactName, isInput, wf)
 	if err != nil {
 		a.serverInternalError(err, w)
-		return
-	}
-
-	isDir := strings.HasSuffix(r.URL.Path, "/")
-
-	if !isDir {
-		isDir, err := driver.IsDirectory(artifact)
-		if err != nil {
-			if !argoerrors.IsCode(argoerrors.CodeNotImplemented, err) {
-				a.serverInternalError(err, w)
-				return
-			}
-		}
-		if isDir {
-			http.Redirect(w, r, r.URL.String()+"/", http.StatusTemporaryRedirect)
-			return
-		}
-	}
-
-	if isDir {
-		// return an html page to the user
-
-		objects, err := driver.ListObjects(artifact)
-		if err != nil {
-			a.httpFromError(err, w)
-			return
-		}
-		log.Debugf("this is a directory, artifact: %+v; files: %v", artifact, objects)
-
-		key, _ := artifact.GetKey()
-		for _, object := range objects {
-
-			// object is prefixed by the key, we must trim it
-			dir, file := path.Split(strings.TrimPrefix(object, key+"/"))
-
-			// if dir is empty string, we are in the root dir
-			// we found in index.html, abort and redirect there
-			if dir == "" && file == "index.html" {
-				w.Header().Set("Location", r.URL.String()+"index.html")
-				w.WriteHeader(http.StatusTemporaryRedirect)
-				return
-			}
-		}
-
-		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte("<html><body><ul>\n"))
-
-		dirs := map[string]bool{} // to de-dupe sub-dirs
-
-		_, _ = w.Write([]byte(fmt.Sprintf("<li><a href=\"%s\">%s</a></li>\n", "..", "..")))
-
-		for _, object := range objects {
-
-			// object is prefixed the key, we must trim it
-			dir, file := path.Split(strings.TrimPrefix(object, key+"/"))
-
-			// if dir is empty string, we are in the root dir
-			if dir == "" {
-				_, _ = w.Write([]byte(fmt.Sprintf("<li><a href=\"%s\">%s</a></li>\n", file, file)))
-			} else if dirs[dir] {
-				continue
-			} else {
-				_, _ = w.Write([]byte(fmt.Sprintf("<li><a href=\"%s\">%s</a></li>\n", dir, dir)))
-				dirs[dir] = true
-			}
-		}
-		_, _ = w.Write([]byte("</ul></body></html>"))
-
-	} else { // stream the file itself
-		log.Debugf("not a directory, artifact: %+v", artifact)
-
-		err = a.returnArtifact(w, artifact, driver)
-
-		if err != nil {
-			a.httpFromError(err, w)
-		}
+		return nil, nil, nil, false
 	}
-
+	return artifact, driver, fileName, true
 }
 
 func (a *ArtifactServer) getArtifact(w http.ResponseWriter, r *http.Request, isInput bool) {
@@ -266,13 +297,13 @@ func (a *ArtifactServer) getArtifact(w http.ResponseWriter, r *http.Request, isI
 		a.httpFromError(err, w)
 		return
 	}
-	art, driver, err := a.getArtifactAndDriver(ctx, nodeId, artifactName, isInput, wf, nil)
+	art, driver, err := a.getArtifactAndDriver(ctx, nodeId, artifactName, isInput, wf)
 	if err != nil {
 		a.serverInternalError(err, w)
 		return
 	}
 
-	err = a.returnArtifact(w, art, driver)
+	err = a.returnArtifact(w, r, art, driver)
 
 	if err != nil {
 		a.httpFromError(err, w)
@@ -317,7 +348,7 @@ func (a *ArtifactServer) getArtifactByUID(w http.ResponseWriter, r *http.Request
 		a.unauthorizedError(w)
 		return
 	}
-	art, driver, err := a.getArtifactAndDriver(ctx, nodeId, artifactName, isInput, wf, nil)
+	art, driver, err := a.getArtifactAndDriver(ctx, nodeId, artifactName, isInput, wf)
 	if err != nil {
 		a.serverInternalError(err, w)
 		return
@@ -325,7 +356,7 @@ func (a *ArtifactServer) getArtifactByUID(w http.ResponseWriter, r *http.Request
 
 	log.WithFields(log.Fields{"uid": uid, "nodeId": nodeId, "artifactName": artifactName, "isInput": isInput}).Info("Download artifact")
 
-	err = a.returnArtifact(w, art, driver)
+	err = a.returnArtifact(w, r, art, driver)
 
 	if err != nil {
 		a.httpFromError(err, w)
@@ -385,7 +416,7 @@ func (a *ArtifactServer) httpFromError(err error, w http.ResponseWriter) {
 	}
 }
 
-func (a *ArtifactServer) getArtifactAndDriver(ctx context.Context, nodeId, artifactName string, isInput bool, wf *wfv1.Workflow, fileName *string) (*wfv1.Artifact, common.ArtifactDriver, error) {
+func (a *ArtifactServer) getArtifactAndDriver(ctx context.Context, nodeId, artifactName string, isInput bool, wf *wfv1.Workflow) (*wfv1.Artifact, common.ArtifactDriver, error) {
 
 	kubeClient := auth.GetKubeClient(ctx)
 
@@ -439,15 +470,7 @@ func (a *ArtifactServer) getArtifactAndDriver(ctx context.Context, nodeId, artif
 	if err != nil {
 		return art, nil, err
 	}
-	if fileName != nil {
-		err = art.AppendToKey(*fileName)
-		if err != nil {
-			return art, nil, fmt.Errorf("error appending filename %s to key of artifact %+v: err: %v", *fileName, art, err)
-		}
-		log.Debugf("appended key %s to artifact %+v", *fileName, art)
-	}
-
-	driver, err := a.artDriverFactory(ctx, art, resources{kubeClient, wf.Namespace})
+	driver, err := a.artDriverFactory(ctx, art, resource.New(kubeClient, wf.Namespace))
 	if err != nil {
 		return art, nil, err
 	}
@@ -456,7 +479,40 @@ func (a *ArtifactServer) getArtifactAndDriver(ctx context.Context, nodeId, artif
 	return art, driver, nil
 }
 
-func (a *ArtifactServer) returnArtifact(w http.ResponseWriter, art *wfv1.Artifact, driver common.ArtifactDriver) error {
+// returnArtifact returns the artifact, serving range and conditional requests when its driver can describe it and
+// stream part of it
+func (a *ArtifactServer) returnArtifact(w http.ResponseWriter, r *http.Request, art *wfv1.Artifact, driver common.ArtifactDriver) error {
+	key, _ := art.GetKey()
+	if streamer, ok := driver.(common.ArtifactRangeStreamer); ok {
+		stat, err := streamer.Stat(art)
+		if err == nil {
+			content := &rangeReader{art: art, driver: streamer, size: stat.Size}
+			defer func() {
+				if err := content.Close(); err != nil {
+					log.WithError(err).Warning("Error closing stream")
+				}
+			}()
+			setFileHeaders(w, key)
+			if stat.ETag != "" {
+				w.Header().Set("ETag", strconv.Quote(strings.Trim(stat.ETag, `"`)))
+			}
+			http.ServeContent(w, r, key, stat.LastModified, content)
+			return nil
+		}
+		// the artifact may still be streamed, e.g. a directory which the driver returns as a tarball
+		log.WithField("key", key).WithError(err).Debug("Failed to stat artifact, streaming it whole")
+	}
+
+	// the digest of the saved file is a strong validator for artifacts which cannot be described by their driver
+	if art.Digest != "" {
+		etag := strconv.Quote(art.Digest)
+		w.Header().Set("ETag", etag)
+		if etagMatches(r.Header.Get("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return nil
+		}
+	}
+
 	stream, err := driver.OpenStream(art)
 	if err != nil {
 		return err
@@ -468,13 +524,33 @@ func (a *ArtifactServer) returnArtifact(w http.ResponseWriter, art *wfv1.Artifac
 		}
 	}()
 
-	key, _ := art.GetKey()
-	w.Header().Add("Content-Disposition", fmt.Sprintf(`filename="%s"`, path.Base(key)))
-	w.Header().Add("Content-Type", mime.TypeByExtension(path.Ext(key)))
+	return writeFile(w, key, stream)
+}
+
+// etagMatches returns whether the If-None-Match header matches the entity tag
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, v := range strings.Split(ifNoneMatch, ",") {
+		v = strings.TrimPrefix(strings.TrimSpace(v), "W/")
+		if v == "*" || v == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// setFileHeaders sets the headers of a file response for its name
+func setFileHeaders(w http.ResponseWriter, name string) {
+	w.Header().Add("Content-Disposition", fmt.Sprintf(`filename="%s"`, path.Base(name)))
+	w.Header().Add("Content-Type", mime.TypeByExtension(path.Ext(name)))
 	w.Header().Add("Content-Security-Policy", env.GetString("ARGO_ARTIFACT_CONTENT_SECURITY_POLICY", "sandbox; base-uri 'none'; default-src 'none'; img-src 'self'; style-src 'self' 'unsafe-inline'"))
 	w.Header().Add("X-Frame-Options", env.GetString("ARGO_ARTIFACT_X_FRAME_OPTIONS", "SAMEORIGIN"))
+}
+
+// writeFile writes the content of a file with the headers for its name
+func writeFile(w http.ResponseWriter, name string, content io.Reader) error {
+	setFileHeaders(w, name)
 
-	_, err = io.Copy(w, stream)
+	_, err := io.Copy(w, content)
 	if err != nil {
 		errStr := fmt.Sprintf("failed to stream artifact: %v", err)
 		http.Error(w, errStr, http.StatusInternalServerError)
@@ -486,6 +562,161 @@ func (a *ArtifactServer) returnArtifact(w http.ResponseWriter, art *wfv1.Artifac
 	return nil
 }
 
+// writeDirectoryListing returns an html page listing the files of a directory, given by their paths relative to it,
+// or redirects to the directory's index.html if it has one
+func writeDirectoryListing(w http.ResponseWriter, r *http.Request, files []string) {
+	for _, f := range files {
+		dir, file := path.Split(f)
+
+		// if dir is empty string, we are in the root dir
+		// we found in index.html, abort and redirect there
+		if dir == "" && file == "index.html" {
+			w.Header().Set("Location", r.URL.String()+"index.html")
+			w.WriteHeader(http.StatusTemporaryRedirect)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("<html><body><ul>\n"))
+
+	dirs := map[string]bool{} // to de-dupe sub-dirs
+
+	_, _ = w.Write([]byte(fmt.Sprintf("<li><a href=\"%s\">%s</a></li>\n", "..", "..")))
+
+	for _, f := range files {
+		dir, file := path.Split(f)
+
+		// if dir is empty string, we are in the root dir
+		if dir == "" {
+			_, _ = w.Write([]byte(fmt.Sprintf("<li><a href=\"%s\">%s</a></li>\n", file, file)))
+		} else if dirs[dir] {
+			continue
+		} else {
+			_, _ = w.Write([]byte(fmt.Sprintf("<li><a href=\"%s\">%s</a></li>\n", dir, dir)))
+			dirs[dir] = true
+		}
+	}
+	_, _ = w.Write([]byte("</ul></body></html>"))
+}
+
+// returnZip returns a ZIP of the files of the directory with the artifact's key
+func (a *ArtifactServer) returnZip(w http.ResponseWriter, art *wfv1.Artifact, driver common.ArtifactDriver) error {
+	objects, err := driver.ListObjects(art)
+	if err != nil {
+		return err
+	}
+	key, _ := art.GetKey()
+	dirPrefix := strings.TrimSuffix(key, "/") + "/"
+	var files []string
+	for _, object := range objects {
+		if strings.HasPrefix(object, dirPrefix) && !strings.HasSuffix(object, "/") {
+			files = append(files, object)
+		}
+	}
+	if len(files) == 0 {
+		return argoerrors.Errorf(argoerrors.CodeNotFound, "%s is not a directory with files in artifact %s", key, art.Name)
+	}
+
+	w.Header().Add("Content-Disposition", fmt.Sprintf(`filename="%s.zip"`, path.Base(key)))
+	w.Header().Add("Content-Type", "application/zip")
+	zw := zip.NewWriter(w)
+	for _, file := range files {
+		if err := writeZipEntry(zw, art, driver, file, strings.TrimPrefix(file, dirPrefix)); err != nil {
+			// the response has started, so it can only be cut short
+			log.WithFields(log.Fields{"artifactName": art.Name, "key": file}).WithError(err).Error("Failed to zip artifact file")
+			return nil
+		}
+	}
+	if err := zw.Close(); err != nil {
+		log.WithField("artifactName", art.Name).WithError(err).Error("Failed to zip artifact")
+	}
+	return nil
+}
+
+// writeZipEntry streams the object with the key into the ZIP as the named file
+func writeZipEntry(zw *zip.Writer, art *wfv1.Artifact, driver common.ArtifactDriver, key, name string) error {
+	file := art.DeepCopy()
+	if err := file.SetKey(key); err != nil {
+		return err
+	}
+	stream, err := driver.OpenStream(file)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = stream.Close() }()
+	fw, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(fw, stream)
+	return err
+}
+
+// isZstdTarballKey returns whether the key is that of an artifact archived with the zstd strategy. The paths of gzip
+// tarballs are not served from within them, as they have always been appended to the artifact's key.
+func isZstdTarballKey(key string) bool {
+	return strings.HasSuffix(key, ".tar.zst")
+}
+
+// returnTarballFile returns a file within a tarball artifact, or a listing of the files in a directory within it.
+// The name is relative to the archived file or directory, and empty for the root.
+func (a *ArtifactServer) returnTarballFile(w http.ResponseWriter, r *http.Request, art *wfv1.Artifact, driver common.ArtifactDriver, name string, isDir bool) error {
+	stream, err := driver.OpenStream(art)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := stream.Close(); err != nil {
+			log.WithFields(log.Fields{"stream": stream}).WithError(err).Warning("Error closing stream")
+		}
+	}()
+	decompressed, err := file.GetDecompressedReader(stream)
+	if err != nil {
+		return err
+	}
+	defer decompressed.Close()
+
+	dirPrefix := ""
+	if name != "" {
+		dirPrefix = name + "/"
+	}
+	var files []string
+	tr := tar.NewReader(decompressed)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		// entries are prefixed by the name of the archived directory, unless a single file was archived
+		entryName := path.Clean(header.Name)
+		if _, rest, ok := strings.Cut(entryName, "/"); ok {
+			entryName = rest
+		}
+		if !isDir && entryName == name {
+			return writeFile(w, entryName, tr)
+		}
+		if strings.HasPrefix(entryName, dirPrefix) {
+			files = append(files, strings.TrimPrefix(entryName, dirPrefix))
+		}
+	}
+	if len(files) == 0 {
+		return argoerrors.Errorf(argoerrors.CodeNotFound, "%s not found in artifact %s", name, art.Name)
+	}
+	if !isDir {
+		http.Redirect(w, r, r.URL.String()+"/", http.StatusTemporaryRedirect)
+		return nil
+	}
+	writeDirectoryListing(w, r, files)
+	return nil
+}
+
 func (a *ArtifactServer) getWorkflowAndValidate(ctx context.Context, namespace string, workflowName string) (*wfv1.Workflow, error) {
 	wfClient := auth.GetWfClient(ctx)
 	wf, err := wfClient.ArgoprojV1alpha1().Workflows(namespace).Get(ctx, workflowName, metav1.GetOptions{})
@@ -1,6 +1,8 @@
 package artifacts
 
 import (
+	"archive/tar"
+	"archive/zip"
 	"bytes"
 	"context"
 	"errors"
@@ -12,7 +14,9 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/klauspost/compress/zstd"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 
 	argoerrors "github.com/argoproj/argo-workflows/v3/errors"
@@ -58,11 +62,14 @@ var bucketsOfKeys = map[string][]string{
 		"my-wf/my-node-1/my-s3-input-artifact.tgz",
 		"my-wf/my-node-1/my-s3-artifact-directory",
 		"my-wf/my-node-1/my-s3-artifact-directory/a.txt",
+		"my-wf/my-node-1/my-s3-artifact-directory/index.html",
 		"my-wf/my-node-1/my-s3-artifact-directory/subdirectory/b.txt",
+		"my-wf/my-node-1/my-s3-artifact-directory/subdirectory/c.txt",
 		"my-wf/my-node-1/my-gcs-artifact",
 		"my-wf/my-node-1/my-gcs-artifact.tgz",
 		"my-wf/my-node-1/my-oss-artifact.zip",
 		"my-wf/my-node-1/my-s3-artifact.tgz",
+		"my-wf/my-node-1/my-s3-artifact-archive.tar.zst",
 		"my-wf/my-node-inline/main.log",
 	},
 	"my-bucket-2": {
@@ -107,9 +114,72 @@ func (a *fakeArtifactDriver) OpenStream(artifact *wfv1.Artifact) (io.ReadCloser,
 		}
 	}
 
+	if strings.HasSuffix(key, ".tar.zst") {
+		return io.NopCloser(bytes.NewReader(a.zstdTarball())), nil
+	}
+
 	return io.NopCloser(bytes.NewReader(a.data)), nil
 }
 
+// zstdTarball returns a tar.zst of a directory with the files a.txt and subdirectory/b.txt
+func (a *fakeArtifactDriver) zstdTarball() []byte {
+	var buf bytes.Buffer
+	zw, err := zstd.NewWriter(&buf)
+	if err != nil {
+		panic(err)
+	}
+	tw := tar.NewWriter(zw)
+	for _, name := range []string{"my-dir/", "my-dir/a.txt", "my-dir/subdirectory/", "my-dir/subdirectory/b.txt"} {
+		header := &tar.Header{Name: name, Mode: 0o644, Typeflag: tar.TypeDir}
+		if !strings.HasSuffix(name, "/") {
+			header.Typeflag = tar.TypeReg
+			header.Size = int64(len(a.data))
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			panic(err)
+		}
+		if header.Typeflag == tar.TypeReg {
+			if _, err := tw.Write(a.data); err != nil {
+				panic(err)
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		panic(err)
+	}
+	if err := zw.Close(); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// Stat describes artifacts other than GCS ones, so that both range requests and whole streams are served
+func (a *fakeArtifactDriver) Stat(artifact *wfv1.Artifact) (*artifactscommon.ArtifactStat, error) {
+	if artifact.GCS != nil {
+		return nil, argoerrors.New(argoerrors.CodeNotImplemented, "Stat currently unimplemented for GCS")
+	}
+	stream, err := a.OpenStream(artifact)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(stream)
+	if err != nil {
+		return nil, err
+	}
+	return &artifactscommon.ArtifactStat{Size: int64(len(data)), ETag: "my-etag", LastModified: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}, nil
+}
+
+func (a *fakeArtifactDriver) OpenStreamFrom(artifact *wfv1.Artifact, offset int64) (io.ReadCloser, error) {
+	stream, err := a.OpenStream(artifact)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.CopyN(io.Discard, stream, offset); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
 func (a *fakeArtifactDriver) Save(_ string, _ *wfv1.Artifact) error {
 	return fmt.Errorf("not implemented")
 }
@@ -222,7 +292,17 @@ func newServer() *ArtifactServer {
 								},
 							},
 							{
-								Name: "my-gcs-artifact",
+								Name: "my-s3-artifact-archive",
+								ArtifactLocation: wfv1.ArtifactLocation{
+									S3: &wfv1.S3Artifact{
+										// S3 is a configured artifact repo, so does not need key
+										Key: "my-wf/my-node-1/my-s3-artifact-archive.tar.zst",
+									},
+								},
+							},
+							{
+								Name:   "my-gcs-artifact",
+								Digest: "sha256:my-digest",
 								ArtifactLocation: wfv1.ArtifactLocation{
 									GCS: &wfv1.GCSArtifact{
 										// GCS is not a configured artifact repo, so must have bucket
@@ -445,6 +525,31 @@ func TestArtifactServer_GetArtifactFile(t *testing.T) {
 			statusCode:  200,
 			isDirectory: false,
 		},
+		{
+			path:        "/artifact-files/my-ns/workflows/my-wf/my-node-1/outputs/my-s3-artifact-archive/",
+			statusCode:  200,
+			isDirectory: true,
+			directoryFiles: []string{
+				"..",
+				"a.txt",
+				"subdirectory/",
+			},
+		},
+		{
+			path:       "/artifact-files/my-ns/workflows/my-wf/my-node-1/outputs/my-s3-artifact-archive/subdirectory",
+			statusCode: 307, // redirect
+			location:   "/artifact-files/my-ns/workflows/my-wf/my-node-1/outputs/my-s3-artifact-archive/subdirectory/",
+		},
+		{
+			path:        "/artifact-files/my-ns/workflows/my-wf/my-node-1/outputs/my-s3-artifact-archive/subdirectory/b.txt",
+			statusCode:  200,
+			isDirectory: false,
+		},
+		{
+			path:        "/artifact-files/my-ns/workflows/my-wf/my-node-1/outputs/my-s3-artifact-archive/missing.txt",
+			statusCode:  404,
+			isDirectory: false,
+		},
 		{
 			path:        "/artifact-files/my-ns/workflows/my-wf/my-node-2/outputs/my-s3-artifact-bucket-3",
 			statusCode:  200,
@@ -527,6 +632,90 @@ func TestArtifactServer_GetOutputArtifact(t *testing.T) {
 	}
 }
 
+func TestArtifactServer_GetOutputArtifactRange(t *testing.T) {
+	s := newServer()
+	get := func(artifactName string, header http.Header) *http.Response {
+		r := &http.Request{Method: http.MethodGet, Header: header}
+		r.URL = mustParse("/artifacts/my-ns/my-wf/my-node-1/" + artifactName)
+		recorder := httptest.NewRecorder()
+		s.GetOutputArtifact(recorder, r)
+		return recorder.Result()
+	}
+
+	t.Run("Range", func(t *testing.T) {
+		resp := get("my-s3-artifact", http.Header{"Range": {"bytes=3-"}})
+		require.Equal(t, http.StatusPartialContent, resp.StatusCode)
+		assert.Equal(t, "bytes 3-6/7", resp.Header.Get("Content-Range"))
+		assert.Equal(t, `"my-etag"`, resp.Header.Get("ETag"))
+		assert.Equal(t, "Mon, 01 Jan 2024 00:00:00 GMT", resp.Header.Get("Last-Modified"))
+		all, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "data", string(all))
+	})
+	t.Run("NotModified", func(t *testing.T) {
+		resp := get("my-s3-artifact", http.Header{"If-None-Match": {`"my-etag"`}})
+		assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+	})
+	t.Run("Digest", func(t *testing.T) {
+		resp := get("my-gcs-artifact", http.Header{})
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, `"sha256:my-digest"`, resp.Header.Get("ETag"))
+		resp = get("my-gcs-artifact", http.Header{"If-None-Match": {`"sha256:my-digest"`}})
+		assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+	})
+}
+
+func TestArtifactServer_GetArtifactZip(t *testing.T) {
+	s := newServer()
+	get := func(path string) *http.Response {
+		r := &http.Request{}
+		r.URL = mustParse(path)
+		recorder := httptest.NewRecorder()
+		s.GetArtifactZip(recorder, r)
+		return recorder.Result()
+	}
+	unzip := func(t *testing.T, resp *http.Response) map[string]string {
+		data, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+		files := map[string]string{}
+		for _, f := range zr.File {
+			rc, err := f.Open()
+			require.NoError(t, err)
+			content, err := io.ReadAll(rc)
+			require.NoError(t, err)
+			files[f.Name] = string(content)
+		}
+		return files
+	}
+
+	t.Run("Directory", func(t *testing.T) {
+		resp := get("/artifact-zips/my-ns/workflows/my-wf/my-node-1/outputs/my-s3-artifact-directory")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, `filename="my-s3-artifact-directory.zip"`, resp.Header.Get("Content-Disposition"))
+		assert.Equal(t, map[string]string{
+			"a.txt":              "my-data",
+			"index.html":         "my-data",
+			"subdirectory/b.txt": "my-data",
+			"subdirectory/c.txt": "my-data",
+		}, unzip(t, resp))
+	})
+	t.Run("Subdirectory", func(t *testing.T) {
+		resp := get("/artifact-zips/my-ns/workflows/my-wf/my-node-1/outputs/my-s3-artifact-directory/subdirectory")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, map[string]string{"b.txt": "my-data", "c.txt": "my-data"}, unzip(t, resp))
+	})
+	t.Run("File", func(t *testing.T) {
+		resp := get("/artifact-zips/my-ns/workflows/my-wf/my-node-1/outputs/my-s3-artifact")
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+	t.Run("BadRequest", func(t *testing.T) {
+		resp := get("/artifact-zips/my-ns/workflows/my-wf/my-node-1/garbage/my-s3-artifact-directory")
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
 func TestArtifactServer_GetOutputArtifactWithTemplate(t *testing.T) {
 	s := newServer()
 
@@ -0,0 +1,65 @@
+package artifacts
+
+import (
+	"errors"
+	"io"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// rangeReader reads an artifact from the offset it was last seeked to. The artifact is streamed from that offset when
+// it is first read, so seeking is cheap, as http.ServeContent expects.
+type rangeReader struct {
+	art    *wfv1.Artifact
+	driver common.ArtifactRangeStreamer
+	size   int64
+	offset int64
+	stream io.ReadCloser
+}
+
+var _ io.ReadSeekCloser = &rangeReader{}
+
+func (r *rangeReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+	if r.stream == nil {
+		stream, err := r.driver.OpenStreamFrom(r.art, r.offset)
+		if err != nil {
+			return 0, err
+		}
+		r.stream = stream
+	}
+	n, err := r.stream.Read(p)
+	r.offset += int64(n)
+	return n, err
+}
+
+func (r *rangeReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	}
+	if offset < 0 {
+		return 0, errors.New("seek to negative offset")
+	}
+	if offset != r.offset {
+		if err := r.Close(); err != nil {
+			return 0, err
+		}
+		r.offset = offset
+	}
+	return offset, nil
+}
+
+func (r *rangeReader) Close() error {
+	if r.stream == nil {
+		return nil
+	}
+	err := r.stream.Close()
+	r.stream = nil
+	return err
+}
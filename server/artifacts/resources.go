@@ -1,29 +0,0 @@
-package artifacts
-
-import (
-	"context"
-
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/kubernetes"
-)
-
-type resources struct {
-	kubeClient kubernetes.Interface
-	namespace  string
-}
-
-func (r resources) GetSecret(ctx context.Context, name, key string) (string, error) {
-	secret, err := r.kubeClient.CoreV1().Secrets(r.namespace).Get(ctx, name, metav1.GetOptions{})
-	if err != nil {
-		return "", err
-	}
-	return string(secret.Data[key]), nil
-}
-
-func (r resources) GetConfigMapKey(ctx context.Context, name, key string) (string, error) {
-	configMap, err := r.kubeClient.CoreV1().ConfigMaps(r.namespace).Get(ctx, name, metav1.GetOptions{})
-	if err != nil {
-		return "", err
-	}
-	return configMap.Data[key], nil
-}
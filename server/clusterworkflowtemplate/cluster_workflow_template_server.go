@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc/codes"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	clusterwftmplpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
@@ -22,13 +23,17 @@ import (
 type ClusterWorkflowTemplateServer struct {
 	instanceIDService instanceid.Service
 	cwftmplStore      servertypes.ClusterWorkflowTemplateStore
+	revisions         sqldb.TemplateRevisionRepo
 }
 
-func NewClusterWorkflowTemplateServer(instanceID instanceid.Service, cwftmplStore servertypes.ClusterWorkflowTemplateStore) clusterwftmplpkg.ClusterWorkflowTemplateServiceServer {
+// NewClusterWorkflowTemplateServer returns a new ClusterWorkflowTemplateServer. Revisions of templates are recorded
+// by the controller. revisions may be nil if persistence is not configured, in which case they cannot be listed or
+// rolled back to.
+func NewClusterWorkflowTemplateServer(instanceID instanceid.Service, cwftmplStore servertypes.ClusterWorkflowTemplateStore, revisions sqldb.TemplateRevisionRepo) clusterwftmplpkg.ClusterWorkflowTemplateServiceServer {
 	if cwftmplStore == nil {
 		cwftmplStore = NewClusterWorkflowTemplateClientStore()
 	}
-	return &ClusterWorkflowTemplateServer{instanceID, cwftmplStore}
+	return &ClusterWorkflowTemplateServer{instanceID, cwftmplStore, revisions}
 }
 
 func (cwts *ClusterWorkflowTemplateServer) CreateClusterWorkflowTemplate(ctx context.Context, req *clusterwftmplpkg.ClusterWorkflowTemplateCreateRequest) (*v1alpha1.ClusterWorkflowTemplate, error) {
@@ -131,5 +136,8 @@ func (cwts *ClusterWorkflowTemplateServer) UpdateClusterWorkflowTemplate(ctx con
 	}
 
 	res, err := wfClient.ArgoprojV1alpha1().ClusterWorkflowTemplates().Update(ctx, req.Template, v1.UpdateOptions{})
-	return res, serverutils.ToStatusError(err, codes.Internal)
+	if err != nil {
+		return nil, serverutils.ToStatusError(err, codes.Internal)
+	}
+	return res, nil
 }
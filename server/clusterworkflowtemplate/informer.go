@@ -23,10 +23,12 @@ const (
 var _ types.ClusterWorkflowTemplateStore = &Informer{}
 
 type Informer struct {
-	informer wfextvv1alpha1.ClusterWorkflowTemplateInformer
+	informer  wfextvv1alpha1.ClusterWorkflowTemplateInformer
+	revisions templateresolution.TemplateRevisions
 }
 
-func NewInformer(restConfig *rest.Config) (*Informer, error) {
+// NewInformer returns a new Informer. revisions may be nil if persistence is not configured.
+func NewInformer(restConfig *rest.Config, revisions templateresolution.TemplateRevisions) (*Informer, error) {
 	dynamicInterface, err := dynamic.NewForConfig(restConfig)
 	if err != nil {
 		return nil, err
@@ -36,7 +38,8 @@ func NewInformer(restConfig *rest.Config) (*Informer, error) {
 		workflowTemplateResyncPeriod,
 	)
 	return &Informer{
-		informer: informer,
+		informer:  informer,
+		revisions: revisions,
 	}, nil
 }
 
@@ -58,5 +61,5 @@ func (cwti *Informer) Getter(_ context.Context) templateresolution.ClusterWorkfl
 	if cwti.informer == nil {
 		log.Fatal("Template informer not started")
 	}
-	return cwti.informer.Lister()
+	return templateresolution.WithClusterWorkflowTemplateRevisions(cwti.informer.Lister(), cwti.revisions)
 }
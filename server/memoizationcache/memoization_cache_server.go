@@ -0,0 +1,172 @@
+package memoizationcache
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	sutils "github.com/argoproj/argo-workflows/v3/server/utils"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
+)
+
+type memoizationCacheServer struct {
+	sqlRepo            sqldb.MemoizationCacheRepo
+	artifactRepository *wfv1.ArtifactRepository
+	newDriver          artifact.NewDriverFunc
+	kubeClient         kubernetes.Interface
+	namespace          string
+}
+
+// NewMemoizationCacheServer returns a new memoizationCacheServer. sqlRepo and artifactRepository may be nil if
+// persistence or a default artifact repository are not configured. The controller holds every cache in its own
+// namespace, whatever the namespace of the workflows using it, so caches are read from and written to the
+// namespace using kubeClient.
+func NewMemoizationCacheServer(sqlRepo sqldb.MemoizationCacheRepo, artifactRepository *wfv1.ArtifactRepository, kubeClient kubernetes.Interface, namespace string) memoizationcachepkg.MemoizationCacheServiceServer {
+	return &memoizationCacheServer{sqlRepo, artifactRepository, artifact.NewDriver, kubeClient, namespace}
+}
+
+// canI verifies the user may perform the verb on workflows, whose outputs the cache holds, in the namespace the
+// caches are held in
+func (s *memoizationCacheServer) canI(ctx context.Context, verb string) error {
+	allowed, err := auth.CanI(ctx, verb, workflow.WorkflowPlural, s.namespace, "")
+	if err != nil {
+		return sutils.ToStatusError(err, codes.Internal)
+	}
+	if !allowed {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to %s workflows in namespace \"%s\"", verb, s.namespace))
+	}
+	return nil
+}
+
+func (s *memoizationCacheServer) getCache(cacheType, name string) (cache.MemoizationCache, error) {
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "cache name is required")
+	}
+	switch cache.CacheType(cacheType) {
+	case "", cache.ConfigMapCache:
+		return cache.NewConfigMapCache(s.namespace, s.kubeClient, name), nil
+	case cache.SQLCache:
+		if s.sqlRepo == nil {
+			return nil, status.Error(codes.FailedPrecondition, "SQL caches require persistence to be configured")
+		}
+		return cache.NewSQLCache(s.namespace, s.sqlRepo, name), nil
+	case cache.ArtifactCache:
+		if s.artifactRepository == nil {
+			return nil, status.Error(codes.FailedPrecondition, "artifact caches require a default artifact repository to be configured")
+		}
+		return cache.NewArtifactCache(s.artifactRepository, s.newDriver, resource.New(s.kubeClient, s.namespace), name), nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown cache type %q, must be one of ConfigMap, SQL or Artifact", cacheType))
+	}
+}
+
+func (s *memoizationCacheServer) ListMemoizationCacheEntries(ctx context.Context, req *memoizationcachepkg.ListMemoizationCacheEntriesRequest) (*memoizationcachepkg.MemoizationCacheEntryList, error) {
+	if err := s.canI(ctx, "list"); err != nil {
+		return nil, err
+	}
+	c, err := s.getCache(req.CacheType, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := c.List(ctx)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	items := make([]*memoizationcachepkg.MemoizationCacheEntry, 0, len(entries))
+	for key, entry := range entries {
+		items = append(items, toEntry(key, entry))
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Key < items[j].Key })
+	return &memoizationcachepkg.MemoizationCacheEntryList{Items: items}, nil
+}
+
+func (s *memoizationCacheServer) GetMemoizationCacheEntry(ctx context.Context, req *memoizationcachepkg.GetMemoizationCacheEntryRequest) (*memoizationcachepkg.MemoizationCacheEntry, error) {
+	if err := s.canI(ctx, "get"); err != nil {
+		return nil, err
+	}
+	c, err := s.getCache(req.CacheType, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	// we list rather than load, as loading an entry records a hit
+	entries, err := c.List(ctx)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	entry, ok := entries[req.Key]
+	if !ok {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("cache entry %q not found", req.Key))
+	}
+	return toEntry(req.Key, entry), nil
+}
+
+func (s *memoizationCacheServer) DeleteMemoizationCacheEntry(ctx context.Context, req *memoizationcachepkg.DeleteMemoizationCacheEntryRequest) (*memoizationcachepkg.MemoizationCacheEntryDeletedResponse, error) {
+	if err := s.canI(ctx, "delete"); err != nil {
+		return nil, err
+	}
+	c, err := s.getCache(req.CacheType, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Delete(ctx, req.Key); err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	return &memoizationcachepkg.MemoizationCacheEntryDeletedResponse{}, nil
+}
+
+func (s *memoizationCacheServer) PruneMemoizationCache(ctx context.Context, req *memoizationcachepkg.PruneMemoizationCacheRequest) (*memoizationcachepkg.MemoizationCachePrunedResponse, error) {
+	if req.OlderThan == "" {
+		return nil, status.Error(codes.InvalidArgument, "olderThan is required")
+	}
+	olderThan, err := time.ParseDuration(req.OlderThan)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+	}
+	if err := s.canI(ctx, "delete"); err != nil {
+		return nil, err
+	}
+	c, err := s.getCache(req.CacheType, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := c.List(ctx)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	keys := []string{}
+	for key, entry := range entries {
+		if time.Since(entry.CreationTimestamp.Time) > olderThan {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := c.Delete(ctx, key); err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+	}
+	return &memoizationcachepkg.MemoizationCachePrunedResponse{Keys: keys}, nil
+}
+
+func toEntry(key string, entry *cache.Entry) *memoizationcachepkg.MemoizationCacheEntry {
+	return &memoizationcachepkg.MemoizationCacheEntry{
+		Key:               key,
+		NodeID:            entry.NodeID,
+		Outputs:           entry.Outputs,
+		CreationTimestamp: &metav1.Time{Time: entry.CreationTimestamp.Time},
+		LastHitTimestamp:  &metav1.Time{Time: entry.LastHitTimestamp.Time},
+	}
+}
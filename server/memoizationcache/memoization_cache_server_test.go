@@ -0,0 +1,109 @@
+package memoizationcache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func Test_memoizationCacheServer(t *testing.T) {
+	repo := &mocks.MemoizationCacheRepo{}
+	kubeClient := &kubefake.Clientset{}
+	allowed := true
+	kubeClient.AddReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+		}, nil
+	})
+	now := time.Now()
+	repo.On("List", "argo", "my-cache").Return([]sqldb.MemoizationCacheRecord{
+		{Key: "new", NodeID: "node-1", Outputs: "{}", CreationTime: now, LastHitTime: now},
+		{Key: "old", NodeID: "node-2", Outputs: "{}", CreationTime: now.Add(-48 * time.Hour), LastHitTime: now},
+	}, nil)
+	repo.On("Delete", "argo", "my-cache", "old").Return(nil)
+	s := NewMemoizationCacheServer(repo, nil, kubeClient, "argo")
+	ctx := context.WithValue(context.TODO(), auth.KubeKey, kubeClient)
+
+	t.Run("ListMemoizationCacheEntries", func(t *testing.T) {
+		list, err := s.ListMemoizationCacheEntries(ctx, &memoizationcachepkg.ListMemoizationCacheEntriesRequest{Namespace: "argo", Name: "my-cache", CacheType: "SQL"})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 2)
+		assert.Equal(t, "new", list.Items[0].Key)
+		assert.Equal(t, "node-1", list.Items[0].NodeID)
+		assert.Equal(t, "old", list.Items[1].Key)
+	})
+	t.Run("GetMemoizationCacheEntry", func(t *testing.T) {
+		entry, err := s.GetMemoizationCacheEntry(ctx, &memoizationcachepkg.GetMemoizationCacheEntryRequest{Namespace: "argo", Name: "my-cache", Key: "old", CacheType: "SQL"})
+		require.NoError(t, err)
+		assert.Equal(t, "node-2", entry.NodeID)
+		_, err = s.GetMemoizationCacheEntry(ctx, &memoizationcachepkg.GetMemoizationCacheEntryRequest{Namespace: "argo", Name: "my-cache", Key: "missing", CacheType: "SQL"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("DeleteMemoizationCacheEntry", func(t *testing.T) {
+		_, err := s.DeleteMemoizationCacheEntry(ctx, &memoizationcachepkg.DeleteMemoizationCacheEntryRequest{Namespace: "argo", Name: "my-cache", Key: "old", CacheType: "SQL"})
+		require.NoError(t, err)
+	})
+	t.Run("PruneMemoizationCache", func(t *testing.T) {
+		resp, err := s.PruneMemoizationCache(ctx, &memoizationcachepkg.PruneMemoizationCacheRequest{Namespace: "argo", Name: "my-cache", CacheType: "SQL", OlderThan: "24h"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"old"}, resp.Keys)
+		_, err = s.PruneMemoizationCache(ctx, &memoizationcachepkg.PruneMemoizationCacheRequest{Namespace: "argo", Name: "my-cache", CacheType: "SQL"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("NotConfigured", func(t *testing.T) {
+		_, err := s.ListMemoizationCacheEntries(ctx, &memoizationcachepkg.ListMemoizationCacheEntriesRequest{Namespace: "argo", Name: "my-cache", CacheType: "Artifact"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		_, err = s.ListMemoizationCacheEntries(ctx, &memoizationcachepkg.ListMemoizationCacheEntriesRequest{Namespace: "argo", Name: "my-cache", CacheType: "Unknown"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("OtherNamespace", func(t *testing.T) {
+		// caches are held in the controller's namespace, whatever the namespace of the request
+		var reviewNamespace string
+		kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+			review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+			reviewNamespace = review.Spec.ResourceAttributes.Namespace
+			return false, nil, nil
+		})
+		list, err := s.ListMemoizationCacheEntries(ctx, &memoizationcachepkg.ListMemoizationCacheEntriesRequest{Namespace: "my-ns", Name: "my-cache", CacheType: "SQL"})
+		require.NoError(t, err)
+		assert.Len(t, list.Items, 2)
+		assert.Equal(t, "argo", reviewNamespace)
+	})
+	t.Run("ConfigMap", func(t *testing.T) {
+		kubeClient := kubefake.NewSimpleClientset(&apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-cache", Namespace: "argo", Labels: map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapCache}},
+			Data:       map[string]string{"my-key": `{"nodeID":"node-1","outputs":{}}`},
+		})
+		kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+			return true, &authorizationv1.SelfSubjectAccessReview{Status: authorizationv1.SubjectAccessReviewStatus{Allowed: true}}, nil
+		})
+		s := NewMemoizationCacheServer(nil, nil, kubeClient, "argo")
+		ctx := context.WithValue(context.TODO(), auth.KubeKey, kubeClient)
+		entry, err := s.GetMemoizationCacheEntry(ctx, &memoizationcachepkg.GetMemoizationCacheEntryRequest{Namespace: "my-ns", Name: "my-cache", Key: "my-key"})
+		require.NoError(t, err)
+		assert.Equal(t, "node-1", entry.NodeID)
+	})
+	t.Run("PermissionDenied", func(t *testing.T) {
+		allowed = false
+		defer func() { allowed = true }()
+		_, err := s.DeleteMemoizationCacheEntry(ctx, &memoizationcachepkg.DeleteMemoizationCacheEntryRequest{Namespace: "argo", Name: "my-cache", Key: "old", CacheType: "SQL"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -3,7 +3,8 @@ package workflow
 import (
 	"context"
 	"fmt"
-
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/go-jose/go-jose/v3/jwt"
@@ -33,6 +34,8 @@ import (
 	"github.com/argoproj/argo-workflows/v3/server/workflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/util"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
@@ -644,7 +647,7 @@ func getWorkflowServer() (workflowpkg.WorkflowServiceServer, context.Context) {
 	namespaceAll := metav1.NamespaceAll
 	wftmplStore := workflowtemplate.NewWorkflowTemplateClientStore()
 	cwftmplStore := clusterworkflowtemplate.NewClusterWorkflowTemplateClientStore()
-	server := NewWorkflowServer(instanceIdSvc, offloadNodeStatusRepo, archivedRepo, wfClientset, wfStore, wfStore, wftmplStore, cwftmplStore, &namespaceAll)
+	server := NewWorkflowServer(instanceIdSvc, offloadNodeStatusRepo, archivedRepo, wfClientset, kubeClientSet, wfStore, wfStore, wftmplStore, cwftmplStore, &namespaceAll)
 	return server, ctx
 }
 
@@ -805,6 +808,50 @@ func TestRetryWorkflow(t *testing.T) {
 	})
 }
 
+// compactedNodesDriver serves the statuses of the nodes collapsed into a Compacted node
+type compactedNodesDriver struct {
+	artifactscommon.ArtifactDriver
+	nodes string
+}
+
+func (d compactedNodesDriver) OpenStream(*v1alpha1.Artifact) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(d.nodes)), nil
+}
+
+func TestRetryWorkflowCompacted(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	wf, err := auth.GetWfClient(ctx).ArgoprojV1alpha1().Workflows("workflows").Get(ctx, "failed", metav1.GetOptions{})
+	require.NoError(t, err)
+	wf.Name = "compacted"
+	wf.ResourceVersion = ""
+	wf.Status.Nodes = v1alpha1.Nodes{
+		"compacted": {ID: "compacted", Name: "compacted", Type: v1alpha1.NodeTypeSteps, Phase: v1alpha1.NodeFailed, Children: []string{"compacted-items"}},
+		"compacted-items": {ID: "compacted-items", Name: "compacted.items", Type: v1alpha1.NodeTypeCompacted, Phase: v1alpha1.NodeSucceeded, BoundaryID: "compacted", Compacted: &v1alpha1.CompactedNodes{
+			Count:    1,
+			Artifact: v1alpha1.Artifact{Name: "compacted", ArtifactLocation: v1alpha1.ArtifactLocation{S3: &v1alpha1.S3Artifact{Key: "compacted/compacted-items.json"}}},
+		}},
+	}
+	_, err = auth.GetWfClient(ctx).ArgoprojV1alpha1().Workflows("workflows").Create(ctx, wf, metav1.CreateOptions{})
+	require.NoError(t, err)
+	// the user cannot get the secrets of the artifact repository, but the server can
+	ws := server.(*workflowServer)
+	ws.kubeClient = fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-s3-credentials", Namespace: "workflows"},
+		Data:       map[string][]byte{"accessKey": []byte("my-access-key")},
+	})
+	ws.newDriver = func(ctx context.Context, _ *v1alpha1.Artifact, ri resource.Interface) (artifactscommon.ArtifactDriver, error) {
+		if _, err := ri.GetSecret(ctx, "my-s3-credentials", "accessKey"); err != nil {
+			return nil, err
+		}
+		return compactedNodesDriver{nodes: `[{"id":"compacted-0","name":"compacted.items(0)","type":"Pod","phase":"Succeeded","boundaryID":"compacted"}]`}, nil
+	}
+
+	retried, err := server.RetryWorkflow(ctx, &workflowpkg.WorkflowRetryRequest{Name: "compacted", Namespace: "workflows"})
+	require.NoError(t, err)
+	assert.Contains(t, retried.Status.Nodes, "compacted-0")
+	assert.NotContains(t, retried.Status.Nodes, "compacted-items")
+}
+
 func TestSuspendResumeWorkflow(t *testing.T) {
 	server, ctx := getWorkflowServer()
 	wf, err := server.SuspendWorkflow(ctx, &workflowpkg.WorkflowSuspendRequest{Name: "hello-world-9tql2-run", Namespace: "workflows"})
@@ -14,12 +14,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 
@@ -32,11 +34,15 @@ type archivedWorkflowServer struct {
 	wfArchive             sqldb.WorkflowArchive
 	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
 	hydrator              hydrator.Interface
+	// kubeClient has the server's credentials, which load the nodes of compacted workflows, so that users do not need
+	// access to the secrets of the artifact repository to retry or resubmit workflows
+	kubeClient kubernetes.Interface
+	newDriver  util.NewArtifactDriverFunc
 }
 
 // NewWorkflowArchiveServer returns a new archivedWorkflowServer
-func NewWorkflowArchiveServer(wfArchive sqldb.WorkflowArchive, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo) workflowarchivepkg.ArchivedWorkflowServiceServer {
-	return &archivedWorkflowServer{wfArchive, offloadNodeStatusRepo, hydrator.New(offloadNodeStatusRepo)}
+func NewWorkflowArchiveServer(wfArchive sqldb.WorkflowArchive, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, kubeClient kubernetes.Interface) workflowarchivepkg.ArchivedWorkflowServiceServer {
+	return &archivedWorkflowServer{wfArchive, offloadNodeStatusRepo, hydrator.New(offloadNodeStatusRepo), kubeClient, artifact.NewDriver}
 }
 
 func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req *workflowarchivepkg.ListArchivedWorkflowsRequest) (*wfv1.WorkflowList, error) {
@@ -192,6 +198,42 @@ func (w *archivedWorkflowServer) ListArchivedWorkflowLabelValues(ctx context.Con
 	return labels, nil
 }
 
+func (w *archivedWorkflowServer) ListArtifactLineage(ctx context.Context, req *workflowarchivepkg.ListArtifactLineageRequest) (*workflowarchivepkg.ArtifactLineage, error) {
+	if req.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "key is required")
+	}
+	// verify if we have permission to list Workflows
+	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, req.Namespace, "")
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to list workflows in namespace \"%s\". Maybe you want to specify a namespace with query parameter `.namespace=%s`?", req.Namespace, req.Namespace))
+	}
+	records, err := w.wfArchive.ListArtifactLineage(req.Namespace, req.Key)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	lineage := &workflowarchivepkg.ArtifactLineage{Key: req.Key}
+	for _, record := range records {
+		node := &workflowarchivepkg.ArtifactLineageNode{
+			WorkflowUID:  record.UID,
+			WorkflowName: record.Name,
+			Namespace:    record.Namespace,
+			NodeID:       record.NodeID,
+			ArtifactName: record.ArtifactName,
+			Digest:       record.Digest,
+			FinishedAt:   &metav1.Time{Time: record.FinishedAt},
+		}
+		if record.Direction == sqldb.ArtifactDirectionOutput {
+			lineage.Producers = append(lineage.Producers, node)
+		} else {
+			lineage.Consumers = append(lineage.Consumers, node)
+		}
+	}
+	return lineage, nil
+}
+
 func (w *archivedWorkflowServer) ResubmitArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.ResubmitArchivedWorkflowRequest) (*wfv1.Workflow, error) {
 	wfClient := auth.GetWfClient(ctx)
 
@@ -200,6 +242,13 @@ func (w *archivedWorkflowServer) ResubmitArchivedWorkflow(ctx context.Context, r
 		return nil, sutils.ToStatusError(err, codes.Internal)
 	}
 
+	if req.Memoized {
+		err = util.RehydrateCompactedNodes(ctx, wf, w.kubeClient, w.newDriver)
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
+	}
+
 	newWF, err := util.FormulateResubmitWorkflow(ctx, wf, req.Memoized, req.Parameters)
 	if err != nil {
 		return nil, sutils.ToStatusError(err, codes.Internal)
@@ -224,8 +273,12 @@ func (w *archivedWorkflowServer) RetryArchivedWorkflow(ctx context.Context, req
 
 	_, err = wfClient.ArgoprojV1alpha1().Workflows(req.Namespace).Get(ctx, wf.Name, metav1.GetOptions{})
 	if apierr.IsNotFound(err) {
+		err = util.RehydrateCompactedNodes(ctx, wf, w.kubeClient, w.newDriver)
+		if err != nil {
+			return nil, sutils.ToStatusError(err, codes.Internal)
+		}
 
-		wf, podsToDelete, err := util.FormulateRetryWorkflow(ctx, wf, req.RestartSuccessful, req.NodeFieldSelector, req.Parameters)
+		wf, podsToDelete, err := util.FormulateRetryWorkflow(ctx, wf, req.RestartSuccessful, req.NodeFieldSelector, req.Parameters, req.NodeParameters, req.NodeArtifacts)
 		if err != nil {
 			return nil, sutils.ToStatusError(err, codes.Internal)
 		}
@@ -35,7 +35,7 @@ func Test_archivedWorkflowServer(t *testing.T) {
 	offloadNodeStatusRepo := &mocks.OffloadNodeStatusRepo{}
 	offloadNodeStatusRepo.On("IsEnabled", mock.Anything).Return(true)
 	offloadNodeStatusRepo.On("List", mock.Anything).Return(map[sqldb.UUIDVersion]v1alpha1.Nodes{}, nil)
-	w := NewWorkflowArchiveServer(repo, offloadNodeStatusRepo)
+	w := NewWorkflowArchiveServer(repo, offloadNodeStatusRepo, kubeClient)
 	allowed := true
 	kubeClient.AddReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
 		return true, &authorizationv1.SelfSubjectAccessReview{
@@ -116,6 +116,10 @@ func Test_archivedWorkflowServer(t *testing.T) {
 	repo.On("ListWorkflowsLabelValues", "my-key").Return(&wfv1.LabelValues{
 		Items: []string{"my-key=foo", "my-key=bar"},
 	}, nil)
+	repo.On("ListArtifactLineage", "", "my-key").Return([]sqldb.ArtifactLineageRecord{
+		{UID: "producer-uid", Name: "producer", NodeID: "producer-node", Direction: sqldb.ArtifactDirectionOutput, ArtifactName: "data", Key: "my-key", Digest: "sha256:abc"},
+		{UID: "consumer-uid", Name: "consumer", NodeID: "consumer-node", Direction: sqldb.ArtifactDirectionInput, ArtifactName: "data", Key: "my-key", Digest: "sha256:abc"},
+	}, nil)
 	repo.On("RetryWorkflow", "failed-uid").Return(&wfv1.Workflow{
 		ObjectMeta: metav1.ObjectMeta{Name: "failed-wf"},
 	}, nil)
@@ -238,6 +242,21 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		require.NoError(t, err)
 		assert.Empty(t, resp.Items)
 	})
+	t.Run("ListArtifactLineage", func(t *testing.T) {
+		_, err := w.ListArtifactLineage(ctx, &workflowarchivepkg.ListArtifactLineageRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		resp, err := w.ListArtifactLineage(ctx, &workflowarchivepkg.ListArtifactLineageRequest{Key: "my-key"})
+		require.NoError(t, err)
+		assert.Equal(t, "my-key", resp.Key)
+		if assert.Len(t, resp.Producers, 1) {
+			assert.Equal(t, "producer", resp.Producers[0].WorkflowName)
+			assert.Equal(t, "producer-node", resp.Producers[0].NodeID)
+			assert.Equal(t, "sha256:abc", resp.Producers[0].Digest)
+		}
+		if assert.Len(t, resp.Consumers, 1) {
+			assert.Equal(t, "consumer", resp.Consumers[0].WorkflowName)
+		}
+	})
 	t.Run("RetryArchivedWorkflow", func(t *testing.T) {
 		_, err := w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "failed-uid"})
 		assert.Equal(t, err, status.Error(codes.AlreadyExists, "Workflow already exists on cluster, use argo retry {name} instead"))
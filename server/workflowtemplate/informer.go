@@ -25,9 +25,11 @@ var _ types.WorkflowTemplateStore = &Informer{}
 type Informer struct {
 	managedNamespace string
 	informer         wfextvv1alpha1.WorkflowTemplateInformer
+	revisions        templateresolution.TemplateRevisions
 }
 
-func NewInformer(restConfig *rest.Config, managedNamespace string) (*Informer, error) {
+// NewInformer returns a new Informer. revisions may be nil if persistence is not configured.
+func NewInformer(restConfig *rest.Config, managedNamespace string, revisions templateresolution.TemplateRevisions) (*Informer, error) {
 	dynamicInterface, err := dynamic.NewForConfig(restConfig)
 	if err != nil {
 		return nil, err
@@ -39,6 +41,7 @@ func NewInformer(restConfig *rest.Config, managedNamespace string) (*Informer, e
 	return &Informer{
 		informer:         informer,
 		managedNamespace: managedNamespace,
+		revisions:        revisions,
 	}, nil
 }
 
@@ -62,5 +65,5 @@ func (wti *Informer) Getter(_ context.Context, namespace string) templateresolut
 	if namespace == "" {
 		namespace = wti.managedNamespace
 	}
-	return wti.informer.Lister().WorkflowTemplates(namespace)
+	return templateresolution.WithWorkflowTemplateRevisions(wti.informer.Lister().WorkflowTemplates(namespace), wti.revisions, namespace)
 }
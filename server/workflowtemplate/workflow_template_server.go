@@ -8,9 +8,12 @@ import (
 	"strings"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	"github.com/argoproj/argo-workflows/v3/server/clusterworkflowtemplate"
@@ -25,16 +28,20 @@ type WorkflowTemplateServer struct {
 	instanceIDService instanceid.Service
 	wftmplStore       servertypes.WorkflowTemplateStore
 	cwftmplStore      servertypes.ClusterWorkflowTemplateStore
+	revisions         sqldb.TemplateRevisionRepo
 }
 
-func NewWorkflowTemplateServer(instanceIDService instanceid.Service, wftmplStore servertypes.WorkflowTemplateStore, cwftmplStore servertypes.ClusterWorkflowTemplateStore) workflowtemplatepkg.WorkflowTemplateServiceServer {
+// NewWorkflowTemplateServer returns a new WorkflowTemplateServer. Revisions of templates are recorded by the
+// controller. revisions may be nil if persistence is not configured, in which case they cannot be listed or rolled
+// back to.
+func NewWorkflowTemplateServer(instanceIDService instanceid.Service, wftmplStore servertypes.WorkflowTemplateStore, cwftmplStore servertypes.ClusterWorkflowTemplateStore, revisions sqldb.TemplateRevisionRepo) workflowtemplatepkg.WorkflowTemplateServiceServer {
 	if wftmplStore == nil {
 		wftmplStore = NewWorkflowTemplateClientStore()
 	}
 	if cwftmplStore == nil {
 		cwftmplStore = clusterworkflowtemplate.NewClusterWorkflowTemplateClientStore()
 	}
-	return &WorkflowTemplateServer{instanceIDService, wftmplStore, cwftmplStore}
+	return &WorkflowTemplateServer{instanceIDService, wftmplStore, cwftmplStore, revisions}
 }
 
 func (wts *WorkflowTemplateServer) CreateWorkflowTemplate(ctx context.Context, req *workflowtemplatepkg.WorkflowTemplateCreateRequest) (*v1alpha1.WorkflowTemplate, error) {
@@ -208,3 +215,63 @@ func (wts *WorkflowTemplateServer) UpdateWorkflowTemplate(ctx context.Context, r
 	}
 	return res, nil
 }
+
+func (wts *WorkflowTemplateServer) ListWorkflowTemplateRevisions(ctx context.Context, req *workflowtemplatepkg.WorkflowTemplateRevisionsRequest) (*workflowtemplatepkg.WorkflowTemplateRevisionList, error) {
+	if wts.revisions == nil {
+		return nil, status.Error(codes.FailedPrecondition, "template revisions require persistence to be configured")
+	}
+	allowed, err := auth.CanI(ctx, "get", workflow.WorkflowTemplatePlural, req.Namespace, req.Name)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to get workflow template \"%s\" in namespace \"%s\"", req.Name, req.Namespace))
+	}
+	records, err := wts.revisions.ListRevisions(workflow.WorkflowTemplateKind, req.Namespace, req.Name)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	items := make([]*workflowtemplatepkg.WorkflowTemplateRevision, len(records))
+	for i, record := range records {
+		items[i] = &workflowtemplatepkg.WorkflowTemplateRevision{
+			Revision:          record.Revision,
+			CreationTimestamp: &v1.Time{Time: record.CreationTime},
+		}
+	}
+	return &workflowtemplatepkg.WorkflowTemplateRevisionList{Items: items}, nil
+}
+
+func (wts *WorkflowTemplateServer) RollbackWorkflowTemplate(ctx context.Context, req *workflowtemplatepkg.WorkflowTemplateRollbackRequest) (*v1alpha1.WorkflowTemplate, error) {
+	if wts.revisions == nil {
+		return nil, status.Error(codes.FailedPrecondition, "template revisions require persistence to be configured")
+	}
+	if req.Revision <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "revision must be greater than zero")
+	}
+	wfClient := auth.GetWfClient(ctx)
+	wfTmpl, err := wfClient.ArgoprojV1alpha1().WorkflowTemplates(req.Namespace).Get(ctx, req.Name, v1.GetOptions{})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	err = wts.instanceIDService.Validate(wfTmpl)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+	}
+	revision, err := wts.revisions.GetWorkflowTemplate(req.Namespace, req.Name, req.Revision)
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	// a rollback is recorded as a new revision with the spec of the old one
+	wfTmpl.Spec = revision.Spec
+	wftmplGetter := wts.wftmplStore.Getter(ctx, req.Namespace)
+	cwftmplGetter := wts.cwftmplStore.Getter(ctx)
+	err = validate.ValidateWorkflowTemplate(wftmplGetter, cwftmplGetter, wfTmpl, validate.ValidateOpts{})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.InvalidArgument)
+	}
+	res, err := wfClient.ArgoprojV1alpha1().WorkflowTemplates(req.Namespace).Update(ctx, wfTmpl, v1.UpdateOptions{})
+	if err != nil {
+		return nil, sutils.ToStatusError(err, codes.Internal)
+	}
+	return res, nil
+}
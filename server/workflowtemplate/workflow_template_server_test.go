@@ -3,13 +3,22 @@ package workflowtemplate
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
 	workflowtemplatepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	wftFake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
@@ -172,7 +181,7 @@ func getWorkflowTemplateServer() (workflowtemplatepkg.WorkflowTemplateServiceSer
 	ctx := context.WithValue(context.WithValue(context.WithValue(context.TODO(), auth.WfKey, wfClientset), auth.KubeKey, kubeClientSet), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
 	wftmplStore := NewWorkflowTemplateClientStore()
 	cwftmplStore := clusterworkflowtemplate.NewClusterWorkflowTemplateClientStore()
-	return NewWorkflowTemplateServer(instanceid.NewService("my-instanceid"), wftmplStore, cwftmplStore), ctx
+	return NewWorkflowTemplateServer(instanceid.NewService("my-instanceid"), wftmplStore, cwftmplStore, nil), ctx
 }
 
 func TestWorkflowTemplateServer_CreateWorkflowTemplate(t *testing.T) {
@@ -277,3 +286,52 @@ func TestWorkflowTemplateServer_UpdateWorkflowTemplate(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestWorkflowTemplateServer_Revisions(t *testing.T) {
+	var wftObj1 v1alpha1.WorkflowTemplate
+	v1alpha1.MustUnmarshal(wftStr2, &wftObj1)
+	kubeClientSet := fake.NewSimpleClientset()
+	kubeClientSet.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: true},
+		}, nil
+	})
+	wfClientset := wftFake.NewSimpleClientset(wftObj1.DeepCopy())
+	ctx := context.WithValue(context.WithValue(context.WithValue(context.TODO(), auth.WfKey, wfClientset), auth.KubeKey, kubeClientSet), auth.ClaimsKey, &types.Claims{Claims: jwt.Claims{Subject: "my-sub"}})
+	revisions := &mocks.TemplateRevisionRepo{}
+	server := NewWorkflowTemplateServer(instanceid.NewService("my-instanceid"), NewWorkflowTemplateClientStore(), clusterworkflowtemplate.NewClusterWorkflowTemplateClientStore(), revisions)
+
+	t.Run("UpdateWorkflowTemplate", func(t *testing.T) {
+		updated := wftObj1.DeepCopy()
+		updated.Spec.Templates[0].Container.Image = "alpine:latest"
+		_, err := server.UpdateWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateUpdateRequest{Namespace: "default", Template: updated})
+		require.NoError(t, err)
+		// revisions are recorded by the controller
+		revisions.AssertNotCalled(t, "SaveWorkflowTemplate", mock.Anything)
+	})
+	t.Run("ListWorkflowTemplateRevisions", func(t *testing.T) {
+		now := time.Now()
+		revisions.On("ListRevisions", "WorkflowTemplate", "default", wftObj1.Name).Return([]sqldb.TemplateRevisionRecord{
+			{Revision: 2, CreationTime: now},
+			{Revision: 1, CreationTime: now.Add(-time.Hour)},
+		}, nil)
+		list, err := server.ListWorkflowTemplateRevisions(ctx, &workflowtemplatepkg.WorkflowTemplateRevisionsRequest{Namespace: "default", Name: wftObj1.Name})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 2)
+		assert.Equal(t, int64(2), list.Items[0].Revision)
+		assert.Equal(t, int64(1), list.Items[1].Revision)
+	})
+	t.Run("RollbackWorkflowTemplate", func(t *testing.T) {
+		revisions.On("GetWorkflowTemplate", "default", wftObj1.Name, int64(1)).Return(wftObj1.DeepCopy(), nil)
+		wftRsp, err := server.RollbackWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateRollbackRequest{Namespace: "default", Name: wftObj1.Name, Revision: 1})
+		require.NoError(t, err)
+		assert.Equal(t, "docker/whalesay", wftRsp.Spec.Templates[0].Container.Image)
+		_, err = server.RollbackWorkflowTemplate(ctx, &workflowtemplatepkg.WorkflowTemplateRollbackRequest{Namespace: "default", Name: wftObj1.Name})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("NotConfigured", func(t *testing.T) {
+		server, ctx := getWorkflowTemplateServer()
+		_, err := server.ListWorkflowTemplateRevisions(ctx, &workflowtemplatepkg.WorkflowTemplateRevisionsRequest{Namespace: "default", Name: wftObj1.Name})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
@@ -34,6 +34,10 @@ export function artifactURN<A extends Artifact>(a: A, ar: ArtifactRepository) {
         return 'artifact:raw:' + a.raw.data;
     } else if (a.azure) {
         return 'artifact:azure:' + (a.azure.endpoint || ar?.azure?.endpoint) + ':' + (a.azure.container || ar?.azure?.container) + ':' + a.azure.blob;
+    } else if (a.oci) {
+        return 'artifact:oci:' + (a.oci.registry || ar?.oci?.registry) + ':' + (a.oci.repository || ar?.oci?.repository) + ':' + a.oci.key;
+    } else if (a.sftp) {
+        return 'artifact:sftp:' + a.sftp.host + ':' + a.sftp.path;
     }
     return 'artifact:unknown';
 }
@@ -53,6 +57,8 @@ export function artifactRepoHasLocation(ar: ArtifactRepository) {
         return true;
     } else if (ar.azure) {
         return ar.azure.container !== '' && ar.azure.blob !== '';
+    } else if (ar.oci) {
+        return ar.oci.registry !== '' && ar.oci.repository !== '';
     }
 }
 
@@ -71,6 +77,10 @@ export function artifactKey<A extends Artifact>(a: A) {
         return 'raw';
     } else if (a.azure) {
         return a.azure.blob;
+    } else if (a.oci) {
+        return a.oci.key;
+    } else if (a.sftp) {
+        return a.sftp.path;
     }
     return 'unknown';
 }
@@ -46,6 +46,12 @@ export interface OSSArtifactRepository {
     key: string;
 }
 
+export interface OCIArtifactRepository {
+    registry: string;
+    repository: string;
+    keyFormat?: string;
+}
+
 export interface GitArtifactRepository {
     repo?: string;
     endpoint?: string;
@@ -68,6 +74,7 @@ export interface ArtifactRepository {
     git?: GitArtifactRepository;
     http?: HTTPArtifactRepository;
     raw?: RawArtifactRepository;
+    oci?: OCIArtifactRepository;
 }
 export interface ArtifactRepositoryRefStatus {
     artifactRepository: ArtifactRepository;
@@ -79,6 +86,17 @@ export interface AzureArtifact {
     blob?: string;
 }
 
+export interface OCIArtifact {
+    registry?: string;
+    repository?: string;
+    key: string;
+}
+
+export interface SFTPArtifact {
+    host: string;
+    path: string;
+}
+
 export interface GCSArtifact {
     endpoint?: string;
     bucket?: string;
@@ -138,6 +156,8 @@ export interface Artifact {
     raw?: RawArtifact;
     s3?: S3Artifact;
     azure?: AzureArtifact;
+    oci?: OCIArtifact;
+    sftp?: SFTPArtifact;
     archive?: {
         none?: any;
     };
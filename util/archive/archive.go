@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/klauspost/compress/zstd"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-workflows/v3/errors"
@@ -20,6 +21,22 @@ type flusher interface {
 
 // TarGzToWriter tar.gz's the source path to the supplied writer
 func TarGzToWriter(sourcePath string, level int, w io.Writer) error {
+	return tarToWriter(sourcePath, w, func(w io.Writer) (io.WriteCloser, error) {
+		return gzip.NewWriterLevel(w, level)
+	})
+}
+
+// TarZstdToWriter tar.zst's the source path to the supplied writer
+func TarZstdToWriter(sourcePath string, level int, w io.Writer) error {
+	if level < 1 || level > 22 {
+		return errors.InternalErrorf("invalid zstd compression level: %d", level)
+	}
+	return tarToWriter(sourcePath, w, func(w io.Writer) (io.WriteCloser, error) {
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	})
+}
+
+func tarToWriter(sourcePath string, w io.Writer, compressor func(io.Writer) (io.WriteCloser, error)) error {
 	sourcePath, err := filepath.Abs(sourcePath)
 	if err != nil {
 		return errors.InternalErrorf("getting absolute path: %v", err)
@@ -38,12 +55,12 @@ func TarGzToWriter(sourcePath string, level int, w io.Writer) error {
 	if flush, ok := w.(flusher); ok {
 		defer func() { _ = flush.Flush() }()
 	}
-	gzw, err := gzip.NewWriterLevel(w, level)
+	cw, err := compressor(w)
 	if err != nil {
 		return errors.InternalWrapError(err)
 	}
-	defer util.Close(gzw)
-	tw := tar.NewWriter(gzw)
+	defer util.Close(cw)
+	tw := tar.NewWriter(cw)
 	defer util.Close(tw)
 
 	if sourceFi.IsDir() {
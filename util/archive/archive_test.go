@@ -1,15 +1,19 @@
 package archive
 
 import (
+	"archive/tar"
 	"archive/zip"
 	"bufio"
+	"bytes"
 	"compress/gzip"
 	"crypto/rand"
 	"encoding/hex"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/klauspost/compress/zstd"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 )
@@ -141,6 +145,58 @@ func TestTarFile(t *testing.T) {
 	}
 }
 
+func TestTarZstd(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		level   int
+		wantErr bool
+	}{
+		{
+			"dir_missing",
+			"./fake/dir",
+			3,
+			true,
+		},
+		{
+			"dir",
+			"../../test/e2e",
+			3,
+			false,
+		},
+		{
+			"file",
+			"archive.go",
+			1,
+			false,
+		},
+		{
+			"level_out_of_range",
+			"../../test/e2e",
+			23,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := TarZstdToWriter(tt.src, tt.level, &buf)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			zr, err := zstd.NewReader(&buf)
+			require.NoError(t, err)
+			defer zr.Close()
+			header, err := tar.NewReader(zr).Next()
+			require.NoError(t, err)
+			require.Equal(t, filepath.Base(tt.src), strings.Split(header.Name, "/")[0])
+		})
+	}
+}
+
 func TestZipDirectory(t *testing.T) {
 	tests := []struct {
 		name    string
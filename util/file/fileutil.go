@@ -2,6 +2,7 @@ package file
 
 import (
 	"archive/tar"
+	"bufio"
 	"bytes"
 	"compress/gzip"
 	"encoding/base64"
@@ -11,6 +12,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/klauspost/pgzip"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/utils/env"
@@ -51,6 +53,23 @@ func GetGzipReader(reader io.Reader) (io.ReadCloser, error) {
 	return gzipReader, nil
 }
 
+var zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+// GetDecompressedReader gets a reader of the decompressed content of a gzip or zstd stream, detecting the
+// compression from the stream's magic number.
+func GetDecompressedReader(reader io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(reader)
+	magic, err := br.Peek(len(zstdMagic))
+	if err == nil && bytes.Equal(magic, zstdMagic) {
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return zr.IOReadCloser(), nil
+	}
+	return GetGzipReader(br)
+}
+
 // ExistsInTar return true if file or directory exists in tar
 func ExistsInTar(sourcePath string, tarReader TarReader) bool {
 	sourcePath = strings.Trim(sourcePath, "/")
@@ -8,6 +8,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -48,6 +49,28 @@ func TestGetGzipReader(t *testing.T) {
 	}
 }
 
+// TestGetDecompressedReader checks that gzip and zstd content are both detected and decompressed.
+func TestGetDecompressedReader(t *testing.T) {
+	rawContent := "this is the content"
+	encoder, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	for name, compressed := range map[string][]byte{
+		"gzip": file.CompressContent([]byte(rawContent)),
+		"zstd": encoder.EncodeAll([]byte(rawContent), nil),
+	} {
+		t.Run(name, func(t *testing.T) {
+			reader, err := file.GetDecompressedReader(bytes.NewReader(compressed))
+			require.NoError(t, err)
+			defer reader.Close()
+			res, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			assert.Equal(t, rawContent, string(res))
+		})
+	}
+	_, err = file.GetDecompressedReader(bytes.NewReader([]byte(rawContent)))
+	require.Error(t, err)
+}
+
 func TestExistsInTar(t *testing.T) {
 	type fakeFile struct {
 		name, body string
@@ -10,8 +10,8 @@ func UpdateResourceDurations(wf *wfv1.Workflow) {
 	wf.Status.ResourcesDuration = wfv1.ResourcesDuration{}
 	for nodeID, node := range wf.Status.Nodes {
 		// pods are already calculated and so we do not need to compute them,
-		// AND they are the only node that should contribute to the total
-		if node.Type == wfv1.NodeTypePod {
+		// AND they are the only node that should contribute to the total, along with the compacted nodes summing them
+		if node.Type == wfv1.NodeTypePod || node.Type == wfv1.NodeTypeCompacted {
 			wf.Status.ResourcesDuration = wf.Status.ResourcesDuration.Add(node.ResourcesDuration)
 		} else if node.Fulfilled() {
 			// compute the sum of all children
@@ -34,7 +34,7 @@ func resourceDuration(wf *wfv1.Workflow, node wfv1.NodeStatus, visited map[strin
 			log.Warnf("was unable to obtain node for %s", childID)
 			continue
 		}
-		if child.Type == wfv1.NodeTypePod {
+		if child.Type == wfv1.NodeTypePod || child.Type == wfv1.NodeTypeCompacted {
 			v = v.Add(child.ResourcesDuration)
 		}
 		v = v.Add(resourceDuration(wf, *child, visited))
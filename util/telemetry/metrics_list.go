@@ -1,6 +1,18 @@
 // Code generated by util/telemetry/builder. DO NOT EDIT.
 package telemetry
 
+var InstrumentArtifactRetentionReclaimedBytes = BuiltinInstrument{
+	name:        "artifact_retention_reclaimed_bytes",
+	description: "A counter of the bytes of output artifacts deleted by the retention policies of artifact repositories",
+	unit:        "By",
+	instType:    Int64Counter,
+	attributes: []BuiltinAttribute{
+		{
+			name: AttribWorkflowNamespace,
+		},
+	},
+}
+
 var InstrumentCronworkflowsConcurrencypolicyTriggered = BuiltinInstrument{
 	name:        "cronworkflows_concurrencypolicy_triggered",
 	description: "A counter of the number of times a CronWorkflow has triggered its `concurrencyPolicy` to limit the number of workflows running",
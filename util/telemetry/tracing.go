@@ -0,0 +1,136 @@
+package telemetry
+
+import (
+	"context"
+	crand "crypto/rand"
+	"fmt"
+	"os"
+	"sync/atomic"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// EnvVarTraceParent holds the W3C trace context of the span a process's spans are children of, following the
+// OpenTelemetry convention for propagating context through the environment
+const EnvVarTraceParent = "TRACEPARENT"
+
+// Tracing emits OpenTelemetry spans. Spans are exported via OTLP if an endpoint is configured with the standard
+// OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT environment variables, otherwise they are dropped.
+type Tracing struct {
+	provider *tracesdk.TracerProvider
+	tracer   trace.Tracer
+}
+
+// NewTracing returns a new Tracing, and installs it as the global tracer provider
+func NewTracing(ctx context.Context, serviceName string, extraOpts ...tracesdk.TracerProviderOption) (*Tracing, error) {
+	_, otlpEnabled := os.LookupEnv(`OTEL_EXPORTER_OTLP_ENDPOINT`)
+	_, otlpTracesEnabled := os.LookupEnv(`OTEL_EXPORTER_OTLP_TRACES_ENDPOINT`)
+	if !otlpEnabled && !otlpTracesEnabled && len(extraOpts) == 0 {
+		return &Tracing{tracer: noop.NewTracerProvider().Tracer(serviceName)}, nil
+	}
+	options := []tracesdk.TracerProviderOption{
+		tracesdk.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+		)),
+		tracesdk.WithIDGenerator(idGenerator{}),
+	}
+	if otlpEnabled || otlpTracesEnabled {
+		log.Info("Starting OTLP trace exporter")
+		exporter, err := otlptracegrpc.New(ctx)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, tracesdk.WithBatcher(exporter))
+	}
+	options = append(options, extraOpts...)
+	provider := tracesdk.NewTracerProvider(options...)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return &Tracing{provider: provider, tracer: provider.Tracer(serviceName)}, nil
+}
+
+// Enabled returns whether spans are recorded
+func (t *Tracing) Enabled() bool {
+	return t != nil && t.provider != nil
+}
+
+// Tracer returns the tracer to start spans with, which drops them if t is nil
+func (t *Tracing) Tracer() trace.Tracer {
+	if t == nil {
+		return noop.NewTracerProvider().Tracer("")
+	}
+	return t.tracer
+}
+
+// Shutdown exports any spans that have not yet been exported
+func (t *Tracing) Shutdown(ctx context.Context) {
+	if !t.Enabled() {
+		return
+	}
+	if err := t.provider.Shutdown(ctx); err != nil {
+		log.WithError(err).Warn("Failed to shut down tracing")
+	}
+}
+
+type spanIDsKey struct{}
+
+type spanIDs struct {
+	traceID trace.TraceID
+	spanID  trace.SpanID
+	used    atomic.Bool
+}
+
+// ContextWithSpanIDs returns a context in which the next span started gets the given IDs, rather than random ones.
+// This allows spans for the same thing to be emitted by different processes, or at different times, and still be
+// part of the same trace. Any further spans started in the context, or in the span's context, get random IDs.
+func ContextWithSpanIDs(ctx context.Context, traceID trace.TraceID, spanID trace.SpanID) context.Context {
+	return context.WithValue(ctx, spanIDsKey{}, &spanIDs{traceID: traceID, spanID: spanID})
+}
+
+// idGenerator generates IDs from the context if set there, otherwise randomly
+type idGenerator struct{}
+
+func (idGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	if ids, ok := ctx.Value(spanIDsKey{}).(*spanIDs); ok && ids.used.CompareAndSwap(false, true) {
+		return ids.traceID, ids.spanID
+	}
+	traceID := trace.TraceID{}
+	_, _ = crand.Read(traceID[:])
+	return traceID, newSpanID()
+}
+
+func (idGenerator) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
+	if ids, ok := ctx.Value(spanIDsKey{}).(*spanIDs); ok && ids.traceID == traceID && ids.used.CompareAndSwap(false, true) {
+		return ids.spanID
+	}
+	return newSpanID()
+}
+
+func newSpanID() trace.SpanID {
+	spanID := trace.SpanID{}
+	_, _ = crand.Read(spanID[:])
+	return spanID
+}
+
+// TraceParent returns the span context in the W3C traceparent format
+func TraceParent(spanContext trace.SpanContext) string {
+	return fmt.Sprintf("00-%s-%s-%s", spanContext.TraceID(), spanContext.SpanID(), spanContext.TraceFlags())
+}
+
+// ContextFromEnv returns a context whose spans are children of the span in the TRACEPARENT environment variable, if set
+func ContextFromEnv(ctx context.Context) context.Context {
+	traceParent, ok := os.LookupEnv(EnvVarTraceParent)
+	if !ok {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{"traceparent": traceParent})
+}
@@ -0,0 +1,71 @@
+package telemetry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracingDisabled(t *testing.T) {
+	tracing, err := NewTracing(context.Background(), "test")
+	require.NoError(t, err)
+	assert.False(t, tracing.Enabled())
+	_, span := tracing.Tracer().Start(context.Background(), "span")
+	assert.False(t, span.IsRecording())
+	tracing.Shutdown(context.Background())
+
+	var nilTracing *Tracing
+	assert.False(t, nilTracing.Enabled())
+	_, span = nilTracing.Tracer().Start(context.Background(), "span")
+	assert.False(t, span.IsRecording())
+}
+
+func TestContextWithSpanIDs(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tracing, err := NewTracing(context.Background(), "test", tracesdk.WithSyncer(exporter))
+	require.NoError(t, err)
+	assert.True(t, tracing.Enabled())
+	traceID := trace.TraceID{1, 2, 3}
+	spanID := trace.SpanID{4, 5, 6}
+	childSpanID := trace.SpanID{7, 8, 9}
+
+	ctx, span := tracing.Tracer().Start(ContextWithSpanIDs(context.Background(), traceID, spanID), "parent")
+	_, child := tracing.Tracer().Start(ContextWithSpanIDs(ctx, traceID, childSpanID), "child")
+	_, random := tracing.Tracer().Start(ctx, "random")
+	random.End()
+	child.End()
+	span.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 3)
+	assert.Equal(t, traceID, spans[0].SpanContext.TraceID())
+	assert.NotEqual(t, spanID, spans[0].SpanContext.SpanID())
+	assert.NotEqual(t, childSpanID, spans[0].SpanContext.SpanID())
+	assert.Equal(t, childSpanID, spans[1].SpanContext.SpanID())
+	assert.Equal(t, spanID, spans[1].Parent.SpanID())
+	assert.Equal(t, spanID, spans[2].SpanContext.SpanID())
+}
+
+func TestContextFromEnv(t *testing.T) {
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1, 2, 3},
+		SpanID:     trace.SpanID{4, 5, 6},
+		TraceFlags: trace.FlagsSampled,
+	})
+	traceParent := TraceParent(spanContext)
+	assert.Equal(t, "00-01020300000000000000000000000000-0405060000000000-01", traceParent)
+
+	assert.False(t, trace.SpanContextFromContext(ContextFromEnv(context.Background())).IsValid())
+
+	t.Setenv(EnvVarTraceParent, traceParent)
+	parent := trace.SpanContextFromContext(ContextFromEnv(context.Background()))
+	assert.True(t, parent.IsRemote())
+	assert.Equal(t, spanContext.TraceID(), parent.TraceID())
+	assert.Equal(t, spanContext.SpanID(), parent.SpanID())
+	assert.True(t, parent.IsSampled())
+}
@@ -8,15 +8,18 @@ import (
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/azure"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/encryption"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/gcs"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/git"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/hdfs"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/http"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/logging"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/oci"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/oss"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/raw"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/s3"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/sftp"
 )
 
 var ErrUnsupportedDriver = fmt.Errorf("unsupported artifact driver")
@@ -29,9 +32,18 @@ func NewDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 	if err != nil {
 		return nil, err
 	}
+	if art.Encryption != nil {
+		if art.Encryption.KeySecret == nil {
+			return nil, fmt.Errorf("encryption.keySecret is required")
+		}
+		keySecret := art.Encryption.KeySecret
+		drv = encryption.New(drv, func() (string, error) {
+			return ri.GetSecret(ctx, keySecret.Name, keySecret.Key)
+		})
+	}
 	return logging.New(drv), nil
-
 }
+
 func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (common.ArtifactDriver, error) {
 	if art.S3 != nil {
 		var accessKey string
@@ -42,6 +54,8 @@ func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 		var kmsEncryptionContext string
 		var enableEncryption bool
 		var caKey string
+		var partSize uint64
+		var concurrency int
 
 		if art.S3.AccessKeySecret != nil && art.S3.AccessKeySecret.Name != "" {
 			accessKeyBytes, err := ri.GetSecret(ctx, art.S3.AccessKeySecret.Name, art.S3.AccessKeySecret.Key)
@@ -90,6 +104,23 @@ func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 			caKey = caBytes
 		}
 
+		if art.S3.TransferOptions != nil {
+			partSize = s3.DefaultPartSize
+			if art.S3.TransferOptions.PartSize != nil {
+				if art.S3.TransferOptions.PartSize.Value() < s3.MinPartSize {
+					return nil, fmt.Errorf("transferOptions.partSize must be at least 5Mi")
+				}
+				partSize = uint64(art.S3.TransferOptions.PartSize.Value())
+			}
+			concurrency = s3.DefaultConcurrency
+			if art.S3.TransferOptions.Concurrency < 0 {
+				return nil, fmt.Errorf("transferOptions.concurrency must not be negative")
+			}
+			if art.S3.TransferOptions.Concurrency > 0 {
+				concurrency = int(art.S3.TransferOptions.Concurrency)
+			}
+		}
+
 		driver := s3.ArtifactDriver{
 			Endpoint:              art.S3.Endpoint,
 			AccessKey:             accessKey,
@@ -104,6 +135,8 @@ func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 			KmsEncryptionContext:  kmsEncryptionContext,
 			EnableEncryption:      enableEncryption,
 			ServerSideCustomerKey: serverSideCustomerKey,
+			PartSize:              partSize,
+			Concurrency:           concurrency,
 		}
 
 		return &driver, nil
@@ -187,6 +220,13 @@ func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 			}
 			gitDriver.SSHPrivateKey = sshPrivateKeyBytes
 		}
+		if art.Git.Commit != nil && art.Git.Commit.SigningKeySecret != nil {
+			signingKeyBytes, err := ri.GetSecret(ctx, art.Git.Commit.SigningKeySecret.Name, art.Git.Commit.SigningKeySecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			gitDriver.SigningKey = signingKeyBytes
+		}
 
 		return &gitDriver, nil
 	}
@@ -274,5 +314,57 @@ func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 		return &driver, nil
 	}
 
+	if art.OCI != nil {
+		driver := oci.ArtifactDriver{Insecure: art.OCI.Insecure}
+		if art.OCI.UsernameSecret != nil && art.OCI.UsernameSecret.Name != "" {
+			username, err := ri.GetSecret(ctx, art.OCI.UsernameSecret.Name, art.OCI.UsernameSecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.Username = username
+		}
+		if art.OCI.PasswordSecret != nil && art.OCI.PasswordSecret.Name != "" {
+			password, err := ri.GetSecret(ctx, art.OCI.PasswordSecret.Name, art.OCI.PasswordSecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.Password = password
+		}
+		return &driver, nil
+	}
+
+	if art.SFTP != nil {
+		driver := sftp.ArtifactDriver{InsecureIgnoreHostKey: art.SFTP.InsecureIgnoreHostKey}
+		if art.SFTP.UsernameSecret != nil {
+			username, err := ri.GetSecret(ctx, art.SFTP.UsernameSecret.Name, art.SFTP.UsernameSecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.Username = username
+		}
+		if art.SFTP.PasswordSecret != nil {
+			password, err := ri.GetSecret(ctx, art.SFTP.PasswordSecret.Name, art.SFTP.PasswordSecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.Password = password
+		}
+		if art.SFTP.SSHPrivateKeySecret != nil {
+			sshPrivateKey, err := ri.GetSecret(ctx, art.SFTP.SSHPrivateKeySecret.Name, art.SFTP.SSHPrivateKeySecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.SSHPrivateKey = sshPrivateKey
+		}
+		if art.SFTP.KnownHostsSecret != nil {
+			knownHosts, err := ri.GetSecret(ctx, art.SFTP.KnownHostsSecret.Name, art.SFTP.KnownHostsSecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.KnownHosts = knownHosts
+		}
+		return &driver, nil
+	}
+
 	return nil, ErrUnsupportedDriver
 }
@@ -7,9 +7,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/oci"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/s3"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/sftp"
 )
 
 type mockResourceInterface struct{}
@@ -61,4 +65,131 @@ func TestNewDriverS3(t *testing.T) {
 	assert.Equal(t, art.S3.AccessKeySecret.Key+"-secret", artDriver.AccessKey)
 	assert.Equal(t, art.S3.SecretKeySecret.Key+"-secret", artDriver.SecretKey)
 	assert.Equal(t, art.S3.SessionTokenSecret.Key+"-secret", artDriver.SessionToken)
+	assert.Zero(t, artDriver.PartSize)
+}
+
+func TestNewDriverExister(t *testing.T) {
+	art := &wfv1.Artifact{
+		ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{
+			S3Bucket: wfv1.S3Bucket{Endpoint: "endpoint", Bucket: "bucket"},
+			Key:      "art",
+		}},
+	}
+
+	got, err := NewDriver(context.TODO(), art, &mockResourceInterface{})
+	require.NoError(t, err)
+
+	_, ok := got.(common.ArtifactExister)
+	assert.True(t, ok, "the logging driver must not hide whether the driver can check for existing artifacts")
+}
+
+func TestNewDriverEncryption(t *testing.T) {
+	art := &wfv1.Artifact{
+		ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "hello"}},
+		Encryption: &wfv1.ArtifactEncryption{
+			KeySecret: &apiv1.SecretKeySelector{
+				LocalObjectReference: apiv1.LocalObjectReference{Name: "my-artifact-key"},
+				Key:                  "key",
+			},
+		},
+	}
+
+	got, err := NewDriver(context.TODO(), art, &mockResourceInterface{})
+	require.NoError(t, err)
+
+	// the raw artifact is not encrypted, so cannot be decrypted
+	_, err = got.OpenStream(art)
+	require.EqualError(t, err, "artifact is not encrypted")
+
+	art.Encryption.KeySecret = nil
+	_, err = NewDriver(context.TODO(), art, &mockResourceInterface{})
+	require.EqualError(t, err, "encryption.keySecret is required")
+}
+
+func TestNewDriverS3TransferOptions(t *testing.T) {
+	newArtifact := func(opts *wfv1.S3TransferOptions) *wfv1.Artifact {
+		return &wfv1.Artifact{
+			ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{
+				S3Bucket: wfv1.S3Bucket{Endpoint: "endpoint", Bucket: "bucket", TransferOptions: opts},
+				Key:      "art",
+			}},
+		}
+	}
+	t.Run("Defaults", func(t *testing.T) {
+		got, err := newDriver(context.TODO(), newArtifact(&wfv1.S3TransferOptions{}), &mockResourceInterface{})
+		require.NoError(t, err)
+		artDriver := got.(*s3.ArtifactDriver)
+		assert.Equal(t, uint64(s3.DefaultPartSize), artDriver.PartSize)
+		assert.Equal(t, s3.DefaultConcurrency, artDriver.Concurrency)
+	})
+	t.Run("Set", func(t *testing.T) {
+		partSize := resource.MustParse("64Mi")
+		got, err := newDriver(context.TODO(), newArtifact(&wfv1.S3TransferOptions{PartSize: &partSize, Concurrency: 8}), &mockResourceInterface{})
+		require.NoError(t, err)
+		artDriver := got.(*s3.ArtifactDriver)
+		assert.Equal(t, uint64(64*1024*1024), artDriver.PartSize)
+		assert.Equal(t, 8, artDriver.Concurrency)
+	})
+	t.Run("PartSizeTooSmall", func(t *testing.T) {
+		partSize := resource.MustParse("1Mi")
+		_, err := newDriver(context.TODO(), newArtifact(&wfv1.S3TransferOptions{PartSize: &partSize}), &mockResourceInterface{})
+		require.EqualError(t, err, "transferOptions.partSize must be at least 5Mi")
+	})
+}
+
+func TestNewDriverOCI(t *testing.T) {
+	art := &wfv1.Artifact{
+		ArtifactLocation: wfv1.ArtifactLocation{OCI: &wfv1.OCIArtifact{
+			OCIRegistry: wfv1.OCIRegistry{
+				Registry:   "ghcr.io",
+				Repository: "my-org/artifacts",
+				Insecure:   true,
+				UsernameSecret: &apiv1.SecretKeySelector{
+					LocalObjectReference: apiv1.LocalObjectReference{Name: "oci"},
+					Key:                  "username",
+				},
+				PasswordSecret: &apiv1.SecretKeySelector{
+					LocalObjectReference: apiv1.LocalObjectReference{Name: "oci"},
+					Key:                  "password",
+				},
+			},
+			Key: "art",
+		}},
+	}
+
+	got, err := newDriver(context.TODO(), art, &mockResourceInterface{})
+	require.NoError(t, err)
+
+	artDriver := got.(*oci.ArtifactDriver)
+	assert.Equal(t, "username-secret", artDriver.Username)
+	assert.Equal(t, "password-secret", artDriver.Password)
+	assert.True(t, artDriver.Insecure)
+}
+
+func TestNewDriverSFTP(t *testing.T) {
+	secret := func(key string) *apiv1.SecretKeySelector {
+		return &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "sftp"}, Key: key}
+	}
+	art := &wfv1.Artifact{
+		ArtifactLocation: wfv1.ArtifactLocation{SFTP: &wfv1.SFTPArtifact{
+			SFTPServer: wfv1.SFTPServer{
+				Host:                "sftp.example.com",
+				UsernameSecret:      secret("username"),
+				PasswordSecret:      secret("password"),
+				SSHPrivateKeySecret: secret("private-key"),
+				KnownHostsSecret:    secret("known-hosts"),
+			},
+			Path: "/art",
+		}},
+	}
+
+	got, err := newDriver(context.TODO(), art, &mockResourceInterface{})
+	require.NoError(t, err)
+
+	artDriver := got.(*sftp.ArtifactDriver)
+	assert.Equal(t, "username-secret", artDriver.Username)
+	assert.Equal(t, "password-secret", artDriver.Password)
+	assert.Equal(t, "private-key-secret", artDriver.SSHPrivateKey)
+	assert.Equal(t, "known-hosts-secret", artDriver.KnownHosts)
+	assert.False(t, artDriver.InsecureIgnoreHostKey)
 }
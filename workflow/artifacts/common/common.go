@@ -3,6 +3,7 @@ package common
 import (
 	"errors"
 	"io"
+	"time"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
@@ -30,6 +31,27 @@ type ArtifactDriver interface {
 	IsDirectory(artifact *v1alpha1.Artifact) (bool, error)
 }
 
+// ArtifactExister is implemented by drivers that can check whether an artifact exists without downloading it
+type ArtifactExister interface {
+	Exists(artifact *v1alpha1.Artifact) (bool, error)
+}
+
+// ArtifactStat describes a stored artifact
+type ArtifactStat struct {
+	Size         int64
+	ETag         string
+	LastModified time.Time
+}
+
+// ArtifactRangeStreamer is implemented by drivers that can describe an artifact and open a stream of part of it, so that
+// it can be served to range requests
+type ArtifactRangeStreamer interface {
+	Stat(artifact *v1alpha1.Artifact) (*ArtifactStat, error)
+
+	// OpenStreamFrom opens an artifact for reading from the offset to its end
+	OpenStreamFrom(artifact *v1alpha1.Artifact, offset int64) (io.ReadCloser, error)
+}
+
 // ErrDeleteNotSupported Sentinel error definition for artifact deletion
 var ErrDeleteNotSupported = errors.New("delete not supported for this artifact storage, please check" +
 	" the following issue for details: https://github.com/argoproj/argo-workflows/issues/3102")
@@ -0,0 +1,133 @@
+package encryption
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// driver encrypts artifacts before they are saved, and decrypts them after they are loaded or opened
+type driver struct {
+	common.ArtifactDriver
+	secret func() (string, error)
+}
+
+// New returns a driver that encrypts with the secret. The secret is only got when an artifact is saved,
+// loaded or opened, so that e.g. artifacts can be deleted without it.
+func New(d common.ArtifactDriver, secret func() (string, error)) common.ArtifactDriver {
+	return &driver{ArtifactDriver: d, secret: secret}
+}
+
+func (d *driver) Save(path string, a *wfv1.Artifact) error {
+	secret, err := d.secret()
+	if err != nil {
+		return err
+	}
+	tempDir, err := os.MkdirTemp("", "encrypted-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempDir)
+	// keep the name, as drivers may use it, e.g. to infer the content type
+	encryptedPath := filepath.Join(tempDir, filepath.Base(path))
+	err = transformPath(path, encryptedPath, func(w io.Writer, r io.Reader) error {
+		ew, err := NewWriter(w, secret)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(ew, r); err != nil {
+			return err
+		}
+		return ew.Close()
+	})
+	if err != nil {
+		return err
+	}
+	return d.ArtifactDriver.Save(encryptedPath, a)
+}
+
+func (d *driver) Load(a *wfv1.Artifact, path string) error {
+	secret, err := d.secret()
+	if err != nil {
+		return err
+	}
+	encryptedPath := path + ".encrypted"
+	defer os.RemoveAll(encryptedPath)
+	if err := d.ArtifactDriver.Load(a, encryptedPath); err != nil {
+		return err
+	}
+	return transformPath(encryptedPath, path, func(w io.Writer, r io.Reader) error {
+		dr, err := NewReader(r, secret)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(w, dr)
+		return err
+	})
+}
+
+func (d *driver) OpenStream(a *wfv1.Artifact) (io.ReadCloser, error) {
+	secret, err := d.secret()
+	if err != nil {
+		return nil, err
+	}
+	rc, err := d.ArtifactDriver.OpenStream(a)
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewReader(rc, secret)
+	if err != nil {
+		_ = rc.Close()
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{r, rc}, nil
+}
+
+// transformPath transforms the file at src, or each file in the directory at src, to the same path in dst
+func transformPath(src, dst string, transform func(w io.Writer, r io.Reader) error) error {
+	return filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if entry.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		return transformFile(path, target, info.Mode().Perm(), transform)
+	})
+}
+
+func transformFile(src, dst string, perm fs.FileMode, transform func(w io.Writer, r io.Reader) error) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+	if err := transform(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,93 @@
+package encryption
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// storeDriver saves artifacts by copying them to its path
+type storeDriver struct {
+	common.ArtifactDriver
+	path string
+}
+
+func (d *storeDriver) Save(path string, _ *wfv1.Artifact) error {
+	return transformPath(path, d.path, copyTo)
+}
+
+func (d *storeDriver) Load(_ *wfv1.Artifact, path string) error {
+	return transformPath(d.path, path, copyTo)
+}
+
+func (d *storeDriver) OpenStream(_ *wfv1.Artifact) (io.ReadCloser, error) {
+	return os.Open(d.path)
+}
+
+func copyTo(w io.Writer, r io.Reader) error {
+	_, err := io.Copy(w, r)
+	return err
+}
+
+func newTestDriver(t *testing.T, secret string) (common.ArtifactDriver, string) {
+	store := filepath.Join(t.TempDir(), "store")
+	return New(&storeDriver{path: store}, func() (string, error) { return secret, nil }), store
+}
+
+func TestDriverFile(t *testing.T) {
+	drv, store := newTestDriver(t, "my-secret")
+	src := filepath.Join(t.TempDir(), "my-file.txt")
+	require.NoError(t, os.WriteFile(src, []byte("hello"), 0o600))
+
+	require.NoError(t, drv.Save(src, &wfv1.Artifact{}))
+	stored, err := os.ReadFile(store)
+	require.NoError(t, err)
+	assert.NotContains(t, string(stored), "hello")
+
+	dst := filepath.Join(t.TempDir(), "my-file.txt")
+	require.NoError(t, drv.Load(&wfv1.Artifact{}, dst))
+	got, err := os.ReadFile(dst)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(got))
+	assert.NoFileExists(t, dst+".encrypted")
+
+	rc, err := drv.OpenStream(&wfv1.Artifact{})
+	require.NoError(t, err)
+	defer rc.Close()
+	got, err = io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(got))
+}
+
+func TestDriverDirectory(t *testing.T) {
+	drv, store := newTestDriver(t, "my-secret")
+	src := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "a.txt"), []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "sub", "b.txt"), []byte("b"), 0o600))
+
+	require.NoError(t, drv.Save(src, &wfv1.Artifact{}))
+	stored, err := os.ReadFile(filepath.Join(store, "sub", "b.txt"))
+	require.NoError(t, err)
+	assert.NotEqual(t, "b", string(stored))
+
+	dst := filepath.Join(t.TempDir(), "dir")
+	require.NoError(t, drv.Load(&wfv1.Artifact{}, dst))
+	got, err := os.ReadFile(filepath.Join(dst, "sub", "b.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "b", string(got))
+}
+
+func TestDriverSecretError(t *testing.T) {
+	drv := New(&storeDriver{}, func() (string, error) { return "", errors.New("secret not found") })
+	require.EqualError(t, drv.Save("my-file", &wfv1.Artifact{}), "secret not found")
+	require.EqualError(t, drv.Load(&wfv1.Artifact{}, "my-file"), "secret not found")
+}
@@ -0,0 +1,212 @@
+package encryption
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// An encrypted file starts with a header of the magic string, then the nonce and ciphertext of the file's
+// random data key, encrypted with a key derived from the secret. The rest of the file is a sequence of
+// chunks of at most chunkSize bytes of plaintext, each sealed with the data key. A chunk's nonce is its
+// index, with the last byte set for the final chunk, so reordered or truncated files fail to decrypt.
+const (
+	magic     = "ARGOENC1"
+	chunkSize = 64 * 1024
+	keySize   = 32
+)
+
+var errNotEncrypted = errors.New("artifact is not encrypted")
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// newKeyAEAD returns the cipher that data keys are encrypted with
+func newKeyAEAD(secret string) (cipher.AEAD, error) {
+	key := make([]byte, keySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, []byte(secret), nil, []byte("argo-workflows artifact encryption")), key); err != nil {
+		return nil, err
+	}
+	return newAEAD(key)
+}
+
+func chunkNonce(nonce []byte, index uint64, last bool) []byte {
+	clear(nonce)
+	binary.BigEndian.PutUint64(nonce[len(nonce)-9:], index)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+// NewWriter returns a writer that encrypts what is written to it to w. It must be closed to write the final chunk.
+func NewWriter(w io.Writer, secret string) (io.WriteCloser, error) {
+	keyAEAD, err := newKeyAEAD(secret)
+	if err != nil {
+		return nil, err
+	}
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	keyNonce := make([]byte, keyAEAD.NonceSize())
+	if _, err := rand.Read(keyNonce); err != nil {
+		return nil, err
+	}
+	header := append([]byte(magic), keyNonce...)
+	header = keyAEAD.Seal(header, keyNonce, dataKey, []byte(magic))
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &writer{w: w, aead: aead, nonce: make([]byte, aead.NonceSize()), buf: make([]byte, 0, chunkSize)}, nil
+}
+
+type writer struct {
+	w      io.Writer
+	aead   cipher.AEAD
+	nonce  []byte
+	index  uint64
+	buf    []byte
+	out    []byte
+	closed bool
+}
+
+func (e *writer) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, errors.New("write to closed encryption writer")
+	}
+	n := 0
+	for len(p) > 0 {
+		// a full chunk is only sealed once more data arrives, as the final chunk is sealed differently
+		if len(e.buf) == chunkSize {
+			if err := e.seal(false); err != nil {
+				return n, err
+			}
+		}
+		m := copy(e.buf[len(e.buf):chunkSize], p)
+		e.buf = e.buf[:len(e.buf)+m]
+		p = p[m:]
+		n += m
+	}
+	return n, nil
+}
+
+// Close writes the final chunk, it does not close the underlying writer
+func (e *writer) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	return e.seal(true)
+}
+
+func (e *writer) seal(last bool) error {
+	e.out = e.aead.Seal(e.out[:0], chunkNonce(e.nonce, e.index, last), e.buf, nil)
+	e.index++
+	e.buf = e.buf[:0]
+	_, err := e.w.Write(e.out)
+	return err
+}
+
+// NewReader returns a reader that decrypts what was written by a writer from NewWriter with the same secret
+func NewReader(r io.Reader, secret string) (io.Reader, error) {
+	keyAEAD, err := newKeyAEAD(secret)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, len(magic)+keyAEAD.NonceSize()+keySize+keyAEAD.Overhead())
+	if _, err := io.ReadFull(r, header); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, errNotEncrypted
+		}
+		return nil, err
+	}
+	if string(header[:len(magic)]) != magic {
+		return nil, errNotEncrypted
+	}
+	keyNonce := header[len(magic) : len(magic)+keyAEAD.NonceSize()]
+	dataKey, err := keyAEAD.Open(nil, keyNonce, header[len(magic)+keyAEAD.NonceSize():], []byte(magic))
+	if err != nil {
+		return nil, errors.New("failed to decrypt the artifact's data key, it may have been encrypted with a different key")
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &reader{
+		r:     bufio.NewReaderSize(r, chunkSize+aead.Overhead()),
+		aead:  aead,
+		nonce: make([]byte, aead.NonceSize()),
+		in:    make([]byte, chunkSize+aead.Overhead()),
+	}, nil
+}
+
+type reader struct {
+	r     *bufio.Reader
+	aead  cipher.AEAD
+	nonce []byte
+	index uint64
+	in    []byte
+	plain []byte
+	out   []byte
+	done  bool
+}
+
+func (d *reader) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+func (d *reader) open() error {
+	n, err := io.ReadFull(d.r, d.in)
+	last := false
+	switch {
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		last = true
+	case errors.Is(err, io.EOF):
+		return fmt.Errorf("failed to decrypt artifact: %w", io.ErrUnexpectedEOF)
+	case err != nil:
+		return err
+	default:
+		if _, err := d.r.Peek(1); errors.Is(err, io.EOF) {
+			last = true
+		} else if err != nil {
+			return err
+		}
+	}
+	plain, err := d.aead.Open(d.plain[:0], chunkNonce(d.nonce, d.index, last), d.in[:n], nil)
+	if err != nil {
+		return errors.New("failed to decrypt artifact, it may be corrupt or truncated")
+	}
+	d.plain = plain
+	d.out = plain
+	d.index++
+	d.done = last
+	return nil
+}
@@ -0,0 +1,76 @@
+package encryption
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encrypt(t *testing.T, plaintext []byte, secret string) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, secret)
+	require.NoError(t, err)
+	_, err = w.Write(plaintext)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func decrypt(ciphertext []byte, secret string) ([]byte, error) {
+	r, err := NewReader(bytes.NewReader(ciphertext), secret)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, size := range []int{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3*chunkSize + 7} {
+		plaintext := bytes.Repeat([]byte("x"), size)
+		ciphertext := encrypt(t, plaintext, "my-secret")
+		assert.NotContains(t, string(ciphertext), "xxxxxxxx")
+
+		got, err := decrypt(ciphertext, "my-secret")
+		require.NoError(t, err, size)
+		assert.Equal(t, plaintext, got, size)
+	}
+}
+
+func TestRandomDataKey(t *testing.T) {
+	assert.NotEqual(t, encrypt(t, []byte("hello"), "my-secret"), encrypt(t, []byte("hello"), "my-secret"))
+}
+
+func TestDecryptErrors(t *testing.T) {
+	plaintext := bytes.Repeat([]byte("x"), 2*chunkSize+1)
+	ciphertext := encrypt(t, plaintext, "my-secret")
+	header := len(magic) + 12 + keySize + 16
+
+	t.Run("WrongSecret", func(t *testing.T) {
+		_, err := decrypt(ciphertext, "other-secret")
+		require.ErrorContains(t, err, "encrypted with a different key")
+	})
+	t.Run("NotEncrypted", func(t *testing.T) {
+		_, err := decrypt([]byte("hello"), "my-secret")
+		require.ErrorIs(t, err, errNotEncrypted)
+		_, err = decrypt(plaintext, "my-secret")
+		require.ErrorIs(t, err, errNotEncrypted)
+	})
+	t.Run("TruncatedAtChunk", func(t *testing.T) {
+		_, err := decrypt(ciphertext[:header+chunkSize+16], "my-secret")
+		require.Error(t, err)
+	})
+	t.Run("TruncatedInChunk", func(t *testing.T) {
+		_, err := decrypt(ciphertext[:len(ciphertext)-1], "my-secret")
+		require.Error(t, err)
+	})
+	t.Run("Tampered", func(t *testing.T) {
+		tampered := bytes.Clone(ciphertext)
+		tampered[header+10] ^= 1
+		_, err := decrypt(tampered, "my-secret")
+		require.ErrorContains(t, err, "corrupt")
+	})
+}
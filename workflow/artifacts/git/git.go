@@ -5,11 +5,16 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
+	"time"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	ssh2 "github.com/go-git/go-git/v5/plumbing/transport/ssh"
@@ -29,6 +34,8 @@ type ArtifactDriver struct {
 	InsecureIgnoreHostKey bool
 	InsecureSkipTLS       bool
 	DisableSubmodules     bool
+	// SigningKey is the armored OpenPGP private key that signs commits of output artifacts
+	SigningKey string
 }
 
 var _ common.ArtifactDriver = &ArtifactDriver{}
@@ -73,9 +80,184 @@ func (g *ArtifactDriver) auth(sshUser string) (func(), transport.AuthMethod, err
 	return func() {}, nil, nil
 }
 
-// Save is unsupported for git output artifacts
-func (g *ArtifactDriver) Save(string, *wfv1.Artifact) error {
-	return errors.New("git output artifacts unsupported")
+// Save commits the file or directory at path to the branch of the repository, and pushes it
+func (g *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	a := outputArtifact.Git
+	c := a.Commit
+	if c == nil {
+		c = &wfv1.GitCommit{}
+	}
+	closer, auth, err := g.auth(GetUser(a.Repo))
+	if err != nil {
+		return err
+	}
+	defer closer()
+	dir, err := os.MkdirTemp("", "git-artifact-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	r, err := git.PlainClone(dir, false, &git.CloneOptions{URL: a.Repo, Auth: auth, Depth: a.GetDepth(), InsecureSkipTLS: g.InsecureSkipTLS})
+	switch err {
+	case transport.ErrEmptyRemoteRepository:
+		log.Info("Cloned an empty repository")
+		r, err = git.PlainInit(dir, false)
+		if err != nil {
+			return fmt.Errorf("failed to plain init: %w", err)
+		}
+		if _, err := r.CreateRemote(&config.RemoteConfig{Name: git.DefaultRemoteName, URLs: []string{a.Repo}}); err != nil {
+			return fmt.Errorf("failed to create remote %q: %w", a.Repo, err)
+		}
+	case nil:
+		// fallthrough ...
+	default:
+		return fmt.Errorf("failed to clone %q: %w", a.Repo, err)
+	}
+	branch, remoteHash, err := checkoutBranch(r, a.Branch)
+	if err != nil {
+		return err
+	}
+	w, err := r.Worktree()
+	if err != nil {
+		return fmt.Errorf("failed to get work tree: %w", err)
+	}
+	dest := filepath.Join(dir, filepath.Clean("/"+c.Path))
+	if err := replaceContents(path, dest); err != nil {
+		return fmt.Errorf("failed to copy artifact into repository: %w", err)
+	}
+	if err := w.AddWithOptions(&git.AddOptions{All: true}); err != nil {
+		return fmt.Errorf("failed to add changes: %w", err)
+	}
+	status, err := w.Status()
+	if err != nil {
+		return fmt.Errorf("failed to get status: %w", err)
+	}
+	if status.IsClean() {
+		log.Infof("No changes to commit to branch %q", branch.Short())
+		return nil
+	}
+	signKey, err := g.signKey()
+	if err != nil {
+		return err
+	}
+	message := c.Message
+	if message == "" {
+		message = fmt.Sprintf("Update output artifact %s", outputArtifact.Name)
+	}
+	h, err := w.Commit(message, &git.CommitOptions{
+		Author:  &object.Signature{Name: c.GetAuthorName(), Email: c.AuthorEmail, When: time.Now()},
+		SignKey: signKey,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+	opts := &git.PushOptions{
+		Auth:            auth,
+		RefSpecs:        []config.RefSpec{config.RefSpec(fmt.Sprintf("%s:%s", branch, branch))},
+		InsecureSkipTLS: g.InsecureSkipTLS,
+	}
+	// a new branch has no history to replace
+	if c.Force && !remoteHash.IsZero() {
+		opts.RefSpecs[0] = "+" + opts.RefSpecs[0]
+		opts.ForceWithLease = &git.ForceWithLease{RefName: branch, Hash: remoteHash}
+	}
+	if err := r.Push(opts); err != nil {
+		return fmt.Errorf("failed to push %q to branch %q: %w", h, branch.Short(), err)
+	}
+	log.Infof("Pushed %q to branch %q", h, branch.Short())
+	return nil
+}
+
+// checkoutBranch checks out the branch, or the default branch if it is empty, creating the branch if it does not exist.
+// It returns the branch, and its hash on the remote, which is zero if it does not exist.
+func checkoutBranch(r *git.Repository, name string) (plumbing.ReferenceName, plumbing.Hash, error) {
+	head, err := r.Head()
+	if err == plumbing.ErrReferenceNotFound {
+		// an empty repository has no commits to check out, so the first commit is made to the branch
+		branch := plumbing.Master
+		if name != "" {
+			branch = plumbing.NewBranchReferenceName(name)
+		}
+		if err := r.Storer.SetReference(plumbing.NewSymbolicReference(plumbing.HEAD, branch)); err != nil {
+			return "", plumbing.ZeroHash, fmt.Errorf("failed to set HEAD to %q: %w", branch, err)
+		}
+		return branch, plumbing.ZeroHash, nil
+	}
+	if err != nil {
+		return "", plumbing.ZeroHash, fmt.Errorf("failed to get HEAD: %w", err)
+	}
+	if name == "" || head.Name().Short() == name {
+		return head.Name(), head.Hash(), nil
+	}
+	branch := plumbing.NewBranchReferenceName(name)
+	opts := &git.CheckoutOptions{Branch: branch, Create: true, Hash: head.Hash()}
+	remoteHash := plumbing.ZeroHash
+	remote, err := r.Reference(plumbing.NewRemoteReferenceName(git.DefaultRemoteName, name), true)
+	switch err {
+	case plumbing.ErrReferenceNotFound:
+		log.Infof("Creating branch %q", name)
+	case nil:
+		opts.Hash = remote.Hash()
+		remoteHash = remote.Hash()
+	default:
+		return "", plumbing.ZeroHash, fmt.Errorf("failed to get branch %q: %w", name, err)
+	}
+	w, err := r.Worktree()
+	if err != nil {
+		return "", plumbing.ZeroHash, fmt.Errorf("failed to get work tree: %w", err)
+	}
+	if err := w.Checkout(opts); err != nil {
+		return "", plumbing.ZeroHash, fmt.Errorf("failed to checkout %q: %w", name, err)
+	}
+	return branch, remoteHash, nil
+}
+
+// replaceContents replaces the contents of the directory dest, other than the .git directory, with the file or the
+// contents of the directory src
+func replaceContents(src, dest string) error {
+	if err := os.MkdirAll(dest, 0o755); err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(dest)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.Name() == git.GitDirName {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(dest, e.Name())); err != nil {
+			return err
+		}
+	}
+	fi, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	if fi.IsDir() {
+		return os.CopyFS(dest, os.DirFS(src))
+	}
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dest, filepath.Base(src)), data, fi.Mode().Perm())
+}
+
+// signKey returns the OpenPGP entity that signs commits, if any
+func (g *ArtifactDriver) signKey() (*openpgp.Entity, error) {
+	if g.SigningKey == "" {
+		return nil, nil
+	}
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(g.SigningKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key: %w", err)
+	}
+	if len(entities) == 0 || entities[0].PrivateKey == nil {
+		return nil, errors.New("signing key does not contain a private key")
+	}
+	return entities[0], nil
 }
 
 // Delete is unsupported for git artifacts
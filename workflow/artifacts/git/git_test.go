@@ -1,9 +1,16 @@
 package git
 
 import (
+	"bytes"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"k8s.io/client-go/util/homedir"
 
 	"github.com/stretchr/testify/assert"
@@ -13,9 +20,82 @@ import (
 )
 
 func TestGitArtifactDriver_Save(t *testing.T) {
-	driver := &ArtifactDriver{}
-	err := driver.Save("", nil)
-	require.Error(t, err)
+	repo := t.TempDir()
+	_, err := git.PlainInit(repo, true)
+	require.NoError(t, err)
+	src := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(src, "a.yaml"), []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "b.yaml"), []byte("b"), 0o600))
+	save := func(driver *ArtifactDriver, a *wfv1.GitArtifact) error {
+		a.Repo = repo
+		return driver.Save(src, &wfv1.Artifact{Name: "config", ArtifactLocation: wfv1.ArtifactLocation{Git: a}})
+	}
+	clone := func(t *testing.T, branch string) (*git.Repository, string) {
+		dir := t.TempDir()
+		r, err := git.PlainClone(dir, false, &git.CloneOptions{URL: repo, ReferenceName: plumbing.NewBranchReferenceName(branch)})
+		require.NoError(t, err)
+		return r, dir
+	}
+	head := func(t *testing.T, r *git.Repository) *object.Commit {
+		ref, err := r.Head()
+		require.NoError(t, err)
+		c, err := r.CommitObject(ref.Hash())
+		require.NoError(t, err)
+		return c
+	}
+
+	t.Run("EmptyRepo", func(t *testing.T) {
+		require.NoError(t, save(&ArtifactDriver{}, &wfv1.GitArtifact{}))
+		r, dir := clone(t, "master")
+		assert.FileExists(t, filepath.Join(dir, "a.yaml"))
+		c := head(t, r)
+		assert.Equal(t, "Update output artifact config", c.Message)
+		assert.Equal(t, "Argo Workflows", c.Author.Name)
+	})
+	t.Run("NewBranch", func(t *testing.T) {
+		commit := &wfv1.GitCommit{Path: "config", AuthorName: "Bot", AuthorEmail: "bot@example.com", Message: "Update config"}
+		require.NoError(t, save(&ArtifactDriver{}, &wfv1.GitArtifact{Branch: "config", Commit: commit}))
+		r, dir := clone(t, "config")
+		assert.FileExists(t, filepath.Join(dir, "a.yaml"))
+		assert.FileExists(t, filepath.Join(dir, "config", "b.yaml"))
+		c := head(t, r)
+		assert.Equal(t, "Update config", c.Message)
+		assert.Equal(t, "bot@example.com", c.Author.Email)
+
+		t.Run("NoChanges", func(t *testing.T) {
+			require.NoError(t, save(&ArtifactDriver{}, &wfv1.GitArtifact{Branch: "config", Commit: commit}))
+			r, _ := clone(t, "config")
+			assert.Equal(t, c.Hash, head(t, r).Hash)
+		})
+		t.Run("RemovedFile", func(t *testing.T) {
+			require.NoError(t, os.Remove(filepath.Join(src, "b.yaml")))
+			require.NoError(t, save(&ArtifactDriver{}, &wfv1.GitArtifact{Branch: "config", Commit: commit}))
+			r, dir := clone(t, "config")
+			assert.FileExists(t, filepath.Join(dir, "config", "a.yaml"))
+			assert.NoFileExists(t, filepath.Join(dir, "config", "b.yaml"))
+			assert.Equal(t, []plumbing.Hash{c.Hash}, head(t, r).ParentHashes)
+		})
+	})
+	t.Run("Signed", func(t *testing.T) {
+		entity, err := openpgp.NewEntity("Bot", "", "bot@example.com", nil)
+		require.NoError(t, err)
+		key := &bytes.Buffer{}
+		w, err := armor.Encode(key, openpgp.PrivateKeyType, nil)
+		require.NoError(t, err)
+		require.NoError(t, entity.SerializePrivate(w, nil))
+		require.NoError(t, w.Close())
+		require.NoError(t, os.WriteFile(filepath.Join(src, "c.yaml"), []byte("c"), 0o600))
+		require.NoError(t, save(&ArtifactDriver{SigningKey: key.String()}, &wfv1.GitArtifact{}))
+		r, _ := clone(t, "master")
+		c := head(t, r)
+		assert.NotEmpty(t, c.PGPSignature)
+		_, err = c.Verify(key.String())
+		require.NoError(t, err)
+	})
+	t.Run("InvalidSigningKey", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(src, "d.yaml"), []byte("d"), 0o600))
+		require.Error(t, save(&ArtifactDriver{SigningKey: "garbage"}, &wfv1.GitArtifact{}))
+	})
 }
 
 func TestGitArtifactDriver_Load(t *testing.T) {
@@ -6,6 +6,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	argoerrors "github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
 )
@@ -78,3 +79,55 @@ func (d driver) IsDirectory(a *wfv1.Artifact) (bool, error) {
 		Info("Check if directory")
 	return isDir, err
 }
+
+// Exists reports false when the wrapped driver cannot check for an artifact, so that it is uploaded
+func (d driver) Exists(a *wfv1.Artifact) (bool, error) {
+	exister, ok := d.ArtifactDriver.(common.ArtifactExister)
+	if !ok {
+		return false, nil
+	}
+	t := time.Now()
+	key, _ := a.GetKey()
+	exists, err := exister.Exists(a)
+	log.WithField("artifactName", a.Name).
+		WithField("key", key).
+		WithField("duration", time.Since(t)).
+		WithError(err).
+		Info("Check if exists")
+	return exists, err
+}
+
+// Stat returns a not implemented error when the wrapped driver cannot describe an artifact
+func (d driver) Stat(a *wfv1.Artifact) (*common.ArtifactStat, error) {
+	streamer, ok := d.ArtifactDriver.(common.ArtifactRangeStreamer)
+	if !ok {
+		return nil, argoerrors.New(argoerrors.CodeNotImplemented, "Stat is not supported for this artifact driver")
+	}
+	t := time.Now()
+	key, _ := a.GetKey()
+	stat, err := streamer.Stat(a)
+	log.WithField("artifactName", a.Name).
+		WithField("key", key).
+		WithField("duration", time.Since(t)).
+		WithError(err).
+		Info("Stat artifact")
+	return stat, err
+}
+
+// OpenStreamFrom returns a not implemented error when the wrapped driver cannot stream part of an artifact
+func (d driver) OpenStreamFrom(a *wfv1.Artifact, offset int64) (io.ReadCloser, error) {
+	streamer, ok := d.ArtifactDriver.(common.ArtifactRangeStreamer)
+	if !ok {
+		return nil, argoerrors.New(argoerrors.CodeNotImplemented, "OpenStreamFrom is not supported for this artifact driver")
+	}
+	t := time.Now()
+	key, _ := a.GetKey()
+	rc, err := streamer.OpenStreamFrom(a, offset)
+	log.WithField("artifactName", a.Name).
+		WithField("key", key).
+		WithField("offset", offset).
+		WithField("duration", time.Since(t)).
+		WithError(err).
+		Info("Stream artifact from offset")
+	return rc, err
+}
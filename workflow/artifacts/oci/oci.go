@@ -0,0 +1,407 @@
+package oci
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/argoproj/pkg/file"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	log "github.com/sirupsen/logrus"
+
+	argoerrors "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+const (
+	// ArtifactType is the media type of the manifest config, which ORAS uses to identify the type of artifact
+	ArtifactType = "application/vnd.argoproj.workflow.artifact.v1+json"
+	// LayerMediaType is the media type of each file layer, the same default as `oras push`
+	LayerMediaType = types.OCIUncompressedLayer
+
+	// titleAnnotation is the layer annotation ORAS uses for the file name
+	titleAnnotation = "org.opencontainers.image.title"
+	// keyAnnotation records the artifact key on the manifest, as the tag is only a digest of it
+	keyAnnotation = "workflows.argoproj.io/key"
+	// directoryAnnotation marks manifests holding a directory, with one layer per file
+	directoryAnnotation = "workflows.argoproj.io/directory"
+)
+
+// ArtifactDriver is a driver for OCI registries. Each artifact key is stored as an ORAS-style
+// manifest, tagged with the SHA-256 digest of the key, holding one layer per file.
+type ArtifactDriver struct {
+	Username string
+	Password string
+	Insecure bool
+}
+
+var _ artifactscommon.ArtifactDriver = &ArtifactDriver{}
+
+func (d *ArtifactDriver) options() []remote.Option {
+	auth := authn.Anonymous
+	if d.Username != "" || d.Password != "" {
+		auth = &authn.Basic{Username: d.Username, Password: d.Password}
+	}
+	return []remote.Option{remote.WithAuth(auth)}
+}
+
+func (d *ArtifactDriver) repository(a *wfv1.OCIArtifact) (name.Repository, error) {
+	var opts []name.Option
+	if d.Insecure || a.Insecure {
+		opts = append(opts, name.Insecure)
+	}
+	repo, err := name.NewRepository(a.Registry+"/"+a.Repository, opts...)
+	if err != nil {
+		return name.Repository{}, fmt.Errorf("invalid OCI repository %s/%s: %w", a.Registry, a.Repository, err)
+	}
+	return repo, nil
+}
+
+// tagForKey returns the tag a key is stored under. Keys may contain characters, such as "/", that are not
+// allowed in tags, so the hex encoded SHA-256 digest of the key is used instead.
+func tagForKey(key string) string {
+	sum := sha256.Sum256([]byte(strings.Trim(key, "/")))
+	return hex.EncodeToString(sum[:])
+}
+
+// artifactManifest is a manifest stored for a key, along with the path of the requested key within it
+type artifactManifest struct {
+	repo     name.Repository
+	digest   v1.Hash
+	key      string
+	subPath  string
+	manifest *v1.Manifest
+}
+
+func (m *artifactManifest) isDirectory() bool {
+	return m.manifest.Annotations[directoryAnnotation] == "true"
+}
+
+// files returns the layers of the manifest beneath the requested path, keyed by their path relative to it
+func (m *artifactManifest) files() map[string]v1.Descriptor {
+	files := make(map[string]v1.Descriptor)
+	for _, l := range m.manifest.Layers {
+		title := l.Annotations[titleAnnotation]
+		if !m.isDirectory() {
+			files[""] = l
+		} else if m.subPath == "" {
+			files[title] = l
+		} else if title == m.subPath {
+			files[""] = l
+		} else if strings.HasPrefix(title, m.subPath+"/") {
+			files[strings.TrimPrefix(title, m.subPath+"/")] = l
+		}
+	}
+	return files
+}
+
+// resolve finds the manifest for the artifact key. Files within a directory artifact do not have a manifest of
+// their own, so if there is no manifest for the key, the parent keys are tried in turn. It returns nil
+// if the key does not exist.
+func (d *ArtifactDriver) resolve(a *wfv1.OCIArtifact) (*artifactManifest, error) {
+	repo, err := d.repository(a)
+	if err != nil {
+		return nil, err
+	}
+	key := strings.Trim(a.Key, "/")
+	for k := key; k != "" && k != "."; k = path.Dir(k) {
+		desc, err := remote.Get(repo.Tag(tagForKey(k)), d.options()...)
+		if isNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("unable to get manifest for %s: %w", k, err)
+		}
+		manifest, err := v1.ParseManifest(bytes.NewReader(desc.Manifest))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse manifest for %s: %w", k, err)
+		}
+		m := &artifactManifest{
+			repo:     repo,
+			digest:   desc.Digest,
+			key:      k,
+			subPath:  strings.TrimPrefix(strings.TrimPrefix(key, k), "/"),
+			manifest: manifest,
+		}
+		if m.subPath != "" && (!m.isDirectory() || len(m.files()) == 0) {
+			return nil, nil
+		}
+		return m, nil
+	}
+	return nil, nil
+}
+
+func isNotFound(err error) bool {
+	var terr *transport.Error
+	return errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound
+}
+
+func notFound(a *wfv1.OCIArtifact) error {
+	return argoerrors.New(argoerrors.CodeNotFound, fmt.Sprintf("no manifest for key %s in %s/%s", a.Key, a.Registry, a.Repository))
+}
+
+// Load downloads artifacts from an OCI registry
+func (d *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
+	a := inputArtifact.OCI
+	log.WithFields(log.Fields{"registry": a.Registry, "repository": a.Repository, "key": a.Key}).Info("Pulling from OCI registry")
+	m, err := d.resolve(a)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return notFound(a)
+	}
+	files := m.files()
+	if l, ok := files[""]; ok {
+		return d.pullFile(m.repo, l, path)
+	}
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		return fmt.Errorf("unable to create local directory %s: %w", path, err)
+	}
+	for rel, l := range files {
+		if !filepath.IsLocal(rel) {
+			return fmt.Errorf("refusing to pull %s from %s outside of the directory", rel, m.key)
+		}
+		if err := d.pullFile(m.repo, l, filepath.Join(path, rel)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *ArtifactDriver) openLayer(repo name.Repository, l v1.Descriptor) (io.ReadCloser, error) {
+	layer, err := remote.Layer(repo.Digest(l.Digest.String()), d.options()...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get layer %s: %w", l.Digest, err)
+	}
+	return layer.Compressed()
+}
+
+func (d *ArtifactDriver) pullFile(repo name.Repository, l v1.Descriptor, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("unable to create dir for file %s: %w", path, err)
+	}
+	rc, err := d.openLayer(repo, l)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := rc.Close(); err != nil {
+			log.Warnf("unable to close layer %s: %s", l.Digest, err)
+		}
+	}()
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to create file %s: %w", path, err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Warnf("unable to close file %s: %s", path, err)
+		}
+	}()
+	if _, err := io.Copy(f, rc); err != nil {
+		return fmt.Errorf("unable to pull layer %s to %s: %w", l.Digest, path, err)
+	}
+	return nil
+}
+
+// OpenStream opens a stream reader for an artifact from an OCI registry
+func (d *ArtifactDriver) OpenStream(inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	a := inputArtifact.OCI
+	log.WithFields(log.Fields{"registry": a.Registry, "repository": a.Repository, "key": a.Key}).Info("Streaming from OCI registry")
+	m, err := d.resolve(a)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, notFound(a)
+	}
+	l, ok := m.files()[""]
+	if !ok {
+		return nil, argoerrors.New(argoerrors.CodeNotImplemented, "Directory Stream capability currently unimplemented for OCI")
+	}
+	return d.openLayer(m.repo, l)
+}
+
+// fileLayer is a layer read directly from a local file, without compression
+type fileLayer struct {
+	path   string
+	digest v1.Hash
+	size   int64
+}
+
+var _ v1.Layer = &fileLayer{}
+
+func newFileLayer(path string) (*fileLayer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open file %s: %w", path, err)
+	}
+	defer f.Close()
+	digest, size, err := v1.SHA256(f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to digest file %s: %w", path, err)
+	}
+	return &fileLayer{path: path, digest: digest, size: size}, nil
+}
+
+func (l *fileLayer) Digest() (v1.Hash, error)             { return l.digest, nil }
+func (l *fileLayer) DiffID() (v1.Hash, error)             { return l.digest, nil }
+func (l *fileLayer) Compressed() (io.ReadCloser, error)   { return os.Open(l.path) }
+func (l *fileLayer) Uncompressed() (io.ReadCloser, error) { return os.Open(l.path) }
+func (l *fileLayer) Size() (int64, error)                 { return l.size, nil }
+func (l *fileLayer) MediaType() (types.MediaType, error)  { return LayerMediaType, nil }
+
+// rawManifest is a manifest ready to be pushed
+type rawManifest []byte
+
+func (m rawManifest) RawManifest() ([]byte, error)        { return m, nil }
+func (m rawManifest) MediaType() (types.MediaType, error) { return types.OCIManifestSchema1, nil }
+
+// Save pushes an artifact to an OCI registry
+func (d *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	a := outputArtifact.OCI
+	log.WithFields(log.Fields{"registry": a.Registry, "repository": a.Repository, "key": a.Key}).Info("Pushing to OCI registry")
+	repo, err := d.repository(a)
+	if err != nil {
+		return err
+	}
+	isDir, err := file.IsDirectory(path)
+	if err != nil {
+		return fmt.Errorf("failed to test if %s is a directory: %w", path, err)
+	}
+	files := map[string]string{}
+	if isDir {
+		root := filepath.Clean(path)
+		err := filepath.Walk(root, func(localPath string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if fi.IsDir() || fi.Mode()&os.ModeSymlink != 0 {
+				return nil
+			}
+			rel, err := filepath.Rel(root, localPath)
+			if err != nil {
+				return err
+			}
+			files[filepath.ToSlash(rel)] = localPath
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("unable to walk directory %s: %w", path, err)
+		}
+	} else {
+		files[filepath.Base(path)] = path
+	}
+
+	config := static.NewLayer([]byte("{}"), ArtifactType)
+	if err := remote.WriteLayer(repo, config, d.options()...); err != nil {
+		return fmt.Errorf("unable to push config for %s: %w", a.Key, err)
+	}
+	configDigest, _ := config.Digest()
+	configSize, _ := config.Size()
+	manifest := v1.Manifest{
+		SchemaVersion: 2,
+		MediaType:     types.OCIManifestSchema1,
+		Config:        v1.Descriptor{MediaType: ArtifactType, Digest: configDigest, Size: configSize},
+		Annotations:   map[string]string{keyAnnotation: strings.Trim(a.Key, "/")},
+	}
+	if isDir {
+		manifest.Annotations[directoryAnnotation] = "true"
+	}
+	for _, title := range slices.Sorted(maps.Keys(files)) {
+		layer, err := newFileLayer(files[title])
+		if err != nil {
+			return err
+		}
+		if err := remote.WriteLayer(repo, layer, d.options()...); err != nil {
+			return fmt.Errorf("unable to push %s: %w", files[title], err)
+		}
+		manifest.Layers = append(manifest.Layers, v1.Descriptor{
+			MediaType:   LayerMediaType,
+			Digest:      layer.digest,
+			Size:        layer.size,
+			Annotations: map[string]string{titleAnnotation: title},
+		})
+	}
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	if err := remote.Put(repo.Tag(tagForKey(a.Key)), rawManifest(data), d.options()...); err != nil {
+		return fmt.Errorf("unable to push manifest for %s: %w", a.Key, err)
+	}
+	return nil
+}
+
+// Delete deletes the manifest of an artifact from an OCI registry. Layers are left for the registry's
+// garbage collection to remove.
+func (d *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	a := artifact.OCI
+	log.WithFields(log.Fields{"registry": a.Registry, "repository": a.Repository, "key": a.Key}).Info("Deleting from OCI registry")
+	m, err := d.resolve(a)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		log.Debugf("manifest to delete for '%s' does not exist", a.Key)
+		return nil
+	}
+	if m.subPath != "" {
+		return fmt.Errorf("unable to delete %s, it is part of the directory %s", a.Key, m.key)
+	}
+	// not every registry supports deleting tags, but deleting the manifest by digest removes its tags too
+	if err := remote.Delete(m.repo.Tag(tagForKey(m.key)), d.options()...); err != nil && !isNotFound(err) {
+		log.WithError(err).Debugf("unable to delete tag for %s", m.key)
+	}
+	if err := remote.Delete(m.repo.Digest(m.digest.String()), d.options()...); err != nil && !isNotFound(err) {
+		return fmt.Errorf("unable to delete manifest for %s: %w", m.key, err)
+	}
+	return nil
+}
+
+// ListObjects lists the files of an artifact in an OCI registry
+func (d *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	a := artifact.OCI
+	m, err := d.resolve(a)
+	if err != nil || m == nil {
+		return nil, err
+	}
+	key := strings.Trim(a.Key, "/")
+	var objects []string
+	for _, rel := range slices.Sorted(maps.Keys(m.files())) {
+		if rel == "" {
+			objects = append(objects, key)
+		} else {
+			objects = append(objects, key+"/"+rel)
+		}
+	}
+	return objects, nil
+}
+
+// IsDirectory indicates whether or not the artifact represents a directory or a single file.
+func (d *ArtifactDriver) IsDirectory(artifact *wfv1.Artifact) (bool, error) {
+	m, err := d.resolve(artifact.OCI)
+	if err != nil || m == nil {
+		return false, err
+	}
+	_, isFile := m.files()[""]
+	return !isFile, nil
+}
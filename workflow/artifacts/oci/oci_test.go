@@ -0,0 +1,165 @@
+package oci
+
+import (
+	"encoding/json"
+	"io"
+	stdlog "log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	argoerrors "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func newRegistry(t *testing.T) string {
+	s := httptest.NewServer(registry.New(registry.Logger(stdlog.New(io.Discard, "", 0))))
+	t.Cleanup(s.Close)
+	return strings.TrimPrefix(s.URL, "http://")
+}
+
+func newArtifact(registry, key string) *wfv1.Artifact {
+	return &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{OCI: &wfv1.OCIArtifact{
+		OCIRegistry: wfv1.OCIRegistry{Registry: registry, Repository: "my-org/artifacts", Insecure: true},
+		Key:         key,
+	}}}
+}
+
+func TestTagForKey(t *testing.T) {
+	assert.Len(t, tagForKey("my-wf/my-pod/main.log"), 64)
+	assert.Equal(t, tagForKey("my-wf/my-pod"), tagForKey("/my-wf/my-pod/"))
+	assert.NotEqual(t, tagForKey("my-wf/my-pod"), tagForKey("my-wf/my-other-pod"))
+}
+
+func TestArtifactDriver_File(t *testing.T) {
+	reg := newRegistry(t)
+	driver := &ArtifactDriver{}
+	tmp := t.TempDir()
+	src := filepath.Join(tmp, "main.log")
+	require.NoError(t, os.WriteFile(src, []byte("my-content"), 0o600))
+	art := newArtifact(reg, "my-wf/my-pod/main.log")
+
+	require.NoError(t, driver.Save(src, art))
+
+	t.Run("Manifest", func(t *testing.T) {
+		repo, err := driver.repository(art.OCI)
+		require.NoError(t, err)
+		desc, err := remote.Get(repo.Tag(tagForKey(art.OCI.Key)))
+		require.NoError(t, err)
+		var m v1.Manifest
+		require.NoError(t, json.Unmarshal(desc.Manifest, &m))
+		assert.Equal(t, ArtifactType, string(m.Config.MediaType))
+		assert.Equal(t, "my-wf/my-pod/main.log", m.Annotations[keyAnnotation])
+		require.Len(t, m.Layers, 1)
+		assert.Equal(t, "main.log", m.Layers[0].Annotations[titleAnnotation])
+	})
+	t.Run("Load", func(t *testing.T) {
+		dst := filepath.Join(tmp, "loaded.log")
+		require.NoError(t, driver.Load(art, dst))
+		data, err := os.ReadFile(dst)
+		require.NoError(t, err)
+		assert.Equal(t, "my-content", string(data))
+	})
+	t.Run("OpenStream", func(t *testing.T) {
+		rc, err := driver.OpenStream(art)
+		require.NoError(t, err)
+		defer rc.Close()
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, "my-content", string(data))
+	})
+	t.Run("IsDirectory", func(t *testing.T) {
+		isDir, err := driver.IsDirectory(art)
+		require.NoError(t, err)
+		assert.False(t, isDir)
+	})
+	t.Run("ListObjects", func(t *testing.T) {
+		objects, err := driver.ListObjects(art)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-wf/my-pod/main.log"}, objects)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, driver.Delete(art))
+		err := driver.Load(art, filepath.Join(tmp, "deleted.log"))
+		assert.True(t, argoerrors.IsCode(argoerrors.CodeNotFound, err))
+		require.NoError(t, driver.Delete(art), "deleting a missing artifact is not an error")
+	})
+}
+
+func TestArtifactDriver_Directory(t *testing.T) {
+	reg := newRegistry(t)
+	driver := &ArtifactDriver{}
+	tmp := t.TempDir()
+	src := filepath.Join(tmp, "src")
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "a.txt"), []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "sub", "b.txt"), []byte("b"), 0o600))
+	art := newArtifact(reg, "my-wf/my-pod/my-dir")
+
+	require.NoError(t, driver.Save(src, art))
+
+	t.Run("Load", func(t *testing.T) {
+		dst := filepath.Join(tmp, "dst")
+		require.NoError(t, driver.Load(art, dst))
+		data, err := os.ReadFile(filepath.Join(dst, "a.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "a", string(data))
+		data, err = os.ReadFile(filepath.Join(dst, "sub", "b.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "b", string(data))
+	})
+	t.Run("LoadSubDirectory", func(t *testing.T) {
+		dst := filepath.Join(tmp, "sub-dst")
+		require.NoError(t, driver.Load(newArtifact(reg, "my-wf/my-pod/my-dir/sub"), dst))
+		data, err := os.ReadFile(filepath.Join(dst, "b.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "b", string(data))
+	})
+	t.Run("OpenStream", func(t *testing.T) {
+		_, err := driver.OpenStream(art)
+		assert.True(t, argoerrors.IsCode(argoerrors.CodeNotImplemented, err))
+
+		rc, err := driver.OpenStream(newArtifact(reg, "my-wf/my-pod/my-dir/sub/b.txt"))
+		require.NoError(t, err)
+		defer rc.Close()
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, "b", string(data))
+	})
+	t.Run("IsDirectory", func(t *testing.T) {
+		for key, expected := range map[string]bool{
+			"my-wf/my-pod/my-dir":           true,
+			"my-wf/my-pod/my-dir/sub":       true,
+			"my-wf/my-pod/my-dir/sub/b.txt": false,
+			"my-wf/my-pod/my-dir/missing":   false,
+		} {
+			isDir, err := driver.IsDirectory(newArtifact(reg, key))
+			require.NoError(t, err)
+			assert.Equal(t, expected, isDir, key)
+		}
+	})
+	t.Run("ListObjects", func(t *testing.T) {
+		objects, err := driver.ListObjects(art)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-wf/my-pod/my-dir/a.txt", "my-wf/my-pod/my-dir/sub/b.txt"}, objects)
+
+		objects, err = driver.ListObjects(newArtifact(reg, "my-wf/my-pod/my-dir/sub"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-wf/my-pod/my-dir/sub/b.txt"}, objects)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		require.Error(t, driver.Delete(newArtifact(reg, "my-wf/my-pod/my-dir/a.txt")))
+		require.NoError(t, driver.Delete(art))
+		objects, err := driver.ListObjects(art)
+		require.NoError(t, err)
+		assert.Empty(t, objects)
+	})
+}
@@ -0,0 +1,35 @@
+package resource
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type kubeResources struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+}
+
+// New returns the resources used by artifact drivers, such as the secrets of artifact repositories, which are read
+// from the namespace using the client
+func New(kubeClient kubernetes.Interface, namespace string) Interface {
+	return kubeResources{kubeClient, namespace}
+}
+
+func (r kubeResources) GetSecret(ctx context.Context, name, key string) (string, error) {
+	secret, err := r.kubeClient.CoreV1().Secrets(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data[key]), nil
+}
+
+func (r kubeResources) GetConfigMapKey(ctx context.Context, name, key string) (string, error) {
+	configMap, err := r.kubeClient.CoreV1().ConfigMaps(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return configMap.Data[key], nil
+}
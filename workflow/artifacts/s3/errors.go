@@ -1,10 +1,13 @@
 package s3
 
 import (
+	"errors"
+	"io"
+
 	argos3 "github.com/argoproj/pkg/s3"
 	log "github.com/sirupsen/logrus"
 
-	"github.com/argoproj/argo-workflows/v3/util/errors"
+	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 )
 
 // s3TransientErrorCodes is a list of S3 error codes that are transient (retryable)
@@ -32,5 +35,10 @@ func isTransientS3Err(err error) bool {
 			return true
 		}
 	}
-	return errors.IsTransientErr(err)
+	// a download that was cut off midway can be resumed
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		log.Errorf("Transient S3 error: %v", err)
+		return true
+	}
+	return errorsutil.IsTransientErr(err)
 }
@@ -2,6 +2,8 @@ package s3
 
 import (
 	"errors"
+	"fmt"
+	"io"
 	"testing"
 
 	"github.com/minio/minio-go/v7"
@@ -22,4 +24,7 @@ func TestIsTransientOSSErr(t *testing.T) {
 
 	requestErr := minio.ErrorResponse{Code: "RequestError"}
 	assert.True(t, isTransientS3Err(requestErr))
+
+	unexpectedEOFErr := fmt.Errorf("failed to get part: %w", io.ErrUnexpectedEOF)
+	assert.True(t, isTransientS3Err(unexpectedEOFErr))
 }
@@ -39,12 +39,38 @@ type ArtifactDriver struct {
 	KmsEncryptionContext  string
 	EnableEncryption      bool
 	ServerSideCustomerKey string
+	// PartSize enables multipart, parallel and resumable transfers when it is set
+	PartSize    uint64
+	Concurrency int
 }
 
-var _ artifactscommon.ArtifactDriver = &ArtifactDriver{}
+var (
+	_ artifactscommon.ArtifactDriver        = &ArtifactDriver{}
+	_ artifactscommon.ArtifactExister       = &ArtifactDriver{}
+	_ artifactscommon.ArtifactRangeStreamer = &ArtifactDriver{}
+)
 
 // newS3Client instantiates a new S3 client object.
 func (s3Driver *ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Client, error) {
+	opts := s3Driver.clientOpts()
+	s3cli, err := argos3.NewS3Client(ctx, opts)
+	if err != nil || s3Driver.PartSize == 0 {
+		return s3cli, err
+	}
+	return newTransferClient(ctx, s3cli, opts, s3Driver.PartSize, s3Driver.Concurrency)
+}
+
+// newRangeClient instantiates a client which can describe objects and read parts of them
+func (s3Driver *ArtifactDriver) newRangeClient(ctx context.Context) (*transferClient, error) {
+	opts := s3Driver.clientOpts()
+	s3cli, err := argos3.NewS3Client(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return newTransferClient(ctx, s3cli, opts, s3Driver.PartSize, s3Driver.Concurrency)
+}
+
+func (s3Driver *ArtifactDriver) clientOpts() argos3.S3ClientOpts {
 	opts := argos3.S3ClientOpts{
 		Endpoint:     s3Driver.Endpoint,
 		Region:       s3Driver.Region,
@@ -72,8 +98,7 @@ func (s3Driver *ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Clien
 		}
 		opts.Transport = tr
 	}
-
-	return argos3.NewS3Client(ctx, opts)
+	return opts
 }
 
 // Load downloads artifacts from S3 compliant storage
@@ -293,6 +318,40 @@ func listObjects(s3cli argos3.S3Client, artifact *wfv1.Artifact) (bool, []string
 	return true, files, nil
 }
 
+// Exists returns whether an object exists with the artifact's key
+func (s3Driver *ArtifactDriver) Exists(artifact *wfv1.Artifact) (bool, error) {
+	s3cli, err := s3Driver.newS3Client(context.TODO())
+	if err != nil {
+		return false, err
+	}
+	return s3cli.KeyExists(artifact.S3.Bucket, artifact.S3.Key)
+}
+
+// Stat describes the object with the artifact's key
+func (s3Driver *ArtifactDriver) Stat(artifact *wfv1.Artifact) (*artifactscommon.ArtifactStat, error) {
+	c, err := s3Driver.newRangeClient(context.TODO())
+	if err != nil {
+		return nil, err
+	}
+	info, err := c.Stat(artifact.S3.Bucket, artifact.S3.Key)
+	if argos3.IsS3ErrCode(err, "NoSuchKey") {
+		return nil, argoerrs.New(argoerrs.CodeNotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &artifactscommon.ArtifactStat{Size: info.Size, ETag: info.ETag, LastModified: info.LastModified}, nil
+}
+
+// OpenStreamFrom opens a stream reader of the object with the artifact's key from the offset
+func (s3Driver *ArtifactDriver) OpenStreamFrom(artifact *wfv1.Artifact, offset int64) (io.ReadCloser, error) {
+	c, err := s3Driver.newRangeClient(context.TODO())
+	if err != nil {
+		return nil, err
+	}
+	return c.OpenFileFrom(artifact.S3.Bucket, artifact.S3.Key, offset)
+}
+
 func (s3Driver *ArtifactDriver) IsDirectory(artifact *wfv1.Artifact) (bool, error) {
 	s3cli, err := s3Driver.newS3Client(context.TODO())
 	if err != nil {
@@ -0,0 +1,255 @@
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	argos3 "github.com/argoproj/pkg/s3"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// DefaultPartSize is the part size used when transferOptions are set without a partSize
+	DefaultPartSize = 16 * 1024 * 1024
+	// MinPartSize is the smallest part size S3 accepts for a multipart upload
+	MinPartSize = 5 * 1024 * 1024
+	// DefaultConcurrency is the concurrency used when transferOptions are set without a concurrency
+	DefaultConcurrency = 4
+)
+
+// transferClient overrides the transfers of an argos3.S3Client: files are uploaded in parallel parts,
+// the files of a directory are transferred in parallel, and files are downloaded part by part so that
+// a download which is interrupted resumes after the last completed part.
+type transferClient struct {
+	argos3.S3Client
+	ctx         context.Context
+	minioClient *minio.Client
+	encryptOpts argos3.EncryptOpts
+	partSize    uint64
+	concurrency int
+}
+
+func newTransferClient(ctx context.Context, s3cli argos3.S3Client, opts argos3.S3ClientOpts, partSize uint64, concurrency int) (*transferClient, error) {
+	credentials, err := argos3.GetCredentials(opts)
+	if err != nil {
+		return nil, err
+	}
+	minioClient, err := minio.New(opts.Endpoint, &minio.Options{
+		Creds:        credentials,
+		Secure:       opts.Secure,
+		Transport:    opts.Transport,
+		Region:       opts.Region,
+		BucketLookup: minio.BucketLookupAuto,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if opts.Trace {
+		minioClient.TraceOn(log.StandardLogger().Out)
+	}
+	return &transferClient{
+		S3Client:    s3cli,
+		ctx:         ctx,
+		minioClient: minioClient,
+		encryptOpts: opts.EncryptOpts,
+		partSize:    partSize,
+		concurrency: concurrency,
+	}, nil
+}
+
+// serverSideEncryption builds the same encryption options as argos3.S3Client, so that objects can be
+// read and written by either client
+func (c *transferClient) serverSideEncryption(bucket, key string) (encrypt.ServerSide, error) {
+	if !c.encryptOpts.Enabled {
+		return nil, nil
+	}
+	if c.encryptOpts.ServerSideCustomerKey != "" {
+		return encrypt.DefaultPBKDF([]byte(c.encryptOpts.ServerSideCustomerKey), []byte(bucket+key)), nil
+	}
+	if c.encryptOpts.KmsKeyId != "" {
+		if c.encryptOpts.KmsEncryptionContext == "" {
+			return encrypt.NewSSEKMS(c.encryptOpts.KmsKeyId, nil)
+		}
+		encryptionContext, err := json.Marshal(json.RawMessage(c.encryptOpts.KmsEncryptionContext))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal KMS encryption context: %w", err)
+		}
+		parsed := base64.StdEncoding.EncodeToString(encryptionContext)
+		return encrypt.NewSSEKMS(c.encryptOpts.KmsKeyId, &parsed)
+	}
+	return encrypt.NewSSE(), nil
+}
+
+// PutFile uploads a file, in parts of partSize of which concurrency are uploaded in parallel
+func (c *transferClient) PutFile(bucket, key, path string) error {
+	log.WithFields(log.Fields{"bucket": bucket, "key": key, "path": path, "partSize": c.partSize}).Info("Saving file to s3 in parts")
+	sse, err := c.serverSideEncryption(bucket, key)
+	if err != nil {
+		return err
+	}
+	_, err = c.minioClient.FPutObject(c.ctx, bucket, key, path, minio.PutObjectOptions{
+		ServerSideEncryption: sse,
+		PartSize:             c.partSize,
+		NumThreads:           uint(c.concurrency),
+	})
+	return err
+}
+
+// PutDirectory uploads the files of a directory, concurrency of them in parallel
+func (c *transferClient) PutDirectory(bucket, key, dirPath string) error {
+	g, ctx := errgroup.WithContext(c.ctx)
+	g.SetLimit(c.concurrency)
+	err := filepath.WalkDir(dirPath, func(localPath string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return filepath.SkipAll
+		}
+		relPath, err := filepath.Rel(dirPath, localPath)
+		if err != nil {
+			return err
+		}
+		fileKey := path.Join(key, filepath.ToSlash(relPath))
+		g.Go(func() error { return c.PutFile(bucket, fileKey, localPath) })
+		return nil
+	})
+	if waitErr := g.Wait(); waitErr != nil {
+		return waitErr
+	}
+	return err
+}
+
+// GetFile downloads a file part by part. Completed parts are kept in a part file next to path, named
+// after the object's ETag, so a later call resumes after the last completed part unless the object
+// has changed in the meantime.
+func (c *transferClient) GetFile(bucket, key, path string) error {
+	log.WithFields(log.Fields{"bucket": bucket, "key": key, "path": path, "partSize": c.partSize}).Info("Getting file from s3 in parts")
+	sse, err := c.serverSideEncryption(bucket, key)
+	if err != nil {
+		return err
+	}
+	info, err := c.minioClient.StatObject(c.ctx, bucket, key, minio.StatObjectOptions{ServerSideEncryption: sse})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	partPath := fmt.Sprintf("%s.%x.part", path, sha256.Sum256([]byte(info.ETag)))
+	f, err := os.OpenFile(partPath, os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	st, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	// a part that was only partially written is downloaded again
+	partSize := int64(c.partSize)
+	offset := st.Size() - st.Size()%partSize
+	if offset > 0 {
+		log.WithFields(log.Fields{"key": key, "offset": offset, "size": info.Size}).Info("Resuming download from s3")
+	}
+	if err := f.Truncate(offset); err != nil {
+		return err
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	for ; offset < info.Size; offset += partSize {
+		end := min(offset+partSize, info.Size) - 1
+		if err := c.getPart(f, bucket, key, info.ETag, sse, offset, end); err != nil {
+			if argos3.IsS3ErrCode(err, "PreconditionFailed") {
+				// the object changed since the download started, the completed parts are stale
+				_ = f.Close()
+				_ = os.Remove(partPath)
+			}
+			log.WithFields(log.Fields{"key": key, "offset": offset, "size": info.Size}).WithError(err).Warn("Failed to get part from s3")
+			return err
+		}
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(partPath, path)
+}
+
+func (c *transferClient) getPart(w io.Writer, bucket, key, etag string, sse encrypt.ServerSide, start, end int64) error {
+	opts := minio.GetObjectOptions{ServerSideEncryption: sse}
+	if err := opts.SetMatchETag(etag); err != nil {
+		return err
+	}
+	if err := opts.SetRange(start, end); err != nil {
+		return err
+	}
+	obj, err := c.minioClient.GetObject(c.ctx, bucket, key, opts)
+	if err != nil {
+		return err
+	}
+	defer obj.Close()
+	n, err := io.Copy(w, obj)
+	if err != nil {
+		return err
+	}
+	if n != end-start+1 {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// Stat describes an object
+func (c *transferClient) Stat(bucket, key string) (minio.ObjectInfo, error) {
+	sse, err := c.serverSideEncryption(bucket, key)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+	return c.minioClient.StatObject(c.ctx, bucket, key, minio.StatObjectOptions{ServerSideEncryption: sse})
+}
+
+// OpenFileFrom opens a file from the offset to its end
+func (c *transferClient) OpenFileFrom(bucket, key string, offset int64) (io.ReadCloser, error) {
+	sse, err := c.serverSideEncryption(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	opts := minio.GetObjectOptions{ServerSideEncryption: sse}
+	// a range starting at zero must have an end
+	if offset > 0 {
+		if err := opts.SetRange(offset, 0); err != nil {
+			return nil, err
+		}
+	}
+	return c.minioClient.GetObject(c.ctx, bucket, key, opts)
+}
+
+// GetDirectory downloads the files of a directory, concurrency of them in parallel
+func (c *transferClient) GetDirectory(bucket, keyPrefix, path string) error {
+	log.WithFields(log.Fields{"bucket": bucket, "key": keyPrefix, "path": path}).Info("Getting directory from s3")
+	keys, err := c.ListDirectory(bucket, keyPrefix)
+	if err != nil {
+		return err
+	}
+	g := errgroup.Group{}
+	g.SetLimit(c.concurrency)
+	for _, objKey := range keys {
+		localPath := filepath.Join(path, strings.TrimPrefix(objKey, keyPrefix))
+		g.Go(func() error { return c.GetFile(bucket, objKey, localPath) })
+	}
+	return g.Wait()
+}
@@ -0,0 +1,259 @@
+package s3
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	argos3 "github.com/argoproj/pkg/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// fakeS3Server implements the parts of the S3 API used by transferClient
+type fakeS3Server struct {
+	endpoint string
+	mu       sync.Mutex
+	objects  map[string][]byte
+	uploads  map[string]map[int][]byte
+	// ranges are the Range headers of the object requests that were served
+	ranges []string
+	// truncate lists ranges whose response is cut off midway the next time they are requested
+	truncate map[string]bool
+}
+
+func newFakeS3Server(t *testing.T) (*fakeS3Server, *transferClient) {
+	s := &fakeS3Server{objects: map[string][]byte{}, uploads: map[string]map[int][]byte{}, truncate: map[string]bool{}}
+	server := httptest.NewServer(s)
+	t.Cleanup(server.Close)
+	s.endpoint = strings.TrimPrefix(server.URL, "http://")
+	opts := argos3.S3ClientOpts{
+		Endpoint:  s.endpoint,
+		Region:    "us-east-1",
+		AccessKey: "access-key",
+		SecretKey: "secret-key",
+	}
+	c, err := newTransferClient(context.Background(), nil, opts, MinPartSize, 2)
+	require.NoError(t, err)
+	return s, c
+}
+
+func etag(data []byte) string {
+	return fmt.Sprintf("%x", md5.Sum(data))
+}
+
+func (s *fakeS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := strings.TrimPrefix(r.URL.Path, "/")
+	query := r.URL.Query()
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		uploadID := strconv.Itoa(len(s.uploads) + 1)
+		s.uploads[uploadID] = map[int][]byte{}
+		_, _ = fmt.Fprintf(w, "<InitiateMultipartUploadResult><UploadId>%s</UploadId></InitiateMultipartUploadResult>", uploadID)
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		partNumber, _ := strconv.Atoi(query.Get("partNumber"))
+		data := readBody(r)
+		s.uploads[query.Get("uploadId")][partNumber] = data
+		w.Header().Set("ETag", `"`+etag(data)+`"`)
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		parts := s.uploads[query.Get("uploadId")]
+		var data []byte
+		for i := 1; i <= len(parts); i++ {
+			data = append(data, parts[i]...)
+		}
+		s.objects[name] = data
+		bucket, key, _ := strings.Cut(name, "/")
+		_, _ = fmt.Fprintf(w, "<CompleteMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><ETag>&quot;%s&quot;</ETag></CompleteMultipartUploadResult>", bucket, key, etag(data))
+	case r.Method == http.MethodPut:
+		s.objects[name] = readBody(r)
+		w.Header().Set("ETag", `"`+etag(s.objects[name])+`"`)
+	case r.Method == http.MethodHead || r.Method == http.MethodGet:
+		data, ok := s.objects[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("ETag", `"`+etag(data)+`"`)
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		if r.Method == http.MethodHead {
+			w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+			return
+		}
+		if match := r.Header.Get("If-Match"); match != "" && strings.Trim(match, `"`) != etag(data) {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			_, _ = fmt.Fprint(w, "<Error><Code>PreconditionFailed</Code></Error>")
+			return
+		}
+		rangeHeader := r.Header.Get("Range")
+		s.ranges = append(s.ranges, rangeHeader)
+		var start, end int
+		_, _ = fmt.Sscanf(rangeHeader, "bytes=%d-%d", &start, &end)
+		part := data[start : end+1]
+		w.Header().Set("Content-Length", strconv.Itoa(len(part)))
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+		w.WriteHeader(http.StatusPartialContent)
+		if s.truncate[rangeHeader] {
+			delete(s.truncate, rangeHeader)
+			part = part[:len(part)/2]
+		}
+		_, _ = w.Write(part)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+// readBody reads a request body, decoding it if it was sent with chunked signatures
+func readBody(r *http.Request) []byte {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		data, _ := io.ReadAll(r.Body)
+		return data
+	}
+	var data []byte
+	reader := bufio.NewReader(r.Body)
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return data
+		}
+		size, err := strconv.ParseInt(strings.SplitN(strings.TrimSpace(header), ";", 2)[0], 16, 64)
+		if err != nil || size == 0 {
+			return data
+		}
+		chunk := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, chunk); err != nil {
+			return data
+		}
+		data = append(data, chunk[:size]...)
+	}
+}
+
+func randomData(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i * 7 % 251)
+	}
+	return data
+}
+
+func TestTransferClientPutFile(t *testing.T) {
+	s, c := newFakeS3Server(t)
+	data := randomData(2*MinPartSize + 1024)
+	path := filepath.Join(t.TempDir(), "large")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	require.NoError(t, c.PutFile("bucket", "large", path))
+
+	assert.Len(t, s.uploads["1"], 3)
+	assert.True(t, bytes.Equal(data, s.objects["bucket/large"]))
+}
+
+func TestTransferClientPutDirectory(t *testing.T) {
+	s, c := newFakeS3Server(t)
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0o755))
+	for _, name := range []string{"a.txt", "b.txt", "sub/c.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name), 0o600))
+	}
+
+	require.NoError(t, c.PutDirectory("bucket", "dir", dir))
+
+	var keys []string
+	for key := range s.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	assert.Equal(t, []string{"bucket/dir/a.txt", "bucket/dir/b.txt", "bucket/dir/sub/c.txt"}, keys)
+	assert.Equal(t, "sub/c.txt", string(s.objects["bucket/dir/sub/c.txt"]))
+}
+
+func TestTransferClientGetFile(t *testing.T) {
+	t.Run("Resume", func(t *testing.T) {
+		s, c := newFakeS3Server(t)
+		data := randomData(2*MinPartSize + 1024)
+		s.objects["bucket/large"] = data
+		s.truncate[fmt.Sprintf("bytes=%d-%d", MinPartSize, 2*MinPartSize-1)] = true
+		path := filepath.Join(t.TempDir(), "large")
+
+		err := c.GetFile("bucket", "large", path)
+		require.Error(t, err)
+		assert.True(t, isTransientS3Err(err))
+		assert.NoFileExists(t, path)
+
+		require.NoError(t, c.GetFile("bucket", "large", path))
+		got, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(data, got))
+		assert.Equal(t, []string{
+			fmt.Sprintf("bytes=0-%d", MinPartSize-1),
+			fmt.Sprintf("bytes=%d-%d", MinPartSize, 2*MinPartSize-1),
+			// the first part is not downloaded again
+			fmt.Sprintf("bytes=%d-%d", MinPartSize, 2*MinPartSize-1),
+			fmt.Sprintf("bytes=%d-%d", 2*MinPartSize, len(data)-1),
+		}, s.ranges)
+		files, err := os.ReadDir(filepath.Dir(path))
+		require.NoError(t, err)
+		assert.Len(t, files, 1)
+	})
+	t.Run("ObjectChanged", func(t *testing.T) {
+		s, c := newFakeS3Server(t)
+		s.objects["bucket/large"] = randomData(2 * MinPartSize)
+		s.truncate[fmt.Sprintf("bytes=%d-%d", MinPartSize, 2*MinPartSize-1)] = true
+		path := filepath.Join(t.TempDir(), "large")
+		require.Error(t, c.GetFile("bucket", "large", path))
+
+		data := []byte("changed")
+		s.objects["bucket/large"] = data
+		require.NoError(t, c.GetFile("bucket", "large", path))
+		got, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, data, got)
+	})
+	t.Run("NoSuchKey", func(t *testing.T) {
+		_, c := newFakeS3Server(t)
+		err := c.GetFile("bucket", "missing", filepath.Join(t.TempDir(), "missing"))
+		assert.True(t, argos3.IsS3ErrCode(err, "NoSuchKey"))
+	})
+}
+
+func TestLoadS3ArtifactInParts(t *testing.T) {
+	s, _ := newFakeS3Server(t)
+	data := randomData(2*MinPartSize + 1024)
+	s.objects["bucket/large"] = data
+	s.truncate[fmt.Sprintf("bytes=%d-%d", MinPartSize, 2*MinPartSize-1)] = true
+	driver := &ArtifactDriver{
+		Endpoint:    s.endpoint,
+		Region:      "us-east-1",
+		AccessKey:   "access-key",
+		SecretKey:   "secret-key",
+		PartSize:    MinPartSize,
+		Concurrency: 2,
+	}
+	path := filepath.Join(t.TempDir(), "large")
+
+	err := driver.Load(&wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{
+		S3Bucket: wfv1.S3Bucket{Bucket: "bucket"},
+		Key:      "large",
+	}}}, path)
+	require.NoError(t, err)
+
+	got, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(data, got))
+	assert.Len(t, s.ranges, 4)
+}
@@ -0,0 +1,352 @@
+package sftp
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/argoproj/pkg/file"
+	"github.com/pkg/sftp"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	argoerrors "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// ArtifactDriver is a driver for SFTP servers
+type ArtifactDriver struct {
+	Username              string
+	Password              string
+	SSHPrivateKey         string
+	KnownHosts            string
+	InsecureIgnoreHostKey bool
+}
+
+var _ artifactscommon.ArtifactDriver = &ArtifactDriver{}
+
+// client is an SFTP client along with the SSH connection it runs over
+type client struct {
+	*sftp.Client
+	conn *ssh.Client
+}
+
+func (c *client) Close() error {
+	return errors.Join(c.Client.Close(), c.conn.Close())
+}
+
+func (d *ArtifactDriver) hostKeyCallback() (ssh.HostKeyCallback, error) {
+	if d.InsecureIgnoreHostKey {
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+	if d.KnownHosts == "" {
+		return nil, fmt.Errorf("knownHostsSecret is required to verify the host key of the SFTP server, unless insecureIgnoreHostKey is set")
+	}
+	// knownhosts only reads from files
+	f, err := os.CreateTemp("", "known_hosts")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+	_, err = f.WriteString(d.KnownHosts)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return knownhosts.New(f.Name())
+}
+
+func (d *ArtifactDriver) newClient(a *wfv1.SFTPArtifact) (*client, error) {
+	var auth []ssh.AuthMethod
+	if d.SSHPrivateKey != "" {
+		signer, err := ssh.ParsePrivateKey([]byte(d.SSHPrivateKey))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse ssh private key: %w", err)
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if d.Password != "" {
+		auth = append(auth, ssh.Password(d.Password))
+	}
+	hostKeyCallback, err := d.hostKeyCallback()
+	if err != nil {
+		return nil, err
+	}
+	addr := a.Host
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, "22")
+	}
+	conn, err := ssh.Dial("tcp", addr, &ssh.ClientConfig{
+		User:            d.Username,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to %s: %w", addr, err)
+	}
+	c, err := sftp.NewClient(conn)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("unable to start SFTP session with %s: %w", addr, err)
+	}
+	return &client{Client: c, conn: conn}, nil
+}
+
+func closeClient(c io.Closer) {
+	if err := c.Close(); err != nil {
+		log.Warnf("unable to close SFTP client: %s", err)
+	}
+}
+
+func isNotExist(err error) bool {
+	return errors.Is(err, os.ErrNotExist)
+}
+
+// Load downloads artifacts from an SFTP server
+func (d *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
+	a := inputArtifact.SFTP
+	log.WithFields(log.Fields{"host": a.Host, "path": a.Path}).Info("Downloading from SFTP")
+	c, err := d.newClient(a)
+	if err != nil {
+		return err
+	}
+	defer closeClient(c)
+
+	fi, err := c.Stat(a.Path)
+	if isNotExist(err) {
+		return argoerrors.New(argoerrors.CodeNotFound, fmt.Sprintf("%s does not exist on %s", a.Path, a.Host))
+	} else if err != nil {
+		return fmt.Errorf("unable to stat %s: %w", a.Path, err)
+	}
+	if !fi.IsDir() {
+		return downloadFile(c, a.Path, path)
+	}
+	walker := c.Walk(a.Path)
+	for walker.Step() {
+		if err := walker.Err(); err != nil {
+			return fmt.Errorf("unable to walk %s: %w", walker.Path(), err)
+		}
+		rel := strings.TrimPrefix(strings.TrimPrefix(walker.Path(), a.Path), "/")
+		localPath := filepath.Join(path, filepath.FromSlash(rel))
+		if walker.Stat().IsDir() {
+			if err := os.MkdirAll(localPath, 0o755); err != nil {
+				return fmt.Errorf("unable to create local directory %s: %w", localPath, err)
+			}
+			continue
+		}
+		if err := downloadFile(c, walker.Path(), localPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func downloadFile(c *client, remotePath, localPath string) error {
+	if err := os.MkdirAll(filepath.Dir(localPath), 0o755); err != nil {
+		return fmt.Errorf("unable to create dir for file %s: %w", localPath, err)
+	}
+	src, err := c.Open(remotePath)
+	if err != nil {
+		return fmt.Errorf("unable to open %s: %w", remotePath, err)
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.Warnf("unable to close %s: %s", remotePath, err)
+		}
+	}()
+	dst, err := os.Create(localPath)
+	if err != nil {
+		return fmt.Errorf("unable to create file %s: %w", localPath, err)
+	}
+	_, err = src.WriteTo(dst)
+	if closeErr := dst.Close(); err == nil && closeErr != nil {
+		return fmt.Errorf("unable to close file %s: %w", localPath, closeErr)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to download %s: %w", remotePath, err)
+	}
+	return nil
+}
+
+// stream is a remote file, which closes the client it was opened with when it is closed
+type stream struct {
+	*sftp.File
+	client *client
+}
+
+func (s *stream) Close() error {
+	return errors.Join(s.File.Close(), s.client.Close())
+}
+
+// OpenStream opens a stream reader for an artifact on an SFTP server
+func (d *ArtifactDriver) OpenStream(inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
+	a := inputArtifact.SFTP
+	log.WithFields(log.Fields{"host": a.Host, "path": a.Path}).Info("Streaming from SFTP")
+	c, err := d.newClient(a)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := c.Stat(a.Path)
+	if err != nil {
+		closeClient(c)
+		if isNotExist(err) {
+			return nil, argoerrors.New(argoerrors.CodeNotFound, fmt.Sprintf("%s does not exist on %s", a.Path, a.Host))
+		}
+		return nil, fmt.Errorf("unable to stat %s: %w", a.Path, err)
+	}
+	if fi.IsDir() {
+		closeClient(c)
+		return nil, argoerrors.New(argoerrors.CodeNotImplemented, "Directory Stream capability currently unimplemented for SFTP")
+	}
+	f, err := c.Open(a.Path)
+	if err != nil {
+		closeClient(c)
+		return nil, fmt.Errorf("unable to open %s: %w", a.Path, err)
+	}
+	return &stream{File: f, client: c}, nil
+}
+
+// Save uploads an artifact to an SFTP server
+func (d *ArtifactDriver) Save(localPath string, outputArtifact *wfv1.Artifact) error {
+	a := outputArtifact.SFTP
+	log.WithFields(log.Fields{"host": a.Host, "path": a.Path}).Info("Uploading to SFTP")
+	isDir, err := file.IsDirectory(localPath)
+	if err != nil {
+		return fmt.Errorf("failed to test if %s is a directory: %w", localPath, err)
+	}
+	c, err := d.newClient(a)
+	if err != nil {
+		return err
+	}
+	defer closeClient(c)
+
+	if !isDir {
+		return uploadFile(c, localPath, a.Path)
+	}
+	root := filepath.Clean(localPath)
+	return filepath.Walk(root, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		remotePath := path.Join(a.Path, filepath.ToSlash(rel))
+		if fi.IsDir() {
+			if err := c.MkdirAll(remotePath); err != nil {
+				return fmt.Errorf("unable to create directory %s: %w", remotePath, err)
+			}
+			return nil
+		}
+		if fi.Mode()&os.ModeSymlink != 0 {
+			return nil
+		}
+		return uploadFile(c, p, remotePath)
+	})
+}
+
+func uploadFile(c *client, localPath, remotePath string) error {
+	if err := c.MkdirAll(path.Dir(remotePath)); err != nil {
+		return fmt.Errorf("unable to create directory for %s: %w", remotePath, err)
+	}
+	src, err := os.Open(localPath)
+	if err != nil {
+		return fmt.Errorf("unable to open file %s: %w", localPath, err)
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.Warnf("unable to close file %s: %s", localPath, err)
+		}
+	}()
+	dst, err := c.Create(remotePath)
+	if err != nil {
+		return fmt.Errorf("unable to create %s: %w", remotePath, err)
+	}
+	_, err = dst.ReadFrom(src)
+	// the upload is only complete once the remote file is closed
+	if closeErr := dst.Close(); err == nil && closeErr != nil {
+		return fmt.Errorf("unable to close %s: %w", remotePath, closeErr)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to upload %s: %w", localPath, err)
+	}
+	return nil
+}
+
+// Delete deletes an artifact from an SFTP server
+func (d *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	a := artifact.SFTP
+	log.WithFields(log.Fields{"host": a.Host, "path": a.Path}).Info("Deleting from SFTP")
+	c, err := d.newClient(a)
+	if err != nil {
+		return err
+	}
+	defer closeClient(c)
+
+	fi, err := c.Stat(a.Path)
+	if isNotExist(err) {
+		log.Debugf("file to delete '%s' does not exist", a.Path)
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("unable to stat %s: %w", a.Path, err)
+	}
+	if fi.IsDir() {
+		return c.RemoveAll(a.Path)
+	}
+	return c.Remove(a.Path)
+}
+
+// ListObjects lists the files of an artifact on an SFTP server
+func (d *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	a := artifact.SFTP
+	c, err := d.newClient(a)
+	if err != nil {
+		return nil, err
+	}
+	defer closeClient(c)
+
+	var files []string
+	walker := c.Walk(a.Path)
+	for walker.Step() {
+		if err := walker.Err(); err != nil {
+			if isNotExist(err) && walker.Path() == a.Path {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("unable to walk %s: %w", walker.Path(), err)
+		}
+		if !walker.Stat().IsDir() {
+			files = append(files, walker.Path())
+		}
+	}
+	return files, nil
+}
+
+// IsDirectory indicates whether or not the artifact represents a directory or a single file.
+func (d *ArtifactDriver) IsDirectory(artifact *wfv1.Artifact) (bool, error) {
+	a := artifact.SFTP
+	c, err := d.newClient(a)
+	if err != nil {
+		return false, err
+	}
+	defer closeClient(c)
+
+	fi, err := c.Stat(a.Path)
+	if isNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("unable to stat %s: %w", a.Path, err)
+	}
+	return fi.IsDir(), nil
+}
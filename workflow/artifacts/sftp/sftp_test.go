@@ -0,0 +1,274 @@
+package sftp
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/sftp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	argoerrors "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type server struct {
+	addr       string
+	hostKey    ssh.PublicKey
+	privateKey string
+}
+
+// newServer starts an SFTP server backed by an in-memory file system, which accepts the password "my-password"
+// or the returned private key. The file system's handlers may be replaced.
+func newServer(t *testing.T, withHandlers ...func(handlers *sftp.Handlers)) *server {
+	_, hostPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostSigner, err := ssh.NewSignerFromKey(hostPriv)
+	require.NoError(t, err)
+	clientPub, clientPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	authorizedKey, err := ssh.NewPublicKey(clientPub)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(clientPriv, "")
+	require.NoError(t, err)
+
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(_ ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if string(password) == "my-password" {
+				return nil, nil
+			}
+			return nil, assert.AnError
+		},
+		PublicKeyCallback: func(_ ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if string(key.Marshal()) == string(authorizedKey.Marshal()) {
+				return nil, nil
+			}
+			return nil, assert.AnError
+		},
+	}
+	config.AddHostKey(hostSigner)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+	handlers := sftp.InMemHandler()
+	for _, f := range withHandlers {
+		f(&handlers)
+	}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go serve(conn, config, handlers)
+		}
+	}()
+	return &server{addr: l.Addr().String(), hostKey: hostSigner.PublicKey(), privateKey: string(pem.EncodeToMemory(block))}
+}
+
+func serve(conn net.Conn, config *ssh.ServerConfig, handlers sftp.Handlers) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	for newChannel := range chans {
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			for req := range requests {
+				_ = req.Reply(req.Type == "subsystem" && string(req.Payload[4:]) == "sftp", nil)
+			}
+		}()
+		server := sftp.NewRequestServer(channel, handlers)
+		go func() {
+			_ = server.Serve()
+			_ = server.Close()
+		}()
+	}
+}
+
+func (s *server) knownHosts() string {
+	return knownhosts.Line([]string{s.addr}, s.hostKey)
+}
+
+func newArtifact(s *server, path string) *wfv1.Artifact {
+	return &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{SFTP: &wfv1.SFTPArtifact{
+		SFTPServer: wfv1.SFTPServer{Host: s.addr},
+		Path:       path,
+	}}}
+}
+
+func TestArtifactDriver_Auth(t *testing.T) {
+	s := newServer(t)
+	art := newArtifact(s, "/missing")
+	t.Run("Password", func(t *testing.T) {
+		driver := &ArtifactDriver{Username: "my-user", Password: "my-password", KnownHosts: s.knownHosts()}
+		_, err := driver.IsDirectory(art)
+		require.NoError(t, err)
+	})
+	t.Run("WrongPassword", func(t *testing.T) {
+		driver := &ArtifactDriver{Username: "my-user", Password: "wrong", KnownHosts: s.knownHosts()}
+		_, err := driver.IsDirectory(art)
+		require.ErrorContains(t, err, "unable to authenticate")
+	})
+	t.Run("PrivateKey", func(t *testing.T) {
+		driver := &ArtifactDriver{Username: "my-user", SSHPrivateKey: s.privateKey, KnownHosts: s.knownHosts()}
+		_, err := driver.IsDirectory(art)
+		require.NoError(t, err)
+	})
+	t.Run("NoKnownHosts", func(t *testing.T) {
+		driver := &ArtifactDriver{Username: "my-user", Password: "my-password"}
+		_, err := driver.IsDirectory(art)
+		require.ErrorContains(t, err, "knownHostsSecret is required")
+	})
+	t.Run("UnknownHost", func(t *testing.T) {
+		other := newServer(t)
+		driver := &ArtifactDriver{Username: "my-user", Password: "my-password", KnownHosts: other.knownHosts()}
+		_, err := driver.IsDirectory(art)
+		require.ErrorContains(t, err, "key is unknown")
+	})
+	t.Run("HostKeyMismatch", func(t *testing.T) {
+		other := newServer(t)
+		driver := &ArtifactDriver{Username: "my-user", Password: "my-password", KnownHosts: knownhosts.Line([]string{s.addr}, other.hostKey)}
+		_, err := driver.IsDirectory(art)
+		require.ErrorContains(t, err, "key mismatch")
+	})
+	t.Run("InsecureIgnoreHostKey", func(t *testing.T) {
+		driver := &ArtifactDriver{Username: "my-user", Password: "my-password", InsecureIgnoreHostKey: true}
+		_, err := driver.IsDirectory(art)
+		require.NoError(t, err)
+	})
+}
+
+func TestArtifactDriver_File(t *testing.T) {
+	s := newServer(t)
+	driver := &ArtifactDriver{Username: "my-user", Password: "my-password", KnownHosts: s.knownHosts()}
+	tmp := t.TempDir()
+	src := filepath.Join(tmp, "main.log")
+	require.NoError(t, os.WriteFile(src, []byte("my-content"), 0o600))
+	art := newArtifact(s, "/my-wf/main.log")
+
+	require.NoError(t, driver.Save(src, art))
+
+	t.Run("Load", func(t *testing.T) {
+		dst := filepath.Join(tmp, "loaded.log")
+		require.NoError(t, driver.Load(art, dst))
+		data, err := os.ReadFile(dst)
+		require.NoError(t, err)
+		assert.Equal(t, "my-content", string(data))
+	})
+	t.Run("OpenStream", func(t *testing.T) {
+		rc, err := driver.OpenStream(art)
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		assert.Equal(t, "my-content", string(data))
+	})
+	t.Run("IsDirectory", func(t *testing.T) {
+		isDir, err := driver.IsDirectory(art)
+		require.NoError(t, err)
+		assert.False(t, isDir)
+	})
+	t.Run("ListObjects", func(t *testing.T) {
+		files, err := driver.ListObjects(art)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/my-wf/main.log"}, files)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, driver.Delete(art))
+		err := driver.Load(art, filepath.Join(tmp, "deleted.log"))
+		assert.True(t, argoerrors.IsCode(argoerrors.CodeNotFound, err))
+		require.NoError(t, driver.Delete(art), "deleting a missing artifact is not an error")
+	})
+}
+
+// failingCloseWriter is a remote file that fails to be closed, e.g. because the server could not flush it
+type failingCloseWriter struct {
+	io.WriterAt
+}
+
+func (failingCloseWriter) Close() error {
+	return assert.AnError
+}
+
+func TestArtifactDriver_SaveCloseError(t *testing.T) {
+	s := newServer(t, func(handlers *sftp.Handlers) {
+		put := handlers.FilePut
+		handlers.FilePut = putFunc(func(r *sftp.Request) (io.WriterAt, error) {
+			w, err := put.Filewrite(r)
+			return failingCloseWriter{w}, err
+		})
+	})
+	driver := &ArtifactDriver{Username: "my-user", Password: "my-password", KnownHosts: s.knownHosts()}
+	src := filepath.Join(t.TempDir(), "main.log")
+	require.NoError(t, os.WriteFile(src, []byte("my-content"), 0o600))
+
+	err := driver.Save(src, newArtifact(s, "/my-wf/main.log"))
+	require.ErrorContains(t, err, "unable to close /my-wf/main.log")
+}
+
+type putFunc func(r *sftp.Request) (io.WriterAt, error)
+
+func (f putFunc) Filewrite(r *sftp.Request) (io.WriterAt, error) {
+	return f(r)
+}
+
+func TestArtifactDriver_Directory(t *testing.T) {
+	s := newServer(t)
+	driver := &ArtifactDriver{Username: "my-user", Password: "my-password", KnownHosts: s.knownHosts()}
+	tmp := t.TempDir()
+	src := filepath.Join(tmp, "src")
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "a.txt"), []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "sub", "b.txt"), []byte("b"), 0o600))
+	art := newArtifact(s, "/my-wf/my-dir")
+
+	require.NoError(t, driver.Save(src, art))
+
+	t.Run("Load", func(t *testing.T) {
+		dst := filepath.Join(tmp, "dst")
+		require.NoError(t, driver.Load(art, dst))
+		data, err := os.ReadFile(filepath.Join(dst, "a.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "a", string(data))
+		data, err = os.ReadFile(filepath.Join(dst, "sub", "b.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "b", string(data))
+	})
+	t.Run("OpenStream", func(t *testing.T) {
+		_, err := driver.OpenStream(art)
+		assert.True(t, argoerrors.IsCode(argoerrors.CodeNotImplemented, err))
+	})
+	t.Run("IsDirectory", func(t *testing.T) {
+		isDir, err := driver.IsDirectory(art)
+		require.NoError(t, err)
+		assert.True(t, isDir)
+	})
+	t.Run("ListObjects", func(t *testing.T) {
+		files, err := driver.ListObjects(art)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"/my-wf/my-dir/a.txt", "/my-wf/my-dir/sub/b.txt"}, files)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, driver.Delete(art))
+		isDir, err := driver.IsDirectory(art)
+		require.NoError(t, err)
+		assert.False(t, isDir)
+		files, err := driver.ListObjects(art)
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+}
@@ -53,6 +53,10 @@ const (
 	// the strategy whose artifacts are being deleted
 	AnnotationKeyArtifactGCStrategy = workflow.WorkflowFullName + "/artifact-gc-strategy"
 
+	// AnnotationKeyArtifactRetention is set on a completed workflow to record the output artifacts which are no longer
+	// retained by the retention policy of its artifact repository, until the controller starts up the pods to delete them
+	AnnotationKeyArtifactRetention = workflow.WorkflowFullName + "/artifact-retention"
+
 	// LabelKeyControllerInstanceID is the label the controller will carry forward to workflows/pod labels
 	// for the purposes of workflow segregation
 	LabelKeyControllerInstanceID = workflow.WorkflowFullName + "/controller-instanceid"
@@ -108,6 +112,9 @@ const (
 	// as well as artifact collection by the wait container.
 	ExecutorMainFilesystemDir = "/mainctrfs"
 
+	// ArtifactStreamPort is the port the wait container serves streamed artifacts on
+	ArtifactStreamPort = 2747
+
 	// ExecutorStagingEmptyDir is the path of the emptydir which is used as a staging area to transfer a file between init/main container for script/resource templates
 	ExecutorStagingEmptyDir = "/argo/staging"
 	// ExecutorScriptSourcePath is the path which init will write the script source file to for script templates
@@ -123,6 +130,12 @@ const (
 	EnvVarPodName = "ARGO_POD_NAME"
 	// EnvVarPodUID is the workflow's UID
 	EnvVarPodUID = "ARGO_POD_UID"
+	// EnvVarPodIP is the IP of the pod, which streamed artifacts are served from
+	EnvVarPodIP = "ARGO_POD_IP"
+	// EnvVarArtifactStreamTimeout is how long the wait container serves streamed artifacts for
+	EnvVarArtifactStreamTimeout = "ARGO_ARTIFACT_STREAM_TIMEOUT"
+	// EnvVarArtifactStreamToken is the bearer token streamed artifacts are served with
+	EnvVarArtifactStreamToken = "ARGO_ARTIFACT_STREAM_TOKEN"
 	// EnvVarInstanceID is the instance ID
 	EnvVarInstanceID = "ARGO_INSTANCE_ID"
 	// EnvVarWorkflowName is the name of the workflow for which the an agent is responsible for
@@ -218,6 +218,9 @@ func ProcessArgs(tmpl *wfv1.Template, args wfv1.ArgumentsProvider, globalParams,
 			artifacts[i].Path = inArt.Path
 			artifacts[i].Mode = inArt.Mode
 			artifacts[i].RecurseMode = inArt.RecurseMode
+			if artifacts[i].Encryption == nil {
+				artifacts[i].Encryption = inArt.Encryption
+			}
 		}
 	}
 
@@ -249,6 +249,25 @@ func TestOverridableDefaultInputArts(t *testing.T) {
 	assert.Equal(t, newTmpl.Inputs.Artifacts[0].Raw.Data, inputRawArt.Data)
 }
 
+func TestInputArtEncryption(t *testing.T) {
+	encryption := &wfv1.ArtifactEncryption{KeySecret: &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "my-artifact-key"},
+		Key:                  "key",
+	}}
+	tmpl := wfv1.Template{
+		Name:   "encrypted-input",
+		Inputs: wfv1.Inputs{Artifacts: []wfv1.Artifact{{Name: "art", Path: "/tmp/art", Encryption: encryption}}},
+	}
+	inputs := wfv1.Inputs{Artifacts: []wfv1.Artifact{{
+		Name:             "art",
+		ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "art.tgz"}},
+	}}}
+
+	newTmpl, err := ProcessArgs(&tmpl, &inputs, map[string]string{}, map[string]string{}, false, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, encryption, newTmpl.Inputs.Artifacts[0].Encryption)
+}
+
 type mockConfigMapStore struct {
 	getByKey func(key string) (interface{}, bool, error)
 }
@@ -69,6 +69,14 @@ func (woc *wfOperationCtx) garbageCollectArtifacts(ctx context.Context) error {
 		}
 	}
 
+	if err := woc.processArtifactRetention(ctx); err != nil {
+		return err
+	}
+
+	if woc.wf.DeletionTimestamp != nil {
+		woc.deleteCompactedNodes(ctx)
+	}
+
 	err := woc.processArtifactGCCompletion(ctx)
 	if err != nil {
 		return err
@@ -132,8 +140,6 @@ func (woc *wfOperationCtx) processArtifactGCStrategy(ctx context.Context, strate
 		woc.updated = true
 	}()
 
-	var err error
-
 	woc.log.Debugf("processing Artifact GC Strategy %s", strategy)
 
 	// Search for artifacts
@@ -143,6 +149,13 @@ func (woc *wfOperationCtx) processArtifactGCStrategy(ctx context.Context, strate
 		return nil
 	}
 
+	return woc.createArtifactGCPods(ctx, strategy, artifactSearchResults)
+}
+
+// start up Pods to delete the artifacts
+func (woc *wfOperationCtx) createArtifactGCPods(ctx context.Context, strategy wfv1.ArtifactGCStrategy, artifactSearchResults wfv1.ArtifactSearchResults) error {
+	var err error
+
 	// cache the templates by name so we can find them easily
 	templatesByName := make(map[string]*wfv1.Template)
 
@@ -267,6 +280,10 @@ func (woc *wfOperationCtx) artGCPodName(strategy wfv1.ArtifactGCStrategy, podInf
 		abbreviatedName = "wfcomp"
 	case wfv1.ArtifactGCOnWorkflowDeletion:
 		abbreviatedName = "wfdel"
+	case artifactGCRetention:
+		// each run of the retention policies deletes different artifacts, so needs its own pod
+		_, _ = h.Write([]byte(woc.artifactRetentionRun))
+		abbreviatedName = "retention"
 	default:
 		return "", fmt.Errorf("ArtifactGCStrategy %q not valid", strategy)
 	}
@@ -508,6 +525,8 @@ func (woc *wfOperationCtx) processArtifactGCCompletion(ctx context.Context) erro
 		return fmt.Errorf("failed to get pods from informer: %w", err)
 	}
 
+	// the pods started up for the retention of artifacts by this reconciliation are not yet in the informer
+	retentionPending := woc.artifactRetentionRun != ""
 	for _, obj := range pods {
 		pod := obj.(*corev1.Pod)
 		if pod.Labels[common.LabelKeyComponent] != artifactGCComponent { // make sure it's an Artifact GC Pod
@@ -536,6 +555,8 @@ func (woc *wfOperationCtx) processArtifactGCCompletion(ctx context.Context) erro
 
 			woc.wf.Status.ArtifactGCStatus.SetArtifactGCPodRecouped(pod.Name, true)
 			woc.updated = true
+		} else if pod.Annotations[common.AnnotationKeyArtifactGCStrategy] == string(artifactGCRetention) {
+			retentionPending = true
 		}
 	}
 
@@ -547,7 +568,8 @@ func (woc *wfOperationCtx) processArtifactGCCompletion(ctx context.Context) erro
 		// check if all artifacts have been deleted and if so remove Finalizer
 		removeFinalizer = woc.allArtifactsDeleted()
 	}
-	if removeFinalizer {
+	// the finalizer is added by the retention of artifacts, so that the workflow is reconciled once its pods complete
+	if removeFinalizer && !retentionPending {
 		woc.log.Infof("no remaining artifacts to GC, removing artifact GC finalizer (forceFinalizerRemoval=%v)", forceFinalizerRemoval)
 		woc.wf.Finalizers = slices.DeleteFunc(woc.wf.Finalizers,
 			func(x string) bool { return x == common.FinalizerArtifactGC })
@@ -558,6 +580,9 @@ func (woc *wfOperationCtx) processArtifactGCCompletion(ctx context.Context) erro
 
 func (woc *wfOperationCtx) allArtifactsDeleted() bool {
 	for _, n := range woc.wf.Status.Nodes {
+		if n.Type == wfv1.NodeTypeCompacted && n.Compacted != nil && !n.Compacted.Artifact.Deleted {
+			return false
+		}
 		if n.Type != wfv1.NodeTypePod {
 			continue
 		}
@@ -615,7 +640,7 @@ func (woc *wfOperationCtx) processCompletedArtifactGCPod(ctx context.Context, po
 	}
 
 	for _, task := range taskList.Items {
-		allArtifactsSucceeded, err := woc.processCompletedWorkflowArtifactGCTask(&task, strategy)
+		allArtifactsSucceeded, err := woc.processCompletedWorkflowArtifactGCTask(ctx, &task, strategy)
 		if err != nil {
 			return err
 		}
@@ -634,10 +659,11 @@ func (woc *wfOperationCtx) processCompletedArtifactGCPod(ctx context.Context, po
 
 // process the Status in the WorkflowArtifactGCTask which was completed and reflect it in Workflow Status; then delete the Task CRD Object
 // return true if all artifacts succeeded, else false
-func (woc *wfOperationCtx) processCompletedWorkflowArtifactGCTask(artifactGCTask *wfv1.WorkflowArtifactGCTask, strategy wfv1.ArtifactGCStrategy) (bool, error) {
+func (woc *wfOperationCtx) processCompletedWorkflowArtifactGCTask(ctx context.Context, artifactGCTask *wfv1.WorkflowArtifactGCTask, strategy wfv1.ArtifactGCStrategy) (bool, error) {
 	woc.log.Debugf("processing WorkflowArtifactGCTask %s", artifactGCTask.Name)
 
 	foundGCFailure := false
+	var reclaimedBytes int64
 	for nodeName, nodeResult := range artifactGCTask.Status.ArtifactResultsByNode {
 		// find this node result in the Workflow Status
 		wfNode, err := woc.wf.Status.Nodes.Get(nodeName)
@@ -656,6 +682,9 @@ func (woc *wfOperationCtx) processCompletedWorkflowArtifactGCTask(artifactGCTask
 				continue
 			}
 
+			if artifactResult.Success && !wfArtifact.Deleted {
+				reclaimedBytes += wfArtifact.SizeBytes
+			}
 			wfNode.Outputs.Artifacts[i].Deleted = artifactResult.Success
 			woc.wf.Status.Nodes.Set(nodeName, *wfNode)
 
@@ -671,6 +700,9 @@ func (woc *wfOperationCtx) processCompletedWorkflowArtifactGCTask(artifactGCTask
 		}
 
 	}
+	if strategy == artifactGCRetention && reclaimedBytes > 0 {
+		woc.controller.metrics.ArtifactRetentionReclaimed(ctx, woc.wf.Namespace, reclaimedBytes)
+	}
 
 	return !foundGCFailure, nil
 }
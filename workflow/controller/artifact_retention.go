@@ -0,0 +1,432 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/env"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// artifactGCRetention is the Artifact GC Strategy of the pods which delete the artifacts that are no longer retained
+// by the retention policy of their artifact repository
+const artifactGCRetention wfv1.ArtifactGCStrategy = "Retention"
+
+// archiveRetentionPageSize is how many archived workflows are listed at a time to enforce artifact retention
+const archiveRetentionPageSize = 100
+
+// artifactRetentionRequest is the value of the artifact retention annotation: the run of the retention policies, and
+// the output artifacts of the workflow which it found are no longer retained
+type artifactRetentionRequest struct {
+	Run       string            `json:"run"`
+	Artifacts []expiredArtifact `json:"artifacts"`
+}
+
+// expiredArtifact identifies an output artifact of a node
+type expiredArtifact struct {
+	NodeID string `json:"nodeID"`
+	Name   string `json:"name"`
+}
+
+// retentionArtifact is an output artifact of a completed workflow
+type retentionArtifact struct {
+	wf     *wfv1.Workflow
+	nodeID string
+	wfv1.Artifact
+	// versionOf identifies the artifact that this is a version of: the template which produced it and its name
+	versionOf string
+}
+
+// artifactRetentionCollector periodically deletes the output artifacts of completed workflows which are no longer
+// retained by the retention policies of their artifact repositories
+func (wfc *WorkflowController) artifactRetentionCollector(ctx context.Context) {
+	defer runtimeutil.HandleCrashWithContext(ctx, runtimeutil.PanicHandlers...)
+
+	periodicity := env.LookupEnvDurationOr("ARTIFACT_RETENTION_PERIOD", time.Hour)
+	if !artifactGCEnabled {
+		log.Info("Artifact GC disabled - so artifact retention disabled")
+		return
+	}
+	log.WithField("periodicity", periodicity).Info("Performing artifact retention")
+	ticker := time.NewTicker(periodicity)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			wfc.enforceArtifactRetention(ctx)
+		}
+	}
+}
+
+// enforceArtifactRetention deletes, or for a dry run logs, the output artifacts which are no longer retained. The
+// artifacts of completed workflows which were deleted from the cluster are enforced from the workflow archive.
+func (wfc *WorkflowController) enforceArtifactRetention(ctx context.Context) {
+	run := fmt.Sprint(time.Now().Unix())
+	policies := make(map[string]*wfv1.ArtifactRetention)
+	policy := func(ref *wfv1.ArtifactRepositoryRefStatus) *wfv1.ArtifactRetention {
+		repository := ref.String()
+		p, ok := policies[repository]
+		if !ok {
+			p = wfc.artifactRetentionPolicy(ctx, ref)
+			policies[repository] = p
+		}
+		return p
+	}
+	artifactsByRepository := make(map[string][]retentionArtifact)
+	live := make(map[types.UID]bool)
+	var workflows []*wfv1.Workflow
+	for _, obj := range wfc.wfInformer.GetIndexer().List() {
+		un, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		// workflows that are still in the cluster are never enforced from the archive
+		live[un.GetUID()] = true
+		if un.GetLabels()[common.LabelKeyCompleted] != "true" || un.GetDeletionTimestamp() != nil {
+			continue
+		}
+		wf, err := util.FromUnstructured(un)
+		if err == nil && wf.Status.ArtifactRepositoryRef != nil {
+			policy(wf.Status.ArtifactRepositoryRef)
+			workflows = append(workflows, wf)
+		}
+	}
+	policy(&wfv1.ArtifactRepositoryRefStatus{Default: true})
+	workflows = append(workflows, wfc.archivedRetentionWorkflows(live, policies, policy)...)
+	for _, wf := range workflows {
+		if policy(wf.Status.ArtifactRepositoryRef).IsEnabled() {
+			repository := wf.Status.ArtifactRepositoryRef.String()
+			artifactsByRepository[repository] = append(artifactsByRepository[repository], retentionArtifacts(wf)...)
+		}
+	}
+
+	for repository, artifacts := range artifactsByRepository {
+		policy := policies[repository]
+		logCtx := log.WithFields(log.Fields{"artifactRepository": repository, "dryRun": policy.DryRun})
+		expired, err := expiredArtifacts(policy, artifacts, time.Now())
+		if err != nil {
+			logCtx.WithError(err).Error("Invalid artifact retention policy")
+			continue
+		}
+		workflows := make(map[*wfv1.Workflow]wfv1.ArtifactSearchResults)
+		for _, a := range expired {
+			logCtx.WithFields(log.Fields{"namespace": a.wf.Namespace, "workflow": a.wf.Name, "nodeID": a.nodeID, "artifact": a.Name, "sizeBytes": a.SizeBytes}).
+				Info("Artifact is no longer retained")
+			workflows[a.wf] = append(workflows[a.wf], wfv1.ArtifactSearchResult{Artifact: a.Artifact, NodeID: a.nodeID})
+		}
+		if policy.DryRun {
+			continue
+		}
+		for wf, results := range workflows {
+			deleteArtifacts := wfc.requestArtifactRetention
+			if !live[wf.UID] {
+				deleteArtifacts = wfc.deleteArchivedArtifacts
+			}
+			if err := deleteArtifacts(ctx, wf, results, run); err != nil {
+				logCtx.WithFields(log.Fields{"namespace": wf.Namespace, "workflow": wf.Name}).WithError(err).Error("Failed to delete artifacts which are no longer retained")
+			}
+		}
+	}
+}
+
+// archivedRetentionWorkflows returns the archived workflows which are no longer in the cluster, and whose artifact
+// repository has an enabled retention policy. The archive is only listed, a page at a time, when one of the policies
+// resolved so far is enabled, and then only for the workflows which finished before its cut-off.
+func (wfc *WorkflowController) archivedRetentionWorkflows(live map[types.UID]bool, policies map[string]*wfv1.ArtifactRetention, policy func(*wfv1.ArtifactRepositoryRefStatus) *wfv1.ArtifactRetention) []*wfv1.Workflow {
+	if !wfc.wfArchive.IsEnabled() {
+		return nil
+	}
+	finishedBefore, enabled := retentionCutOff(policies, time.Now())
+	if !enabled {
+		return nil
+	}
+	var workflows []*wfv1.Workflow
+	for offset := 0; ; offset += archiveRetentionPageSize {
+		page, err := wfc.wfArchive.ListWorkflowsFinishedBefore(finishedBefore, archiveRetentionPageSize, offset)
+		if err != nil {
+			log.WithError(err).Error("Failed to list archived workflows for artifact retention")
+			return nil
+		}
+		for i := range page {
+			wf := &page[i]
+			if live[wf.UID] || wf.Status.ArtifactRepositoryRef == nil || !policy(wf.Status.ArtifactRepositoryRef).IsEnabled() {
+				continue
+			}
+			workflows = append(workflows, wf)
+		}
+		if len(page) < archiveRetentionPageSize {
+			return workflows
+		}
+	}
+}
+
+// retentionCutOff returns the time before which a workflow must have finished for any of the enabled policies to
+// expire its artifacts, and whether any of them is enabled. The artifacts of the workflows which finished since are
+// younger than the MaxAge of every policy, so are retained, though they then do not count towards KeepLatest.
+func retentionCutOff(policies map[string]*wfv1.ArtifactRetention, now time.Time) (time.Time, bool) {
+	cutOff, enabled := now, false
+	for _, p := range policies {
+		if !p.IsEnabled() {
+			continue
+		}
+		maxAge, err := p.GetMaxAge()
+		if err != nil {
+			// an invalid policy expires no artifacts
+			continue
+		}
+		if !enabled || now.Add(-maxAge).After(cutOff) {
+			cutOff = now.Add(-maxAge)
+		}
+		enabled = true
+	}
+	return cutOff, enabled
+}
+
+// artifactRetentionPolicy returns the retention policy currently configured for the artifact repository, rather
+// than the one recorded in the workflow's status when it ran
+func (wfc *WorkflowController) artifactRetentionPolicy(ctx context.Context, ref *wfv1.ArtifactRepositoryRefStatus) *wfv1.ArtifactRetention {
+	current := ref.DeepCopy()
+	current.ArtifactRepository = nil
+	repo, err := wfc.artifactRepositories.Get(ctx, current)
+	if err != nil {
+		log.WithField("artifactRepository", ref.String()).WithError(err).Warn("Failed to get artifact repository for its retention policy")
+		return nil
+	}
+	return repo.Retention
+}
+
+// retentionArtifacts returns the output artifacts of the workflow that retention policies apply to. Artifacts that
+// are never garbage collected, such as content addressed artifacts which may be shared by several workflows, are
+// never deleted by retention policies either.
+func retentionArtifacts(wf *wfv1.Workflow) []retentionArtifact {
+	var artifacts []retentionArtifact
+	for _, node := range wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypePod {
+			continue
+		}
+		for _, a := range node.GetOutputs().GetArtifacts() {
+			if a.Deleted || !a.HasKey() || (a.ArtifactGC != nil && a.ArtifactGC.Strategy == wfv1.ArtifactGCNever) {
+				continue
+			}
+			artifacts = append(artifacts, retentionArtifact{wf: wf, nodeID: node.ID, Artifact: a, versionOf: artifactTemplate(wf, node) + "/" + a.Name})
+		}
+	}
+	return artifacts
+}
+
+// artifactTemplate identifies the template which produced the node's artifacts across workflows
+func artifactTemplate(wf *wfv1.Workflow, node wfv1.NodeStatus) string {
+	if ref := node.TemplateRef; ref != nil {
+		if ref.ClusterScope {
+			return ref.Name + "/" + ref.Template
+		}
+		return wf.Namespace + "/" + ref.Name + "/" + ref.Template
+	}
+	name := wf.Name
+	if wf.Spec.WorkflowTemplateRef != nil {
+		name = wf.Spec.WorkflowTemplateRef.Name
+	} else if cronWorkflow := wf.Labels[common.LabelKeyCronWorkflow]; cronWorkflow != "" {
+		name = cronWorkflow
+	} else if wf.GenerateName != "" {
+		name = wf.GenerateName
+	}
+	return wf.Namespace + "/" + name + "/" + node.TemplateName
+}
+
+// expiredArtifacts returns the artifacts which are no longer retained by the policy: those which are neither among
+// the KeepLatest most recent versions of the artifact, nor younger than MaxAge. The artifacts of the workflows which
+// match the KeepLabelSelector are always retained, and do not count towards KeepLatest.
+func expiredArtifacts(policy *wfv1.ArtifactRetention, artifacts []retentionArtifact, now time.Time) ([]retentionArtifact, error) {
+	maxAge, err := policy.GetMaxAge()
+	if err != nil {
+		return nil, fmt.Errorf("invalid maxAge: %w", err)
+	}
+	keep := labels.Nothing()
+	if policy.KeepLabelSelector != "" {
+		keep, err = labels.Parse(policy.KeepLabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid keepLabelSelector: %w", err)
+		}
+	}
+	versions := make(map[string][]retentionArtifact)
+	for _, a := range artifacts {
+		if !keep.Matches(labels.Set(a.wf.Labels)) {
+			versions[a.versionOf] = append(versions[a.versionOf], a)
+		}
+	}
+	var expired []retentionArtifact
+	for _, v := range versions {
+		// most recent first
+		sort.SliceStable(v, func(i, j int) bool {
+			return v[i].wf.Status.FinishedAt.After(v[j].wf.Status.FinishedAt.Time)
+		})
+		for i, a := range v {
+			if i < policy.GetKeepLatest() || (maxAge > 0 && now.Sub(a.wf.Status.FinishedAt.Time) < maxAge) {
+				continue
+			}
+			expired = append(expired, a)
+		}
+	}
+	sort.Slice(expired, func(i, j int) bool {
+		a, b := expired[i], expired[j]
+		if a.wf.Namespace+"/"+a.wf.Name != b.wf.Namespace+"/"+b.wf.Name {
+			return a.wf.Namespace+"/"+a.wf.Name < b.wf.Namespace+"/"+b.wf.Name
+		}
+		if a.nodeID != b.nodeID {
+			return a.nodeID < b.nodeID
+		}
+		return a.Name < b.Name
+	})
+	return expired, nil
+}
+
+// requestArtifactRetention records the artifacts of the completed workflow which are no longer retained in its
+// artifact retention annotation, and requeues it, so that it is reconciled to start up the Artifact GC Pods which
+// delete them. The Artifact GC finalizer is added so that the workflow is reconciled again, and the artifacts marked
+// as deleted, once they complete. The artifacts of a workflow already annotated by a previous run are left to it.
+func (wfc *WorkflowController) requestArtifactRetention(ctx context.Context, wf *wfv1.Workflow, results wfv1.ArtifactSearchResults, run string) error {
+	if _, ok := wf.Annotations[common.AnnotationKeyArtifactRetention]; ok {
+		return nil
+	}
+	request := artifactRetentionRequest{Run: run}
+	for _, result := range results {
+		request.Artifacts = append(request.Artifacts, expiredArtifact{NodeID: result.NodeID, Name: result.Name})
+	}
+	value, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	finalizers := wf.Finalizers
+	if !slices.Contains(finalizers, common.FinalizerArtifactGC) {
+		finalizers = append(slices.Clone(finalizers), common.FinalizerArtifactGC)
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"metadata": metav1.ObjectMeta{
+			// the patch fails, rather than replace the finalizers, if the workflow was updated since it was listed
+			ResourceVersion: wf.ResourceVersion,
+			Annotations:     map[string]string{common.AnnotationKeyArtifactRetention: string(value)},
+			Finalizers:      finalizers,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal patch: %w", err)
+	}
+	_, err = wfc.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Patch(ctx, wf.Name, types.MergePatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return err
+	}
+	wfc.wfQueue.AddRateLimited(wf.Namespace + "/" + wf.Name)
+	return nil
+}
+
+// processArtifactRetention starts up the Artifact GC Pods to delete the artifacts recorded in the artifact retention
+// annotation, and removes it. The pods are named after the run which recorded the artifacts, so they are not started
+// up twice should the workflow fail to update.
+func (woc *wfOperationCtx) processArtifactRetention(ctx context.Context) error {
+	value, ok := woc.wf.Annotations[common.AnnotationKeyArtifactRetention]
+	if !ok {
+		return nil
+	}
+	var request artifactRetentionRequest
+	if err := json.Unmarshal([]byte(value), &request); err != nil {
+		woc.log.WithError(err).Warn("Ignoring invalid artifact retention annotation")
+	} else if results := request.searchResults(woc.wf); len(results) > 0 {
+		woc.artifactRetentionRun = request.Run
+		if err := woc.createArtifactGCPods(ctx, artifactGCRetention, results); err != nil {
+			return err
+		}
+	}
+	delete(woc.wf.Annotations, common.AnnotationKeyArtifactRetention)
+	woc.updated = true
+	return nil
+}
+
+// searchResults returns the recorded artifacts which the workflow has not since marked as deleted
+func (r artifactRetentionRequest) searchResults(wf *wfv1.Workflow) wfv1.ArtifactSearchResults {
+	var results wfv1.ArtifactSearchResults
+	for _, expired := range r.Artifacts {
+		node, err := wf.Status.Nodes.Get(expired.NodeID)
+		if err != nil {
+			continue
+		}
+		if a := node.GetOutputs().GetArtifacts().GetArtifactByName(expired.Name); a != nil && !a.Deleted {
+			results = append(results, wfv1.ArtifactSearchResult{Artifact: *a, NodeID: node.ID})
+		}
+	}
+	return results
+}
+
+// deleteArchivedArtifacts deletes the artifacts of an archived workflow which is no longer in the cluster. As there is
+// no workflow for the Artifact GC Pods to report to, the controller deletes the artifacts itself, using the secrets of
+// the artifact repository in the workflow's namespace, and re-archives the workflow with the artifacts marked deleted.
+func (wfc *WorkflowController) deleteArchivedArtifacts(ctx context.Context, wf *wfv1.Workflow, results wfv1.ArtifactSearchResults, _ string) error {
+	repo, err := wfc.artifactRepositories.Get(ctx, wf.Status.ArtifactRepositoryRef)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	deleted := 0
+	var reclaimedBytes int64
+	for _, result := range results {
+		node, err := wf.Status.Nodes.Get(result.NodeID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := wfc.deleteArchivedArtifact(ctx, wf, *node, repo, result.Artifact); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete artifact %s of node %s: %w", result.Name, result.NodeID, err))
+			continue
+		}
+		for i, a := range node.Outputs.Artifacts {
+			if a.Name == result.Name {
+				node.Outputs.Artifacts[i].Deleted = true
+			}
+		}
+		wf.Status.Nodes.Set(node.ID, *node)
+		deleted++
+		reclaimedBytes += result.SizeBytes
+	}
+	if reclaimedBytes > 0 {
+		wfc.metrics.ArtifactRetentionReclaimed(ctx, wf.Namespace, reclaimedBytes)
+	}
+	if deleted > 0 {
+		if err := wfc.wfArchive.ArchiveWorkflow(wf); err != nil {
+			errs = append(errs, fmt.Errorf("failed to re-archive workflow: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// deleteArchivedArtifact deletes the artifact from the archive location of its template, or else of the repository,
+// as the Artifact GC Pods would
+func (wfc *WorkflowController) deleteArchivedArtifact(ctx context.Context, wf *wfv1.Workflow, node wfv1.NodeStatus, repo *wfv1.ArtifactRepository, art wfv1.Artifact) error {
+	location := repo.ToArtifactLocation()
+	if tmpl := wf.GetTemplateByName(util.GetTemplateFromNode(node)); tmpl != nil && tmpl.ArchiveLocation.HasLocation() {
+		location = tmpl.ArchiveLocation
+	}
+	if err := art.Relocate(location); err != nil {
+		return err
+	}
+	driver, err := wfc.artDriverFactory(ctx, &art, resource.New(wfc.kubeclientset, wf.Namespace))
+	if err != nil {
+		return err
+	}
+	return driver.Delete(&art)
+}
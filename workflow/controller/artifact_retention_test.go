@@ -0,0 +1,229 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	sqldbmocks "github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	armocks "github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories/mocks"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func retentionWorkflow(name string, finishedAt time.Time, labels map[string]string) *wfv1.Workflow {
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argo", GenerateName: "my-wf-", Labels: labels},
+		Status: wfv1.WorkflowStatus{
+			FinishedAt: metav1.NewTime(finishedAt),
+			Nodes: wfv1.Nodes{
+				name: {ID: name, Type: wfv1.NodeTypePod, TemplateName: "main", Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{
+					{Name: "out", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: name + "/out.tgz"}}},
+					{Name: "shared", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "sha256/abc"}}, ArtifactGC: &wfv1.ArtifactGC{Strategy: wfv1.ArtifactGCNever}},
+				}}},
+			},
+		},
+	}
+}
+
+func TestRetentionArtifacts(t *testing.T) {
+	wf := retentionWorkflow("my-wf-1", time.Now(), nil)
+	artifacts := retentionArtifacts(wf)
+	require.Len(t, artifacts, 1)
+	assert.Equal(t, "out", artifacts[0].Name)
+	assert.Equal(t, "argo/my-wf-/main/out", artifacts[0].versionOf)
+
+	t.Run("CronWorkflow", func(t *testing.T) {
+		wf := retentionWorkflow("my-wf-1", time.Now(), map[string]string{common.LabelKeyCronWorkflow: "my-cron"})
+		assert.Equal(t, "argo/my-cron/main/out", retentionArtifacts(wf)[0].versionOf)
+	})
+	t.Run("TemplateRef", func(t *testing.T) {
+		wf := retentionWorkflow("my-wf-1", time.Now(), nil)
+		node := wf.Status.Nodes["my-wf-1"]
+		node.TemplateRef = &wfv1.TemplateRef{Name: "my-tmpl", Template: "build", ClusterScope: true}
+		wf.Status.Nodes["my-wf-1"] = node
+		assert.Equal(t, "my-tmpl/build/out", retentionArtifacts(wf)[0].versionOf)
+	})
+	t.Run("Deleted", func(t *testing.T) {
+		wf := retentionWorkflow("my-wf-1", time.Now(), nil)
+		wf.Status.Nodes["my-wf-1"].Outputs.Artifacts[0].Deleted = true
+		assert.Empty(t, retentionArtifacts(wf))
+	})
+}
+
+func TestExpiredArtifacts(t *testing.T) {
+	now := time.Now()
+	var artifacts []retentionArtifact
+	for i, wf := range []*wfv1.Workflow{
+		retentionWorkflow("my-wf-1", now.Add(-1*time.Hour), nil),
+		retentionWorkflow("my-wf-2", now.Add(-2*time.Hour), nil),
+		retentionWorkflow("my-wf-3", now.Add(-3*time.Hour), map[string]string{"keep": "true"}),
+		retentionWorkflow("my-wf-4", now.Add(-4*time.Hour), nil),
+	} {
+		artifacts = append(artifacts, retentionArtifacts(wf)...)
+		require.Len(t, artifacts, i+1)
+	}
+	names := func(artifacts []retentionArtifact) []string {
+		var names []string
+		for _, a := range artifacts {
+			names = append(names, a.wf.Name)
+		}
+		return names
+	}
+
+	t.Run("KeepLatest", func(t *testing.T) {
+		expired, err := expiredArtifacts(&wfv1.ArtifactRetention{KeepLatest: ptr.To(int32(1))}, artifacts, now)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-wf-2", "my-wf-3", "my-wf-4"}, names(expired))
+	})
+	t.Run("MaxAge", func(t *testing.T) {
+		expired, err := expiredArtifacts(&wfv1.ArtifactRetention{MaxAge: "150m"}, artifacts, now)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-wf-3", "my-wf-4"}, names(expired))
+	})
+	t.Run("KeepLatestAndMaxAge", func(t *testing.T) {
+		expired, err := expiredArtifacts(&wfv1.ArtifactRetention{KeepLatest: ptr.To(int32(3)), MaxAge: "1m"}, artifacts, now)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-wf-4"}, names(expired))
+	})
+	t.Run("KeepLabelSelector", func(t *testing.T) {
+		expired, err := expiredArtifacts(&wfv1.ArtifactRetention{KeepLatest: ptr.To(int32(1)), KeepLabelSelector: "keep=true"}, artifacts, now)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-wf-2", "my-wf-4"}, names(expired))
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := expiredArtifacts(&wfv1.ArtifactRetention{MaxAge: "forever"}, artifacts, now)
+		require.Error(t, err)
+		_, err = expiredArtifacts(&wfv1.ArtifactRetention{MaxAge: "1h", KeepLabelSelector: "keep in ("}, artifacts, now)
+		require.Error(t, err)
+	})
+}
+
+func TestEnforceArtifactRetention(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	var live []interface{}
+	for i, name := range []string{"my-wf-1", "my-wf-2"} {
+		wf := retentionWorkflow(name, now.Add(-time.Duration(i+1)*time.Hour), map[string]string{common.LabelKeyCompleted: "true"})
+		wf.UID = types.UID(name + "-uid")
+		wf.Spec = wfv1.WorkflowSpec{Entrypoint: "main", Templates: []wfv1.Template{{Name: "main", Container: &apiv1.Container{Image: "busybox"}}}}
+		wf.Status.Phase = wfv1.WorkflowSucceeded
+		wf.Status.ArtifactRepositoryRef = &wfv1.ArtifactRepositoryRefStatus{Default: true}
+		live = append(live, wf)
+	}
+	cancel, controller := newController(append(live, func(wfc *WorkflowController) {
+		wfc.artifactRepositories = armocks.DummyArtifactRepositories(&wfv1.ArtifactRepository{
+			S3:        &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}},
+			Retention: &wfv1.ArtifactRetention{KeepLatest: ptr.To(int32(1))},
+		})
+	})...)
+	defer cancel()
+	workflows := controller.wfclientset.ArgoprojV1alpha1().Workflows("argo")
+	artifactGCPods := func() []apiv1.Pod {
+		pods, err := controller.kubeclientset.CoreV1().Pods("argo").List(ctx, metav1.ListOptions{LabelSelector: common.LabelKeyComponent + "=" + artifactGCComponent})
+		require.NoError(t, err)
+		return pods.Items
+	}
+
+	controller.enforceArtifactRetention(ctx)
+
+	// the expired artifacts are recorded on the workflow, for its reconciliation to delete
+	wf, err := workflows.Get(ctx, "my-wf-2", metav1.GetOptions{})
+	require.NoError(t, err)
+	var request artifactRetentionRequest
+	require.NoError(t, json.Unmarshal([]byte(wf.Annotations[common.AnnotationKeyArtifactRetention]), &request))
+	assert.NotEmpty(t, request.Run)
+	assert.Equal(t, []expiredArtifact{{NodeID: "my-wf-2", Name: "out"}}, request.Artifacts)
+	assert.Contains(t, wf.Finalizers, common.FinalizerArtifactGC)
+	kept, err := workflows.Get(ctx, "my-wf-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, kept.Annotations, common.AnnotationKeyArtifactRetention)
+	assert.Empty(t, artifactGCPods())
+
+	woc := newWorkflowOperationCtx(wf.DeepCopy(), controller)
+	woc.operate(ctx)
+	pods := artifactGCPods()
+	require.Len(t, pods, 1)
+	assert.Equal(t, string(artifactGCRetention), pods[0].Annotations[common.AnnotationKeyArtifactGCStrategy])
+	assert.NotContains(t, woc.wf.Annotations, common.AnnotationKeyArtifactRetention)
+	assert.Contains(t, woc.wf.Finalizers, common.FinalizerArtifactGC)
+
+	t.Run("Conflict", func(t *testing.T) {
+		// reconciling the annotated workflow again, as if it had failed to update, does not start up more pods
+		woc := newWorkflowOperationCtx(wf.DeepCopy(), controller)
+		woc.operate(ctx)
+		assert.Len(t, artifactGCPods(), 1)
+	})
+}
+
+func TestEnforceArtifactRetentionArchived(t *testing.T) {
+	now := time.Now()
+	var archived wfv1.Workflows
+	for i, name := range []string{"my-wf-1", "my-wf-2", "my-wf-3"} {
+		wf := retentionWorkflow(name, now.Add(-time.Duration(i+1)*time.Hour), nil)
+		wf.UID = types.UID(name + "-uid")
+		wf.Status.ArtifactRepositoryRef = &wfv1.ArtifactRepositoryRefStatus{Default: true}
+		archived = append(archived, *wf)
+	}
+	archive := &sqldbmocks.WorkflowArchive{}
+	archive.On("IsEnabled").Return(true)
+	archive.On("ListWorkflowsFinishedBefore", mock.Anything, archiveRetentionPageSize, 0).Return(archived, nil)
+	var rearchived []*wfv1.Workflow
+	archive.On("ArchiveWorkflow", mock.Anything).Run(func(args mock.Arguments) {
+		rearchived = append(rearchived, args.Get(0).(*wfv1.Workflow))
+	}).Return(nil)
+	driver := &memoryArtifactDriver{objects: map[string]string{"my-wf-1/out.tgz": "1", "my-wf-2/out.tgz": "2", "my-wf-3/out.tgz": "3"}}
+	// my-wf-3 is still in the cluster, so its artifacts are deleted by the Artifact GC Pods
+	live := retentionWorkflow("my-wf-3", now.Add(-3*time.Hour), map[string]string{common.LabelKeyCompleted: "true"})
+	live.UID = "my-wf-3-uid"
+	cancel, controller := newController(live, func(wfc *WorkflowController) {
+		wfc.wfArchive = archive
+		wfc.artifactRepositories = armocks.DummyArtifactRepositories(&wfv1.ArtifactRepository{
+			S3:        &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}},
+			Retention: &wfv1.ArtifactRetention{KeepLatest: ptr.To(int32(1))},
+		})
+		wfc.artDriverFactory = func(context.Context, *wfv1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+			return driver, nil
+		}
+	})
+	defer cancel()
+
+	controller.enforceArtifactRetention(context.Background())
+
+	assert.Equal(t, map[string]string{"my-wf-1/out.tgz": "1", "my-wf-3/out.tgz": "3"}, driver.objects)
+	require.Len(t, rearchived, 1)
+	assert.Equal(t, "my-wf-2", rearchived[0].Name)
+	assert.True(t, rearchived[0].Status.Nodes["my-wf-2"].Outputs.Artifacts[0].Deleted)
+
+	t.Run("Disabled", func(t *testing.T) {
+		archive := &sqldbmocks.WorkflowArchive{}
+		archive.On("IsEnabled").Return(true)
+		controller.wfArchive = archive
+		controller.artifactRepositories = armocks.DummyArtifactRepositories(&wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{}})
+		controller.enforceArtifactRetention(context.Background())
+		archive.AssertNotCalled(t, "ListWorkflowsFinishedBefore", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestRetentionCutOff(t *testing.T) {
+	now := time.Now()
+	_, enabled := retentionCutOff(map[string]*wfv1.ArtifactRetention{"a": nil, "b": {DryRun: true}}, now)
+	assert.False(t, enabled)
+	cutOff, enabled := retentionCutOff(map[string]*wfv1.ArtifactRetention{"a": {MaxAge: "2h"}, "b": {MaxAge: "1h"}, "c": {MaxAge: "forever"}}, now)
+	assert.True(t, enabled)
+	assert.Equal(t, now.Add(-time.Hour), cutOff)
+	cutOff, enabled = retentionCutOff(map[string]*wfv1.ArtifactRetention{"a": {MaxAge: "2h"}, "b": {KeepLatest: ptr.To(int32(1))}}, now)
+	assert.True(t, enabled)
+	assert.Equal(t, now, cutOff)
+}
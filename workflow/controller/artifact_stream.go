@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// artifactStreamTokenKey is the key of the token in the workflow's artifact stream secret
+const artifactStreamTokenKey = "token"
+
+// artifactStreamSecretName is the name of the secret that holds the token the workflow's streamed artifacts are
+// served with, so that the token is never recorded in the workflow itself
+func (woc *wfOperationCtx) artifactStreamSecretName() string {
+	return woc.wf.Name + "-artifact-stream"
+}
+
+// createArtifactStreamSecret creates the workflow's artifact stream secret with a random token, unless it exists.
+// The secret is owned by the workflow, so it is deleted with it.
+func (woc *wfOperationCtx) createArtifactStreamSecret(ctx context.Context) error {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return err
+	}
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   woc.artifactStreamSecretName(),
+			Labels: map[string]string{common.LabelKeyWorkflow: woc.wf.Name},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
+			},
+		},
+		StringData: map[string]string{artifactStreamTokenKey: hex.EncodeToString(token)},
+	}
+	_, err := woc.controller.kubeclientset.CoreV1().Secrets(woc.wf.Namespace).Create(ctx, secret, metav1.CreateOptions{})
+	if err != nil {
+		if apierr.IsAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+	woc.log.WithField("secret", secret.Name).Info("Created artifact stream secret")
+	return nil
+}
+
+// artifactStreamTokenEnvVar is the token from the workflow's artifact stream secret. The secret is optional for the
+// pods that load streamed artifacts, which load them from the artifact repository if it does not exist.
+func (woc *wfOperationCtx) artifactStreamTokenEnvVar(optional bool) apiv1.EnvVar {
+	return apiv1.EnvVar{
+		Name: common.EnvVarArtifactStreamToken,
+		ValueFrom: &apiv1.EnvVarSource{
+			SecretKeyRef: &apiv1.SecretKeySelector{
+				LocalObjectReference: apiv1.LocalObjectReference{Name: woc.artifactStreamSecretName()},
+				Key:                  artifactStreamTokenKey,
+				Optional:             ptr.To(optional),
+			},
+		},
+	}
+}
+
+// hasStreamedInputArtifacts returns whether any of the template's input artifacts are streamed to it
+func hasStreamedInputArtifacts(tmpl *wfv1.Template) bool {
+	for _, art := range tmpl.Inputs.Artifacts {
+		if art.StreamSource != nil {
+			return true
+		}
+	}
+	return false
+}
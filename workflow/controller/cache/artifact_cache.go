@@ -0,0 +1,215 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+type artifactCache struct {
+	name       string
+	repository *wfv1.ArtifactRepository
+	newDriver  artifact.NewDriverFunc
+	resources  resource.Interface
+	lock       sync.Mutex
+}
+
+func NewArtifactCache(repository *wfv1.ArtifactRepository, newDriver artifact.NewDriverFunc, ri resource.Interface, n string) MemoizationCache {
+	return &artifactCache{
+		name:       n,
+		repository: repository,
+		newDriver:  newDriver,
+		resources:  ri,
+	}
+}
+
+func (c *artifactCache) log(fields log.Fields) *log.Entry {
+	return log.WithFields(log.Fields{"name": c.name}).WithFields(fields)
+}
+
+// artifact returns the artifact holding the entry for the key, and its driver
+func (c *artifactCache) artifact(ctx context.Context, key string) (*wfv1.Artifact, common.ArtifactDriver, error) {
+	return c.artifactAt(ctx, key, path.Join("memoization", c.name, key+".json"))
+}
+
+func (c *artifactCache) artifactAt(ctx context.Context, key, objectKey string) (*wfv1.Artifact, common.ArtifactDriver, error) {
+	location := c.repository.ToArtifactLocation()
+	if location == nil || location.SetKey(objectKey) != nil || !location.HasLocation() {
+		return nil, nil, fmt.Errorf("artifact cache requires a default artifact repository to be configured")
+	}
+	art := &wfv1.Artifact{Name: key, ArtifactLocation: *location}
+	driver, err := c.newDriver(ctx, art, c.resources)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create artifact driver for cache: %w", err)
+	}
+	return art, driver, nil
+}
+
+func (c *artifactCache) Load(ctx context.Context, key string) (*Entry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("invalid cache key: %s", key)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	art, driver, err := c.artifact(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := c.read(art, driver)
+	if err != nil {
+		c.log(log.Fields{"key": key}).WithError(err).Debug("Error loading artifact cache entry")
+		return nil, err
+	}
+	if entry == nil {
+		c.log(log.Fields{"key": key}).Info("artifact cache miss: entry does not exist")
+		return nil, nil
+	}
+
+	entry.LastHitTimestamp = metav1.Time{Time: time.Now()}
+	if err := c.save(art, driver, entry); err != nil {
+		c.log(log.Fields{"key": key}).WithError(err).Debug("Error updating last hit timestamp on cache")
+		return nil, fmt.Errorf("error updating last hit timestamp on cache: %w", err)
+	}
+	return entry, nil
+}
+
+// read downloads the entry, returning nil if it does not exist
+func (c *artifactCache) read(art *wfv1.Artifact, driver common.ArtifactDriver) (*Entry, error) {
+	stream, err := driver.OpenStream(art)
+	if argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not load artifact cache entry: %w", err)
+	}
+	defer func() { _ = stream.Close() }()
+	data, err := io.ReadAll(stream)
+	if err != nil {
+		return nil, fmt.Errorf("could not read artifact cache entry: %w", err)
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("malformed cache entry: could not unmarshal JSON; unable to parse: %w", err)
+	}
+	return &entry, nil
+}
+
+func (c *artifactCache) Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("invalid cache key: %s", key)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.log(log.Fields{"key": key, "nodeId": nodeId}).Info("Saving artifact cache entry")
+
+	art, driver, err := c.artifact(ctx, key)
+	if err != nil {
+		return err
+	}
+	creationTime := time.Now()
+	err = c.save(art, driver, &Entry{
+		NodeID:            nodeId,
+		Outputs:           value,
+		CreationTimestamp: metav1.Time{Time: creationTime},
+		LastHitTimestamp:  metav1.Time{Time: creationTime},
+	})
+	if err != nil {
+		c.log(log.Fields{"key": key, "nodeId": nodeId}).WithError(err).Error("Error saving to artifact cache")
+		return fmt.Errorf("could not save to artifact cache: %w", err)
+	}
+	return nil
+}
+
+// save uploads the entry, which drivers require to be a local file
+func (c *artifactCache) save(art *wfv1.Artifact, driver common.ArtifactDriver, entry *Entry) error {
+	entryJSON, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("unable to marshal cache entry: %w", err)
+	}
+	file, err := os.CreateTemp("", "memoization-cache-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(file.Name()) }()
+	if _, err := file.Write(entryJSON); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return driver.Save(file.Name(), art)
+}
+
+func (c *artifactCache) List(ctx context.Context) (map[string]*Entry, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	dir, driver, err := c.artifactAt(ctx, "", path.Join("memoization", c.name))
+	if err != nil {
+		return nil, err
+	}
+	objectKeys, err := driver.ListObjects(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not list artifact cache entries: %w", err)
+	}
+	entries := make(map[string]*Entry)
+	for _, objectKey := range objectKeys {
+		key := strings.TrimSuffix(path.Base(objectKey), ".json")
+		if !cacheKeyRegex.MatchString(key) {
+			continue
+		}
+		art, driver, err := c.artifact(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		entry, err := c.read(art, driver)
+		if err != nil {
+			return nil, err
+		}
+		if entry != nil {
+			entries[key] = entry
+		}
+	}
+	return entries, nil
+}
+
+func (c *artifactCache) Delete(ctx context.Context, key string) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("invalid cache key: %s", key)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.log(log.Fields{"key": key}).Info("Deleting artifact cache entry")
+
+	art, driver, err := c.artifact(ctx, key)
+	if err != nil {
+		return err
+	}
+	if err := driver.Delete(art); err != nil && !argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+		return fmt.Errorf("could not delete artifact cache entry: %w", err)
+	}
+	return nil
+}
@@ -2,13 +2,17 @@ package cache
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
 )
 
 var cacheKeyRegex = regexp.MustCompile("^[a-zA-Z0-9][-a-zA-Z0-9]*$")
@@ -16,6 +20,10 @@ var cacheKeyRegex = regexp.MustCompile("^[a-zA-Z0-9][-a-zA-Z0-9]*$")
 type MemoizationCache interface {
 	Load(ctx context.Context, key string) (*Entry, error)
 	Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs) error
+	// List returns all entries by key, without recording a hit
+	List(ctx context.Context) (map[string]*Entry, error)
+	// Delete removes the entry, if it exists
+	Delete(ctx context.Context, key string) error
 }
 
 type Entry struct {
@@ -48,42 +56,86 @@ func (e *Entry) GetOutputsWithMaxAge(maxAge time.Duration) (*wfv1.Outputs, bool)
 }
 
 type cacheFactory struct {
-	caches     map[string]MemoizationCache
-	kubeclient kubernetes.Interface
-	namespace  string
+	caches             map[string]MemoizationCache
+	kubeclient         kubernetes.Interface
+	namespace          string
+	sqlRepo            sqldb.MemoizationCacheRepo
+	artifactRepository *wfv1.ArtifactRepository
 }
 
 type Factory interface {
 	GetCache(ct CacheType, name string) MemoizationCache
+	// Configure sets where SQL and artifact caches are held, either of which may be nil if not configured
+	Configure(sqlRepo sqldb.MemoizationCacheRepo, artifactRepository *wfv1.ArtifactRepository)
 }
 
 func NewCacheFactory(ki kubernetes.Interface, ns string) Factory {
 	return &cacheFactory{
-		make(map[string]MemoizationCache),
-		ki,
-		ns,
+		caches:     make(map[string]MemoizationCache),
+		kubeclient: ki,
+		namespace:  ns,
 	}
 }
 
-type CacheType string
+type CacheType = wfv1.MemoizationCacheType
 
 const (
-	// Only config maps are currently supported for caching
-	ConfigMapCache CacheType = "ConfigMapCache"
+	ConfigMapCache = wfv1.MemoizationCacheTypeConfigMap
+	SQLCache       = wfv1.MemoizationCacheTypeSQL
+	ArtifactCache  = wfv1.MemoizationCacheTypeArtifact
 )
 
+func (cf *cacheFactory) Configure(sqlRepo sqldb.MemoizationCacheRepo, artifactRepository *wfv1.ArtifactRepository) {
+	cf.sqlRepo = sqlRepo
+	cf.artifactRepository = artifactRepository
+	// caches must be re-created to use the new configuration
+	cf.caches = make(map[string]MemoizationCache)
+}
+
 // Returns a cache if it exists and creates it otherwise
 func (cf *cacheFactory) GetCache(ct CacheType, name string) MemoizationCache {
 	idx := string(ct) + "." + name
 	if c := cf.caches[idx]; c != nil {
 		return c
 	}
+	var c MemoizationCache
 	switch ct {
 	case ConfigMapCache:
-		c := NewConfigMapCache(cf.namespace, cf.kubeclient, name)
-		cf.caches[idx] = c
-		return c
+		c = NewConfigMapCache(cf.namespace, cf.kubeclient, name)
+	case SQLCache:
+		if cf.sqlRepo == nil {
+			return &unavailableCache{fmt.Errorf("SQL cache %q requires persistence to be configured", name)}
+		}
+		c = NewSQLCache(cf.namespace, cf.sqlRepo, name)
+	case ArtifactCache:
+		if cf.artifactRepository == nil {
+			return &unavailableCache{fmt.Errorf("artifact cache %q requires a default artifact repository to be configured", name)}
+		}
+		c = NewArtifactCache(cf.artifactRepository, artifact.NewDriver, resource.New(cf.kubeclient, cf.namespace), name)
 	default:
 		return nil
 	}
+	cf.caches[idx] = c
+	return c
+}
+
+// unavailableCache is a cache whose store is not configured
+type unavailableCache struct {
+	err error
+}
+
+func (c *unavailableCache) Load(context.Context, string) (*Entry, error) {
+	return nil, c.err
+}
+
+func (c *unavailableCache) Save(context.Context, string, string, *wfv1.Outputs) error {
+	return c.err
+}
+
+func (c *unavailableCache) List(context.Context) (map[string]*Entry, error) {
+	return nil, c.err
+}
+
+func (c *unavailableCache) Delete(context.Context, string) error {
+	return c.err
 }
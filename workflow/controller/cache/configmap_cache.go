@@ -171,3 +171,56 @@ func (c *configMapCache) Save(ctx context.Context, key string, nodeId string, va
 	}
 	return nil
 }
+
+func (c *configMapCache) List(ctx context.Context) (map[string]*Entry, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	entries := make(map[string]*Entry)
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return entries, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not load config map cache: %w", err)
+	}
+	if err := c.validateConfigmap(cm); err != nil {
+		return nil, err
+	}
+	for key, rawEntry := range cm.Data {
+		var entry Entry
+		if err := json.Unmarshal([]byte(rawEntry), &entry); err != nil {
+			return nil, fmt.Errorf("malformed cache entry %q: could not unmarshal JSON; unable to parse: %w", key, err)
+		}
+		entries[key] = &entry
+	}
+	return entries, nil
+}
+
+func (c *configMapCache) Delete(ctx context.Context, key string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.logInfo(log.Fields{"key": key}, "Deleting ConfigMap cache entry")
+
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not load config map cache: %w", err)
+	}
+	if err := c.validateConfigmap(cm); err != nil {
+		return err
+	}
+	if _, ok := cm.Data[key]; !ok {
+		return nil
+	}
+	delete(cm.Data, key)
+	_, err = c.kubeClient.CoreV1().ConfigMaps(c.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	if err != nil {
+		c.logError(err, log.Fields{"key": key}, "Error deleting cache entry")
+		return fmt.Errorf("error deleting cache entry: %w", err)
+	}
+	return nil
+}
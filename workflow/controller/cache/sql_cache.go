@@ -0,0 +1,127 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type sqlCache struct {
+	namespace string
+	name      string
+	repo      sqldb.MemoizationCacheRepo
+}
+
+func NewSQLCache(ns string, repo sqldb.MemoizationCacheRepo, n string) MemoizationCache {
+	return &sqlCache{
+		namespace: ns,
+		name:      n,
+		repo:      repo,
+	}
+}
+
+func (c *sqlCache) log(fields log.Fields) *log.Entry {
+	return log.WithFields(log.Fields{"namespace": c.namespace, "name": c.name}).WithFields(fields)
+}
+
+func (c *sqlCache) Load(ctx context.Context, key string) (*Entry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("invalid cache key: %s", key)
+	}
+
+	record, err := c.repo.Get(c.namespace, c.name, key)
+	if err != nil {
+		c.log(log.Fields{"key": key}).WithError(err).Debug("Error loading SQL cache entry")
+		return nil, fmt.Errorf("could not load SQL cache entry: %w", err)
+	}
+	if record == nil {
+		c.log(log.Fields{"key": key}).Info("SQL cache miss: entry does not exist")
+		return nil, nil
+	}
+
+	entry, err := recordToEntry(record)
+	if err != nil {
+		return nil, err
+	}
+
+	hitTime := time.Now()
+	if err := c.repo.Hit(c.namespace, c.name, key, hitTime); err != nil {
+		c.log(log.Fields{"key": key}).WithError(err).Debug("Error updating last hit timestamp on cache")
+		return nil, fmt.Errorf("error updating last hit timestamp on cache: %w", err)
+	}
+
+	entry.LastHitTimestamp = metav1.Time{Time: hitTime}
+	return entry, nil
+}
+
+func recordToEntry(record *sqldb.MemoizationCacheRecord) (*Entry, error) {
+	var outputs wfv1.Outputs
+	if err := json.Unmarshal([]byte(record.Outputs), &outputs); err != nil {
+		return nil, fmt.Errorf("malformed cache entry: could not unmarshal JSON; unable to parse: %w", err)
+	}
+	return &Entry{
+		NodeID:            record.NodeID,
+		Outputs:           &outputs,
+		CreationTimestamp: metav1.Time{Time: record.CreationTime},
+		LastHitTimestamp:  metav1.Time{Time: record.LastHitTime},
+	}, nil
+}
+
+func (c *sqlCache) Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("invalid cache key: %s", key)
+	}
+
+	c.log(log.Fields{"key": key, "nodeId": nodeId}).Info("Saving SQL cache entry")
+
+	outputsJSON, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("unable to marshal cache entry: %w", err)
+	}
+	creationTime := time.Now().UTC()
+	err = c.repo.Save(&sqldb.MemoizationCacheRecord{
+		Namespace:    c.namespace,
+		CacheName:    c.name,
+		Key:          key,
+		NodeID:       nodeId,
+		Outputs:      string(outputsJSON),
+		CreationTime: creationTime,
+		LastHitTime:  creationTime,
+	})
+	if err != nil {
+		c.log(log.Fields{"key": key, "nodeId": nodeId}).WithError(err).Error("Error saving to SQL cache")
+		return fmt.Errorf("could not save to SQL cache: %w", err)
+	}
+	return nil
+}
+
+func (c *sqlCache) List(ctx context.Context) (map[string]*Entry, error) {
+	records, err := c.repo.List(c.namespace, c.name)
+	if err != nil {
+		return nil, fmt.Errorf("could not list SQL cache entries: %w", err)
+	}
+	entries := make(map[string]*Entry, len(records))
+	for i := range records {
+		entry, err := recordToEntry(&records[i])
+		if err != nil {
+			return nil, err
+		}
+		entries[records[i].Key] = entry
+	}
+	return entries, nil
+}
+
+func (c *sqlCache) Delete(ctx context.Context, key string) error {
+	c.log(log.Fields{"key": key}).Info("Deleting SQL cache entry")
+	if err := c.repo.Delete(c.namespace, c.name, key); err != nil {
+		return fmt.Errorf("could not delete SQL cache entry: %w", err)
+	}
+	return nil
+}
@@ -2,14 +2,24 @@ package controller
 
 import (
 	"context"
+	"io"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	sqldbmocks "github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 )
@@ -104,3 +114,148 @@ func TestConfigMapCacheSave(t *testing.T) {
 	wfv1.MustUnmarshal([]byte(cm.Data["hi-there-world"]), &entry)
 	assert.Equal(t, entry.LastHitTimestamp.Time, entry.CreationTimestamp.Time)
 }
+
+func TestConfigMapCacheListDelete(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+
+	ctx := context.Background()
+	_, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, sampleConfigMapCacheEntry.DeepCopy(), metav1.CreateOptions{})
+	require.NoError(t, err)
+	c := cache.NewConfigMapCache("default", controller.kubeclientset, "whalesay-cache")
+
+	entries, err := c.List(ctx)
+	require.NoError(t, err)
+	require.Contains(t, entries, "hi-there-world")
+	assert.Equal(t, "memoized-simple-workflow-5wj2p", entries["hi-there-world"].NodeID)
+
+	require.NoError(t, c.Delete(ctx, "hi-there-world"))
+	entries, err = c.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestSQLCacheLoadHit(t *testing.T) {
+	repo := &sqldbmocks.MemoizationCacheRepo{}
+	creationTime := time.Now().Add(-time.Minute)
+	repo.On("Get", "default", "whalesay-cache", "hi-there-world").Return(&sqldb.MemoizationCacheRecord{
+		NodeID:       "memoized-simple-workflow-5wj2p",
+		Outputs:      `{"parameters":[{"name":"hello","value":"foobar"}]}`,
+		CreationTime: creationTime,
+	}, nil)
+	repo.On("Hit", "default", "whalesay-cache", "hi-there-world", mock.Anything).Return(nil)
+	c := cache.NewSQLCache("default", repo, "whalesay-cache")
+
+	entry, err := c.Load(context.Background(), "hi-there-world")
+	require.NoError(t, err)
+	assert.True(t, entry.Hit())
+	assert.True(t, entry.LastHitTimestamp.Time.After(entry.CreationTimestamp.Time))
+	require.Len(t, entry.Outputs.Parameters, 1)
+	assert.Equal(t, "foobar", entry.Outputs.Parameters[0].Value.String())
+	repo.AssertExpectations(t)
+}
+
+func TestSQLCacheLoadMiss(t *testing.T) {
+	repo := &sqldbmocks.MemoizationCacheRepo{}
+	repo.On("Get", "default", "whalesay-cache", "hi-there-world").Return(nil, nil)
+	c := cache.NewSQLCache("default", repo, "whalesay-cache")
+
+	entry, err := c.Load(context.Background(), "hi-there-world")
+	require.NoError(t, err)
+	assert.Nil(t, entry)
+}
+
+func TestSQLCacheSave(t *testing.T) {
+	repo := &sqldbmocks.MemoizationCacheRepo{}
+	repo.On("Save", mock.MatchedBy(func(record *sqldb.MemoizationCacheRecord) bool {
+		return record.Namespace == "default" && record.CacheName == "whalesay-cache" && record.Key == "hi-there-world" &&
+			record.NodeID == "my-node" && record.Outputs == `{"parameters":[{"name":"hello","value":"Hello world"}]}` &&
+			record.CreationTime.Equal(record.LastHitTime)
+	})).Return(nil)
+	c := cache.NewSQLCache("default", repo, "whalesay-cache")
+
+	outputs := &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "hello", Value: wfv1.AnyStringPtr("Hello world")}}}
+	err := c.Save(context.Background(), "hi-there-world", "my-node", outputs)
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+// memoryArtifactDriver holds artifacts in memory by key
+type memoryArtifactDriver struct {
+	artifactscommon.ArtifactDriver
+	objects map[string]string
+}
+
+func (d *memoryArtifactDriver) OpenStream(a *wfv1.Artifact) (io.ReadCloser, error) {
+	key, _ := a.GetKey()
+	data, ok := d.objects[key]
+	if !ok {
+		return nil, argoerrs.New(argoerrs.CodeNotFound, key)
+	}
+	return io.NopCloser(strings.NewReader(data)), nil
+}
+
+func (d *memoryArtifactDriver) Save(path string, a *wfv1.Artifact) error {
+	key, _ := a.GetKey()
+	data, err := os.ReadFile(path)
+	d.objects[key] = string(data)
+	return err
+}
+
+func (d *memoryArtifactDriver) ListObjects(a *wfv1.Artifact) ([]string, error) {
+	prefix, _ := a.GetKey()
+	var keys []string
+	for key := range d.objects {
+		if strings.HasPrefix(key, prefix+"/") {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (d *memoryArtifactDriver) Delete(a *wfv1.Artifact) error {
+	key, _ := a.GetKey()
+	delete(d.objects, key)
+	return nil
+}
+
+func TestArtifactCache(t *testing.T) {
+	driver := &memoryArtifactDriver{objects: map[string]string{}}
+	newDriver := func(context.Context, *wfv1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+		return driver, nil
+	}
+	repository := &wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Endpoint: "my-endpoint", Bucket: "my-bucket"}}}
+	c := cache.NewArtifactCache(repository, newDriver, nil, "whalesay-cache")
+	ctx := context.Background()
+
+	entry, err := c.Load(ctx, "hi-there-world")
+	require.NoError(t, err)
+	assert.Nil(t, entry)
+
+	outputs := &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "hello", Value: wfv1.AnyStringPtr("Hello world")}}}
+	err = c.Save(ctx, "hi-there-world", "my-node", outputs)
+	require.NoError(t, err)
+	assert.Contains(t, driver.objects, "memoization/whalesay-cache/hi-there-world.json")
+
+	entry, err = c.Load(ctx, "hi-there-world")
+	require.NoError(t, err)
+	assert.Equal(t, "my-node", entry.NodeID)
+	assert.Equal(t, outputs, entry.Outputs)
+
+	entries, err := c.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "my-node", entries["hi-there-world"].NodeID)
+
+	require.NoError(t, c.Delete(ctx, "hi-there-world"))
+	assert.Empty(t, driver.objects)
+}
+
+func TestCacheFactoryNotConfigured(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+
+	c := controller.cacheFactory.GetCache(cache.SQLCache, "whalesay-cache")
+	_, err := c.Load(context.Background(), "hi-there-world")
+	require.EqualError(t, err, `SQL cache "whalesay-cache" requires persistence to be configured`)
+}
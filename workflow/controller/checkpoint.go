@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+const checkpointVolumeName = "checkpoint"
+
+// setCheckpointKey defaults the key of the template's checkpoints to one unique to the node, and shared by its retries
+func (woc *wfOperationCtx) setCheckpointKey(tmpl *wfv1.Template, nodeName string) {
+	if tmpl.Checkpoint == nil || tmpl.Checkpoint.Key != "" {
+		return
+	}
+	nodeID := woc.wf.NodeID(nodeName)
+	// the nodes of retries are named after their retry node, e.g. "my-wf.step(1)"
+	if i := strings.LastIndex(nodeName, "("); i > 0 && strings.HasSuffix(nodeName, ")") {
+		if retryNode, err := woc.wf.GetNodeByName(nodeName[:i]); err == nil && retryNode.Type == wfv1.NodeTypeRetry {
+			nodeID = retryNode.ID
+		}
+	}
+	tmpl.Checkpoint.Key = path.Join(woc.wf.Name, "checkpoints", nodeID+".tgz")
+}
+
+// addCheckpointVolume mounts an empty volume at the checkpointed directory of the main container, and at the same path
+// under the main container's filesystem of the init container, which restores it. The wait container, which saves it,
+// gets the volume by mirroring the main container's volume mounts.
+func addCheckpointVolume(pod *apiv1.Pod, tmpl *wfv1.Template) {
+	if tmpl.Checkpoint == nil {
+		return
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, apiv1.Volume{
+		Name:         checkpointVolumeName,
+		VolumeSource: apiv1.VolumeSource{EmptyDir: &apiv1.EmptyDirVolumeSource{}},
+	})
+	for i, c := range pod.Spec.InitContainers {
+		if c.Name == common.InitContainerName {
+			c.VolumeMounts = append(c.VolumeMounts, apiv1.VolumeMount{
+				Name:      checkpointVolumeName,
+				MountPath: filepath.Join(common.ExecutorMainFilesystemDir, tmpl.Checkpoint.Path),
+			})
+			pod.Spec.InitContainers[i] = c
+		}
+	}
+	for i, c := range pod.Spec.Containers {
+		if c.Name == common.MainContainerName {
+			c.VolumeMounts = append(c.VolumeMounts, apiv1.VolumeMount{
+				Name:      checkpointVolumeName,
+				MountPath: tmpl.Checkpoint.Path,
+			})
+			pod.Spec.Containers[i] = c
+		}
+	}
+}
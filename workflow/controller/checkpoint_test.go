@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+const checkpointWorkflow = `
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    retryStrategy:
+      limit: "1"
+    checkpoint:
+      path: /checkpoint
+    container:
+      image: my-image
+`
+
+func podTemplate(t *testing.T, pod apiv1.Pod) wfv1.Template {
+	t.Helper()
+	for _, env := range pod.Spec.InitContainers[0].Env {
+		if env.Name == common.EnvVarTemplate {
+			var tmpl wfv1.Template
+			require.NoError(t, json.Unmarshal([]byte(env.Value), &tmpl))
+			return tmpl
+		}
+	}
+	t.Fatal("pod has no template")
+	return wfv1.Template{}
+}
+
+func volumeMountPath(c apiv1.Container, name string) string {
+	for _, m := range c.VolumeMounts {
+		if m.Name == name {
+			return m.MountPath
+		}
+	}
+	return ""
+}
+
+func TestCheckpoint(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(checkpointWorkflow)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	pods, err := listPods(woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	pod := pods.Items[0]
+
+	tmpl := podTemplate(t, pod)
+	require.NotNil(t, tmpl.Checkpoint)
+	assert.Equal(t, "my-wf/checkpoints/my-wf.tgz", tmpl.Checkpoint.Key)
+	assert.True(t, tmpl.ArchiveLocation.HasLocation())
+
+	assert.Contains(t, pod.Spec.Volumes, apiv1.Volume{Name: checkpointVolumeName, VolumeSource: apiv1.VolumeSource{EmptyDir: &apiv1.EmptyDirVolumeSource{}}})
+	assert.Equal(t, filepath.Join(common.ExecutorMainFilesystemDir, "/checkpoint"), volumeMountPath(pod.Spec.InitContainers[0], checkpointVolumeName))
+	for _, c := range pod.Spec.Containers {
+		switch c.Name {
+		case common.MainContainerName:
+			assert.Equal(t, "/checkpoint", volumeMountPath(c, checkpointVolumeName))
+		case common.WaitContainerName:
+			assert.Equal(t, filepath.Join(common.ExecutorMainFilesystemDir, "/checkpoint"), volumeMountPath(c, checkpointVolumeName))
+		}
+	}
+
+	// the retry restores from the same checkpoint
+	makePodsPhase(ctx, woc, apiv1.PodFailed)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	pods, err = listPods(woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 2)
+	for _, pod := range pods.Items {
+		assert.Equal(t, "my-wf/checkpoints/my-wf.tgz", podTemplate(t, pod).Checkpoint.Key)
+	}
+
+	// the checkpoints reported by the executor are recorded on the node
+	node := woc.wf.Status.Nodes.FindByName("my-wf(1)")
+	require.NotNil(t, node)
+	savedAt := metav1.Now()
+	_, err = controller.wfclientset.ArgoprojV1alpha1().WorkflowTaskResults("my-ns").Create(ctx, &wfv1.WorkflowTaskResult{
+		ObjectMeta: metav1.ObjectMeta{Name: node.ID, Namespace: "my-ns", Labels: map[string]string{common.LabelKeyWorkflow: "my-wf"}},
+		NodeResult: wfv1.NodeResult{Checkpoint: &wfv1.CheckpointStatus{Artifact: wfv1.Artifact{Name: "checkpoint"}, SavedAt: &savedAt}},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return len(controller.taskResultInformer.GetStore().List()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	node = woc.wf.Status.Nodes.FindByName("my-wf(1)")
+	require.NotNil(t, node)
+	require.NotNil(t, node.Checkpoint)
+	assert.Equal(t, "checkpoint", node.Checkpoint.Artifact.Name)
+	assert.NotNil(t, node.Checkpoint.SavedAt)
+}
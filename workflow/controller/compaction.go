@@ -0,0 +1,291 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+	wfcommon "github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// compactedNodeSuffix is appended to the name of an expanded step or task to name the node its nodes are compacted into,
+// e.g. "my-wf[0].my-step(compacted)" for the nodes "my-wf[0].my-step(0:foo)" and "my-wf[0].my-step(1:bar)"
+const compactedNodeSuffix = "(compacted)"
+
+// compactNodes collapses the completed, successful nodes of the fulfilled expanded steps and tasks of a large workflow
+// into Compacted nodes, saving their statuses to the workflow's artifact repository, to reduce the size of its status
+func (woc *wfOperationCtx) compactNodes(ctx context.Context) {
+	compaction := woc.controller.Config.NodeStatusCompaction
+	if !compaction.IsEnabled() || len(woc.wf.Status.Nodes) < compaction.GetMinNodes() {
+		return
+	}
+	for name, nodes := range woc.compactableNodes() {
+		if len(nodes) < compaction.GetMinChildren() {
+			continue
+		}
+		if err := woc.compact(ctx, name, nodes); err != nil {
+			woc.log.WithError(err).WithField("nodeName", name).Warn("Failed to compact nodes")
+		}
+	}
+}
+
+// compactableNodes returns the nodes that can be compacted, keyed by the name of the expanded step or task they are the
+// nodes of
+func (woc *wfOperationCtx) compactableNodes() map[string][]wfv1.NodeStatus {
+	compactable := make(map[string][]wfv1.NodeStatus)
+	for _, group := range woc.wf.Status.Nodes {
+		if (group.Type != wfv1.NodeTypeStepGroup && group.Type != wfv1.NodeTypeTaskGroup) || !group.Fulfilled() {
+			continue
+		}
+		// the nodes of an expanded step are named after it, e.g. "my-wf[0].my-step(0:foo)", and those of an expanded
+		// task after its TaskGroup node, e.g. "my-wf.my-task(0:foo)"
+		prefix := group.Name
+		if group.Type == wfv1.NodeTypeStepGroup {
+			prefix += "."
+		}
+		for _, childID := range group.Children {
+			child, err := woc.wf.Status.Nodes.Get(childID)
+			if err != nil || !strings.HasPrefix(child.Name, prefix) || !woc.isCompactable(child) {
+				continue
+			}
+			i := strings.Index(child.Name[len(prefix):], "(")
+			if i < 0 {
+				// the node of a step which was not expanded
+				continue
+			}
+			name := child.Name[:len(prefix)+i]
+			compactable[name] = append(compactable[name], *child)
+		}
+	}
+	return compactable
+}
+
+// isCompactable returns whether the node can be compacted: it must be a successful pod, whose outputs have been
+// reported, and which has no output artifacts, as those are garbage collected by looking at the nodes
+func (woc *wfOperationCtx) isCompactable(node *wfv1.NodeStatus) bool {
+	if node.Type != wfv1.NodeTypePod || node.Phase != wfv1.NodeSucceeded || woc.wf.Status.IsTaskResultIncomplete(node.ID) {
+		return false
+	}
+	if node.NodeFlag != nil && (node.NodeFlag.Hooked || node.NodeFlag.Retried) {
+		return false
+	}
+	if node.Outputs != nil && len(node.Outputs.Artifacts) > 0 {
+		return false
+	}
+	for _, childID := range node.Children {
+		child, err := woc.wf.Status.Nodes.Get(childID)
+		if err != nil || child.Type == wfv1.NodeTypeContainer || (child.NodeFlag != nil && child.NodeFlag.Hooked) {
+			return false
+		}
+	}
+	return true
+}
+
+// compact collapses the nodes of an expanded step or task into a Compacted node
+func (woc *wfOperationCtx) compact(ctx context.Context, name string, nodes []wfv1.NodeStatus) error {
+	compactedName := name + compactedNodeSuffix
+	compactedID := woc.wf.NodeID(compactedName)
+	if woc.wf.Status.Nodes.Has(compactedID) {
+		return fmt.Errorf("nodes of %s already compacted", name)
+	}
+	art, driver, err := woc.compactionArtifact(ctx, compactedID)
+	if err != nil {
+		return err
+	}
+	if err := util.SaveCompactedNodes(driver, art, nodes); err != nil {
+		return fmt.Errorf("could not save compacted nodes: %w", err)
+	}
+
+	first := nodes[0]
+	compacted := wfv1.NodeStatus{
+		ID:            compactedID,
+		Name:          compactedName,
+		DisplayName:   strings.SplitN(first.DisplayName, "(", 2)[0] + compactedNodeSuffix,
+		Type:          wfv1.NodeTypeCompacted,
+		TemplateName:  first.TemplateName,
+		TemplateRef:   first.TemplateRef,
+		TemplateScope: first.TemplateScope,
+		Phase:         wfv1.NodeSucceeded,
+		BoundaryID:    first.BoundaryID,
+		Message:       fmt.Sprintf("%d nodes compacted", len(nodes)),
+		Progress:      wfv1.ProgressZero,
+		Compacted:     &wfv1.CompactedNodes{Count: int32(len(nodes)), Artifact: *art},
+	}
+	ids := make(map[string]bool, len(nodes))
+	for i, node := range nodes {
+		ids[node.ID] = true
+		if i == 0 || node.StartedAt.Before(&compacted.StartedAt) {
+			compacted.StartedAt = node.StartedAt
+		}
+		if node.FinishedAt.After(compacted.FinishedAt.Time) {
+			compacted.FinishedAt = node.FinishedAt
+		}
+		duration := int64(node.FinishedAt.Sub(node.StartedAt.Time).Seconds())
+		if i == 0 || duration < compacted.Compacted.MinDurationSeconds {
+			compacted.Compacted.MinDurationSeconds = duration
+		}
+		if duration > compacted.Compacted.MaxDurationSeconds {
+			compacted.Compacted.MaxDurationSeconds = duration
+		}
+		if node.Progress.IsValid() {
+			compacted.Progress = compacted.Progress.Add(node.Progress)
+		}
+		compacted.ResourcesDuration = compacted.ResourcesDuration.Add(node.ResourcesDuration)
+		for _, child := range node.Children {
+			if !slices.Contains(compacted.Children, child) {
+				compacted.Children = append(compacted.Children, child)
+			}
+		}
+	}
+
+	woc.wf.Status.Nodes.Set(compactedID, compacted)
+	for id := range ids {
+		woc.wf.Status.Nodes.Delete(id)
+	}
+	for id, node := range woc.wf.Status.Nodes {
+		children, replacedChildren := replaceCompactedNodeIDs(node.Children, ids, compactedID)
+		outboundNodes, replacedOutboundNodes := replaceCompactedNodeIDs(node.OutboundNodes, ids, compactedID)
+		if replacedChildren || replacedOutboundNodes {
+			node.Children = children
+			node.OutboundNodes = outboundNodes
+			woc.wf.Status.Nodes.Set(id, node)
+		}
+	}
+	woc.controller.compactedNodes.Add(woc.compactedNodesKey(compactedID), nodes)
+	// the artifact is deleted by artifact GC when the workflow is deleted
+	if artifactGCEnabled && !slices.Contains(woc.wf.Finalizers, wfcommon.FinalizerArtifactGC) {
+		woc.wf.Finalizers = append(woc.wf.Finalizers, wfcommon.FinalizerArtifactGC)
+	}
+	woc.updated = true
+	woc.log.WithFields(log.Fields{"nodeName": compactedName, "count": len(nodes)}).Info("Compacted nodes")
+	return nil
+}
+
+// deleteCompactedNodes deletes the artifacts of the Compacted nodes of a deleted workflow. They are saved by the
+// controller, rather than by the workflow's pods, so are deleted by the controller, rather than by Artifact GC Pods.
+func (woc *wfOperationCtx) deleteCompactedNodes(ctx context.Context) {
+	for id, node := range woc.wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypeCompacted || node.Compacted == nil || node.Compacted.Artifact.Deleted {
+			continue
+		}
+		driver, err := woc.controller.artDriverFactory(ctx, &node.Compacted.Artifact, resource.New(woc.controller.kubeclientset, woc.wf.Namespace))
+		if err == nil {
+			err = driver.Delete(&node.Compacted.Artifact)
+		}
+		if err != nil {
+			msg := fmt.Sprintf("Failed to delete compacted nodes of %s: %v", node.Name, err)
+			woc.addArtGCCondition(msg)
+			woc.addArtGCEvent(msg)
+			continue
+		}
+		node.Compacted.Artifact.Deleted = true
+		woc.wf.Status.Nodes.Set(id, node)
+		woc.controller.compactedNodes.Remove(woc.compactedNodesKey(id))
+		woc.updated = true
+	}
+}
+
+// replaceCompactedNodeIDs replaces the IDs of compacted nodes with the ID of the node they were compacted into
+func replaceCompactedNodeIDs(nodeIDs []string, compactedIDs map[string]bool, compactedID string) ([]string, bool) {
+	replaced := false
+	var result []string
+	for _, id := range nodeIDs {
+		if !compactedIDs[id] {
+			result = append(result, id)
+		} else if !replaced {
+			result = append(result, compactedID)
+			replaced = true
+		}
+	}
+	return result, replaced
+}
+
+// compactionArtifact returns the artifact the compacted nodes of a node are saved to, and its driver
+func (woc *wfOperationCtx) compactionArtifact(ctx context.Context, nodeID string) (*wfv1.Artifact, common.ArtifactDriver, error) {
+	location := woc.artifactRepository.ToArtifactLocation()
+	if location == nil || location.SetKey(path.Join(woc.wf.Name, "compacted", nodeID+".json")) != nil || !location.HasLocation() {
+		return nil, nil, fmt.Errorf("node status compaction requires an artifact repository to be configured")
+	}
+	art := &wfv1.Artifact{Name: "compacted-nodes", ArtifactLocation: *location}
+	driver, err := woc.controller.artDriverFactory(ctx, art, resource.New(woc.controller.kubeclientset, woc.wf.Namespace))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create artifact driver for compacted nodes: %w", err)
+	}
+	return art, driver, nil
+}
+
+// expandCompactedNodes replaces any Compacted nodes with the nodes collapsed into them
+func (woc *wfOperationCtx) expandCompactedNodes(ctx context.Context, nodes []wfv1.NodeStatus) ([]wfv1.NodeStatus, error) {
+	if !slices.ContainsFunc(nodes, func(node wfv1.NodeStatus) bool { return node.Type == wfv1.NodeTypeCompacted }) {
+		return nodes, nil
+	}
+	var expanded []wfv1.NodeStatus
+	for _, node := range nodes {
+		if node.Type != wfv1.NodeTypeCompacted {
+			expanded = append(expanded, node)
+			continue
+		}
+		compactedNodes, err := woc.loadCompactedNodes(ctx, node)
+		if err != nil {
+			return nil, err
+		}
+		expanded = append(expanded, compactedNodes...)
+	}
+	return expanded, nil
+}
+
+// loadCompactedNodes loads the nodes collapsed into a Compacted node, which are cached as they are needed by each
+// operation on the workflow to aggregate the outputs of the expanded step or task
+func (woc *wfOperationCtx) loadCompactedNodes(ctx context.Context, node wfv1.NodeStatus) ([]wfv1.NodeStatus, error) {
+	if nodes, ok := woc.controller.compactedNodes.Get(woc.compactedNodesKey(node.ID)); ok {
+		return nodes.([]wfv1.NodeStatus), nil
+	}
+	if node.Compacted == nil {
+		return nil, fmt.Errorf("node %s is not compacted", node.ID)
+	}
+	driver, err := woc.controller.artDriverFactory(ctx, &node.Compacted.Artifact, resource.New(woc.controller.kubeclientset, woc.wf.Namespace))
+	if err != nil {
+		return nil, fmt.Errorf("could not create artifact driver for compacted nodes: %w", err)
+	}
+	nodes, err := util.LoadCompactedNodes(driver, node)
+	if err != nil {
+		return nil, err
+	}
+	woc.controller.compactedNodes.Add(woc.compactedNodesKey(node.ID), nodes)
+	return nodes, nil
+}
+
+// compactedNodesKey is the key of the nodes collapsed into a Compacted node in the controller's cache, as node IDs are
+// only unique within a workflow
+func (woc *wfOperationCtx) compactedNodesKey(nodeID string) string {
+	return string(woc.wf.UID) + "/" + nodeID
+}
+
+// isCompactedPod returns whether the node of a pod was compacted, given the prefixes of the names of the nodes of the
+// workflow's compacted steps and tasks
+func isCompactedPod(pod *apiv1.Pod, compactedPrefixes []string) bool {
+	nodeName := pod.Annotations[wfcommon.AnnotationKeyNodeName]
+	return nodeName != "" && slices.ContainsFunc(compactedPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(nodeName, prefix)
+	})
+}
+
+// compactedNodePrefixes returns the prefixes of the names of the nodes of the workflow's compacted steps and tasks
+func (woc *wfOperationCtx) compactedNodePrefixes() []string {
+	var prefixes []string
+	for _, node := range woc.wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypeCompacted {
+			prefixes = append(prefixes, strings.TrimSuffix(node.Name, compactedNodeSuffix)+"(")
+		}
+	}
+	return prefixes
+}
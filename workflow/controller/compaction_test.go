@@ -0,0 +1,160 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+	wfcommon "github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+const compactionWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: compaction
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: hello
+        template: hello
+        withItems: [a, b, c]
+    - - name: wait
+        template: hello
+    - - name: print
+        template: print
+        arguments:
+          parameters:
+          - name: messages
+            value: "{{steps.hello.outputs.parameters.message}}"
+  - name: hello
+    container:
+      image: busybox
+    outputs:
+      parameters:
+      - name: message
+        valueFrom:
+          path: /tmp/message
+  - name: print
+    inputs:
+      parameters:
+      - name: messages
+    container:
+      image: busybox
+`
+
+func TestCompactNodes(t *testing.T) {
+	ctx := context.Background()
+	driver := &memoryArtifactDriver{objects: map[string]string{}}
+	wf := wfv1.MustUnmarshalWorkflow(compactionWorkflow)
+	cancel, controller := newController(wf, func(wfc *WorkflowController) {
+		wfc.Config.NodeStatusCompaction = &config.NodeStatusCompaction{Enabled: true, MinNodes: 1, MinChildren: 2}
+		wfc.artDriverFactory = func(context.Context, *wfv1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+			return driver, nil
+		}
+	})
+	defer cancel()
+	// complete completes the running pods, and operates on the workflow again
+	complete := func(woc *wfOperationCtx, taskResults int) *wfOperationCtx {
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("hi")}}}))
+		require.Eventually(t, func() bool {
+			return len(controller.taskResultInformer.GetStore().List()) == taskResults
+		}, 5*time.Second, 10*time.Millisecond)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		return woc
+	}
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	woc = complete(woc, 3)
+
+	compacted, err := woc.wf.GetNodeByName("compaction[0].hello(compacted)")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeTypeCompacted, compacted.Type)
+	assert.Equal(t, wfv1.NodeSucceeded, compacted.Phase)
+	assert.Equal(t, "hello(compacted)", compacted.DisplayName)
+	assert.Equal(t, "3 nodes compacted", compacted.Message)
+	require.NotNil(t, compacted.Compacted)
+	assert.Equal(t, int32(3), compacted.Compacted.Count)
+	assert.Len(t, driver.objects, 1)
+	for _, name := range []string{"compaction[0].hello(0:a)", "compaction[0].hello(1:b)", "compaction[0].hello(2:c)"} {
+		_, err := woc.wf.GetNodeByName(name)
+		assert.Error(t, err, name)
+	}
+	group, err := woc.wf.GetNodeByName("compaction[0]")
+	require.NoError(t, err)
+	assert.Equal(t, []string{compacted.ID}, group.Children)
+	wait, err := woc.wf.GetNodeByName("compaction[1].wait")
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodePending, wait.Phase)
+
+	t.Run("AggregatedOutputs", func(t *testing.T) {
+		// outputs are aggregated from the artifact once the compacted nodes are no longer cached
+		controller.compactedNodes.Clear()
+		woc = complete(woc, 4)
+		printNode, err := woc.wf.GetNodeByName("compaction[2].print")
+		require.NoError(t, err)
+		require.NotNil(t, printNode.Inputs)
+		assert.JSONEq(t, `["hi","hi","hi"]`, printNode.Inputs.Parameters[0].Value.String())
+		woc = complete(woc, 5)
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	})
+
+	t.Run("CompactedPods", func(t *testing.T) {
+		prefixes := woc.compactedNodePrefixes()
+		assert.Equal(t, []string{"compaction[0].hello("}, prefixes)
+		pod := func(nodeName string) *apiv1.Pod {
+			return &apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{wfcommon.AnnotationKeyNodeName: nodeName}}}
+		}
+		assert.True(t, isCompactedPod(pod("compaction[0].hello(1:b)"), prefixes))
+		assert.False(t, isCompactedPod(pod("compaction[1].wait"), prefixes))
+	})
+
+	t.Run("DeletedWithWorkflow", func(t *testing.T) {
+		assert.Contains(t, woc.wf.Finalizers, wfcommon.FinalizerArtifactGC)
+		woc.wf.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		assert.False(t, woc.allArtifactsDeleted())
+		woc.deleteCompactedNodes(ctx)
+		assert.Empty(t, driver.objects)
+		compacted, err := woc.wf.GetNodeByName("compaction[0].hello(compacted)")
+		require.NoError(t, err)
+		assert.True(t, compacted.Compacted.Artifact.Deleted)
+		assert.True(t, woc.allArtifactsDeleted())
+	})
+}
+
+func TestCompactNodesMinChildren(t *testing.T) {
+	ctx := context.Background()
+	wf := wfv1.MustUnmarshalWorkflow(compactionWorkflow)
+	cancel, controller := newController(wf, func(wfc *WorkflowController) {
+		wfc.Config.NodeStatusCompaction = &config.NodeStatusCompaction{Enabled: true, MinNodes: 1, MinChildren: 4}
+	})
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("hi")}}}))
+	require.Eventually(t, func() bool {
+		return len(controller.taskResultInformer.GetStore().List()) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	_, err := woc.wf.GetNodeByName("compaction[0].hello(compacted)")
+	require.Error(t, err)
+	_, err = woc.wf.GetNodeByName("compaction[0].hello(0:a)")
+	require.NoError(t, err)
+	_, err = woc.wf.GetNodeByName("compaction[1].wait")
+	require.NoError(t, err)
+}
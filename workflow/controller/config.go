@@ -12,6 +12,7 @@ import (
 
 	"github.com/argoproj/argo-workflows/v3"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
@@ -27,6 +28,7 @@ func (wfc *WorkflowController) updateConfig() error {
 	wfc.offloadNodeStatusRepo = sqldb.ExplosiveOffloadNodeStatusRepo
 	wfc.wfArchive = sqldb.NullWorkflowArchive
 	wfc.archiveLabelSelector = labels.Everything()
+	wfc.templateRevisions = nil
 
 	persistence := wfc.Config.Persistence
 	if persistence != nil {
@@ -44,6 +46,7 @@ func (wfc *WorkflowController) updateConfig() error {
 			wfc.session = session
 		}
 		sqldb.ConfigureDBSession(wfc.session, persistence.ConnectionPool)
+		wfc.templateRevisions = sqldb.NewTemplateRevisionRepo(wfc.session)
 		if persistence.NodeStatusOffload {
 			wfc.offloadNodeStatusRepo, err = sqldb.NewOffloadNodeStatusRepo(wfc.session, persistence.GetClusterName(), tableName)
 			if err != nil {
@@ -69,6 +72,7 @@ func (wfc *WorkflowController) updateConfig() error {
 		log.Info("Persistence configuration disabled")
 	}
 
+	wfc.configureCacheFactory()
 	wfc.hydrator = hydrator.New(wfc.offloadNodeStatusRepo)
 	wfc.updateEstimatorFactory()
 	wfc.rateLimiter = wfc.newRateLimiter()
@@ -81,6 +85,19 @@ func (wfc *WorkflowController) updateConfig() error {
 	return nil
 }
 
+// configureCacheFactory configures where SQL and artifact memoization caches are held
+func (wfc *WorkflowController) configureCacheFactory() {
+	var memoizationCacheRepo sqldb.MemoizationCacheRepo
+	if wfc.session != nil {
+		memoizationCacheRepo = sqldb.NewMemoizationCacheRepo(wfc.session)
+	}
+	var artifactRepository *wfv1.ArtifactRepository
+	if wfc.Config.ArtifactRepository.Get() != nil {
+		artifactRepository = &wfc.Config.ArtifactRepository
+	}
+	wfc.cacheFactory.Configure(memoizationCacheRepo, artifactRepository)
+}
+
 // initDB inits argo DB tables
 func (wfc *WorkflowController) initDB() error {
 	persistence := wfc.Config.Persistence
@@ -37,6 +37,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	apiwatch "k8s.io/client-go/tools/watch"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/lru"
 
 	"github.com/argoproj/argo-workflows/v3"
 	"github.com/argoproj/argo-workflows/v3/config"
@@ -55,6 +56,7 @@ import (
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/entrypoint"
@@ -135,15 +137,23 @@ type WorkflowController struct {
 	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
 	hydrator              hydrator.Interface
 	wfArchive             sqldb.WorkflowArchive
+	templateRevisions     sqldb.TemplateRevisionRepo
 	estimatorFactory      estimation.EstimatorFactory
 	syncManager           *sync.Manager
 	metrics               *metrics.Metrics
+	tracing               *telemetry.Tracing
 	eventRecorderManager  events.EventRecorderManager
 	archiveLabelSelector  labels.Selector
 	cacheFactory          controllercache.Factory
-	wfTaskSetInformer     wfextvv1alpha1.WorkflowTaskSetInformer
-	artGCTaskInformer     wfextvv1alpha1.WorkflowArtifactGCTaskInformer
-	taskResultInformer    cache.SharedIndexInformer
+	artDriverFactory      util.NewArtifactDriverFunc
+	// compactedNodes caches the nodes collapsed into Compacted nodes, see compactNodes
+	compactedNodes     *lru.Cache
+	wfTaskSetInformer  wfextvv1alpha1.WorkflowTaskSetInformer
+	artGCTaskInformer  wfextvv1alpha1.WorkflowArtifactGCTaskInformer
+	taskResultInformer cache.SharedIndexInformer
+	// quotaInformer is only started once resource quotas are needed, see startQuotaInformer
+	quotaInformer     cache.SharedIndexInformer
+	quotaInformerOnce gosync.Once
 
 	// progressPatchTickDuration defines how often the executor will patch pod annotations if an updated progress is found.
 	// Default is 1m and can be configured using the env var ARGO_PROGRESS_PATCH_TICK_DURATION.
@@ -163,6 +173,7 @@ const (
 	clusterWorkflowTemplateResyncPeriod = 20 * time.Minute
 	workflowExistenceCheckPeriod        = 1 * time.Minute
 	workflowTaskSetResyncPeriod         = 20 * time.Minute
+	quotaResyncPeriod                   = 20 * time.Minute
 )
 
 var (
@@ -176,6 +187,10 @@ var (
 	// believe it cannot run. By delaying for 1s, we would have finished the semaphore counter
 	// updates, and the next workflow will see the updated availability.
 	semaphoreNotifyDelay = env.LookupEnvDurationOr("SEMAPHORE_NOTIFY_DELAY", time.Second)
+
+	// quotaAdmissionRetryPeriod is how long to wait before checking again whether a workflow fits within the headroom
+	// of its namespace's resource quotas
+	quotaAdmissionRetryPeriod = env.LookupEnvDurationOr("QUOTA_ADMISSION_RETRY_PERIOD", 10*time.Second)
 )
 
 func init() {
@@ -204,6 +219,8 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 		configController:           config.NewController(namespace, configMap, kubeclientset),
 		workflowKeyLock:            syncpkg.NewKeyLock(),
 		cacheFactory:               controllercache.NewCacheFactory(kubeclientset, namespace),
+		artDriverFactory:           artifact.NewDriver,
+		compactedNodes:             lru.New(64),
 		eventRecorderManager:       events.NewEventRecorderManager(kubeclientset),
 		progressPatchTickDuration:  env.LookupEnvDurationOr(common.EnvVarProgressPatchTickDuration, 1*time.Minute),
 		progressFileTickDuration:   env.LookupEnvDurationOr(common.EnvVarProgressFileTickDuration, 3*time.Second),
@@ -227,6 +244,10 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 		})
 	deprecation.Initialize(wfc.metrics.Metrics.DeprecatedFeature)
 
+	if err != nil {
+		return nil, err
+	}
+	wfc.tracing, err = telemetry.NewTracing(ctx, `workflows-controller`)
 	if err != nil {
 		return nil, err
 	}
@@ -243,10 +264,14 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 
 func (wfc *WorkflowController) newThrottler() sync.Throttler {
 	f := func(key string) { wfc.wfQueue.AddRateLimited(key) }
-	return sync.ChainThrottler{
+	throttler := sync.ChainThrottler{
 		sync.NewThrottler(wfc.Config.Parallelism, sync.SingleBucket, f),
 		sync.NewThrottler(wfc.Config.NamespaceParallelism, sync.NamespaceBucket, f),
 	}
+	if wfc.Config.ResourceQuotaAdmission {
+		throttler = append(throttler, sync.NewQuotaThrottler(wfc.workflowQuotaDemand, wfc.namespaceQuotaHeadroom, f, wfc.wfQueue.AddAfter, quotaAdmissionRetryPeriod))
+	}
+	return throttler
 }
 
 // runGCcontroller runs the workflow garbage collector controller
@@ -292,6 +317,7 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 
 	defer wfc.wfQueue.ShutDown()
 	defer wfc.podCleanupQueue.ShutDown()
+	defer wfc.tracing.Shutdown(context.Background())
 
 	log.WithField("version", argo.GetVersion().Version).
 		WithField("defaultRequeueTime", GetRequeueTime()).
@@ -313,13 +339,18 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	if err != nil {
 		log.Fatal(err)
 	}
+	if err := wfc.addTemplateRevisionHandler(wfc.wftmplInformer.Informer(), wfc.recordWorkflowTemplateRevision); err != nil {
+		log.Fatal(err)
+	}
 	wfc.podInformer = wfc.newPodInformer(ctx)
 	wfc.updateEstimatorFactory()
 
 	wfc.configMapInformer = wfc.newConfigMapInformer()
 
 	// Create Synchronization Manager
-	wfc.createSynchronizationManager(ctx)
+	if err := wfc.createSynchronizationManager(ctx); err != nil {
+		log.Fatal(err)
+	}
 	// init managers: throttler and SynchronizationManager
 	if err := wfc.initManagers(ctx); err != nil {
 		log.Fatal(err)
@@ -329,6 +360,10 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 		go wfc.runConfigMapWatcher(ctx)
 	}
 
+	// workflows are admitted by the throttler as soon as the workflow informer sees them
+	if wfc.Config.ResourceQuotaAdmission && !wfc.startQuotaInformer(ctx) {
+		log.Fatal("Timed out waiting for resource quota cache to sync")
+	}
 	go wfc.wfInformer.Run(ctx.Done())
 	go wfc.wftmplInformer.Informer().Run(ctx.Done())
 	go wfc.podInformer.Run(ctx.Done())
@@ -357,6 +392,7 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	}
 	go wfc.workflowGarbageCollector(ctx)
 	go wfc.archivedWorkflowGarbageCollector(ctx)
+	go wfc.artifactRetentionCollector(ctx)
 
 	go wfc.runGCcontroller(ctx, workflowTTLWorkers)
 	go wfc.runCronController(ctx, cronWorkflowWorkers)
@@ -380,7 +416,7 @@ func (wfc *WorkflowController) RunPrometheusServer(ctx context.Context, isDummy
 }
 
 // Create and the Synchronization Manager
-func (wfc *WorkflowController) createSynchronizationManager(ctx context.Context) {
+func (wfc *WorkflowController) createSynchronizationManager(ctx context.Context) error {
 	getSyncLimit := func(lockKey string) (int, error) {
 		lockName, err := sync.DecodeLockName(lockKey)
 		if err != nil {
@@ -412,6 +448,14 @@ func (wfc *WorkflowController) createSynchronizationManager(ctx context.Context)
 	}
 
 	wfc.syncManager = sync.NewLockManager(getSyncLimit, nextWorkflow, isWFDeleted)
+
+	if syncConfig := wfc.Config.Synchronization; syncConfig != nil {
+		if wfc.session == nil {
+			return fmt.Errorf("synchronization requires persistence to be configured")
+		}
+		return wfc.syncManager.EnableDatabase(ctx, sqldb.NewSyncRepo(wfc.session), syncConfig)
+	}
+	return nil
 }
 
 // list all running workflows to initialize throttler and syncManager
@@ -497,6 +541,9 @@ func (wfc *WorkflowController) createClusterWorkflowTemplateInformer(ctx context
 
 	if cwftGetAllowed && cwftListAllowed && cwftWatchAllowed {
 		wfc.cwftmplInformer = informer.NewTolerantClusterWorkflowTemplateInformer(wfc.dynamicInterface, clusterWorkflowTemplateResyncPeriod)
+		if err := wfc.addTemplateRevisionHandler(wfc.cwftmplInformer.Informer(), wfc.recordClusterWorkflowTemplateRevision); err != nil {
+			log.Fatal(err)
+		}
 		go wfc.cwftmplInformer.Informer().Run(ctx.Done())
 
 		// since the above call is asynchronous, make sure we populate our cache before we try to use it later
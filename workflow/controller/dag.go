@@ -15,8 +15,8 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
 	"github.com/argoproj/argo-workflows/v3/util/template"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
-	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
 
 // dagContext holds context information about this context's DAG
@@ -127,6 +127,38 @@ func (d *dagContext) getTaskNode(taskName string) *wfv1.NodeStatus {
 	return node
 }
 
+// outputsDAG returns whether a task node succeeded and generated a DAG to be spliced in after it (see outputs.dag)
+func outputsDAG(node *wfv1.NodeStatus) bool {
+	return node.Phase == wfv1.NodeSucceeded && node.Outputs.HasDAG()
+}
+
+// dynamicDAGNodeName formulates the name of the DAG node spliced in for a task node which generated a DAG
+func dynamicDAGNodeName(taskNodeName string) string {
+	return taskNodeName + dynamicDAGNodeSuffix
+}
+
+const dynamicDAGNodeSuffix = ".dag"
+
+// taskResultNodeID returns the ID of the node which determines the result of a task. This is the task node itself,
+// unless the task generated a DAG, in which case it is the DAG node spliced in for it.
+func (d *dagContext) taskResultNodeID(taskName string) string {
+	nodeID := d.taskNodeID(taskName)
+	if node, err := d.wf.Status.Nodes.Get(nodeID); err == nil && outputsDAG(node) {
+		return d.wf.NodeID(dynamicDAGNodeName(node.Name))
+	}
+	return nodeID
+}
+
+// getTaskResultNode returns the node which determines the result of a task, see taskResultNodeID.
+// It returns nil when that node does not exist yet.
+func (d *dagContext) getTaskResultNode(taskName string) *wfv1.NodeStatus {
+	node, err := d.wf.Status.Nodes.Get(d.taskResultNodeID(taskName))
+	if err != nil {
+		return nil
+	}
+	return node
+}
+
 // assessDAGPhase assesses the overall DAG status
 func (d *dagContext) assessDAGPhase(targetTasks []string, nodes wfv1.Nodes, isShutdown bool) (wfv1.NodePhase, error) {
 	// We cannot only rely on the DAG traversal. Conditionals, self-references,
@@ -143,7 +175,7 @@ func (d *dagContext) assessDAGPhase(targetTasks []string, nodes wfv1.Nodes, isSh
 	// will take precedence as the branch phase for their descendents.
 	targetTaskPhases := make(map[string]wfv1.NodePhase)
 	for _, task := range targetTasks {
-		targetTaskPhases[d.taskNodeID(task)] = ""
+		targetTaskPhases[d.taskResultNodeID(task)] = ""
 	}
 
 	boundaryNode, err := nodes.Get(d.boundaryID)
@@ -195,7 +227,7 @@ func (d *dagContext) assessDAGPhase(targetTasks []string, nodes wfv1.Nodes, isSh
 	failFast := d.tmpl.DAG.FailFast == nil || *d.tmpl.DAG.FailFast
 	result := wfv1.NodeSucceeded
 	for _, depName := range targetTasks {
-		branchPhase := targetTaskPhases[d.taskNodeID(depName)]
+		branchPhase := targetTaskPhases[d.taskResultNodeID(depName)]
 		if branchPhase == "" {
 			result = wfv1.NodeRunning
 			// If failFast is disabled, we will want to let all tasks complete before checking for failures
@@ -275,7 +307,7 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 
 		if taskNode != nil {
 			task := dagCtx.GetTask(taskName)
-			scope, err := woc.buildLocalScopeFromTask(dagCtx, task)
+			scope, err := woc.buildLocalScopeFromTask(ctx, dagCtx, task)
 			if err != nil {
 				woc.markNodeError(node.Name, err)
 				return node, err
@@ -339,7 +371,7 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 				}
 				childNodes[i] = *childNode
 			}
-			err := woc.processAggregateNodeOutputs(scope, prefix, childNodes)
+			err := woc.processAggregateNodeOutputs(ctx, scope, prefix, childNodes)
 			if err != nil {
 				woc.log.Errorf("unable to processAggregateNodeOutputs")
 				return nil, errors.InternalWrapError(err)
@@ -363,7 +395,7 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 		woc.wf.Status.Nodes.Set(node.ID, *node)
 	}
 	if node.MemoizationStatus != nil {
-		c := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, node.MemoizationStatus.CacheName)
+		c := woc.controller.cacheFactory.GetCache(node.MemoizationStatus.GetCacheType(), node.MemoizationStatus.CacheName)
 		err := c.Save(ctx, node.MemoizationStatus.Key, node.ID, node.Outputs)
 		if err != nil {
 			woc.log.WithFields(log.Fields{"nodeID": node.ID}).WithError(err).Error("Failed to save node outputs to cache")
@@ -382,7 +414,7 @@ func (woc *wfOperationCtx) updateOutboundNodesForTargetTasks(dagCtx *dagContext,
 	// set the outbound nodes from the target tasks
 	outbound := make([]string, 0)
 	for _, depName := range targetTasks {
-		depNode := dagCtx.getTaskNode(depName)
+		depNode := dagCtx.getTaskResultNode(depName)
 		if depNode == nil {
 			woc.log.Println(depName)
 			continue
@@ -412,7 +444,7 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 	task := dagCtx.GetTask(taskName)
 	log := woc.log.WithField("taskName", taskName)
 	if node != nil && (node.Fulfilled() || node.Phase == wfv1.NodeRunning) {
-		scope, err := woc.buildLocalScopeFromTask(dagCtx, task)
+		scope, err := woc.buildLocalScopeFromTask(ctx, dagCtx, task)
 		if err != nil {
 			log.Error("Failed to build local scope from task")
 			woc.markNodeError(node.Name, err)
@@ -457,7 +489,11 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 			woc.controller.syncManager.Release(ctx, woc.wf, node.ID, processedTmpl.Synchronization)
 		}
 
-		scope, err := woc.buildLocalScopeFromTask(dagCtx, task)
+		if outputsDAG(node) {
+			woc.executeDynamicDAG(ctx, dagCtx, node)
+		}
+
+		scope, err := woc.buildLocalScopeFromTask(ctx, dagCtx, task)
 		if err != nil {
 			woc.markNodeError(node.Name, err)
 			log.Error("Failed to build local scope from task")
@@ -501,7 +537,7 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 		} else {
 			// Otherwise, add all outbound nodes of our dependencies as parents to this node
 			for _, depName := range taskDependencies {
-				depNode := dagCtx.getTaskNode(depName)
+				depNode := dagCtx.getTaskResultNode(depName)
 				outboundNodeIDs := woc.getOutboundNodes(depNode.ID)
 				for _, outNodeID := range outboundNodeIDs {
 					nodeName, err := woc.wf.Status.Nodes.GetName(outNodeID)
@@ -540,7 +576,7 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 
 	// All our dependencies were satisfied and successful. It's our turn to run
 	// First resolve/substitute params/artifacts from our dependencies
-	newTask, err := woc.resolveDependencyReferences(dagCtx, task)
+	newTask, err := woc.resolveDependencyReferences(ctx, dagCtx, task)
 	if err != nil {
 		woc.initializeNode(nodeName, wfv1.NodeTypeSkipped, dagTemplateScope, task, dagCtx.boundaryID, wfv1.NodeError, &wfv1.NodeFlag{}, err.Error())
 		connectDependencies(nodeName)
@@ -571,6 +607,17 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 		}
 	}
 
+	// The pods of a gang are only created once enough of them can be placed
+	if taskGroupNode != nil {
+		nodeNames := make([]string, len(expandedTasks))
+		for i, t := range expandedTasks {
+			nodeNames[i] = dagCtx.taskNodeName(t.Name)
+		}
+		if !woc.waitForGang(ctx, dagCtx.tmplCtx, task, taskGroupNode.Name, nodeNames) {
+			return
+		}
+	}
+
 	for _, t := range expandedTasks {
 		taskNodeName := dagCtx.taskNodeName(t.Name)
 		node = dagCtx.getTaskNode(t.Name)
@@ -593,7 +640,12 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 		}
 
 		// Finally execute the template
-		node, err = woc.executeTemplate(ctx, taskNodeName, &t, dagCtx.tmplCtx, t.Arguments, &executeTemplateOpts{boundaryID: dagCtx.boundaryID, onExitTemplate: dagCtx.onExitTemplate})
+		opts := &executeTemplateOpts{boundaryID: dagCtx.boundaryID, onExitTemplate: dagCtx.onExitTemplate}
+		if t.Loop != nil {
+			node, err = woc.executeLoop(ctx, taskNodeName, &t, t.Loop, dagCtx.tmplCtx, t.Arguments, opts)
+		} else {
+			node, err = woc.executeTemplate(ctx, taskNodeName, &t, dagCtx.tmplCtx, t.Arguments, opts)
+		}
 		if err != nil {
 			switch err {
 			case ErrDeadlineExceeded:
@@ -613,7 +665,7 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 			return
 		}
 		if node.Completed() {
-			scope, err := woc.buildLocalScopeFromTask(dagCtx, task)
+			scope, err := woc.buildLocalScopeFromTask(ctx, dagCtx, task)
 			if err != nil {
 				woc.markNodeError(node.Name, err)
 			}
@@ -643,7 +695,73 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 	}
 }
 
-func (woc *wfOperationCtx) buildLocalScopeFromTask(dagCtx *dagContext, task *wfv1.DAGTask) (*wfScope, error) {
+// executeDynamicDAG splices the DAG generated by a task into the workflow as a DAG node following the task's node,
+// and executes it
+func (woc *wfOperationCtx) executeDynamicDAG(ctx context.Context, dagCtx *dagContext, node *wfv1.NodeStatus) {
+	nodeName := dynamicDAGNodeName(node.Name)
+	dagNode, err := woc.wf.GetNodeByName(nodeName)
+	if err == nil && dagNode.Fulfilled() {
+		return
+	}
+	tmpl, err := woc.parseDynamicDAG(node.Outputs.DAG)
+	if err != nil {
+		woc.initializeNode(nodeName, wfv1.NodeTypeSkipped, dagCtx.tmplCtx.GetTemplateScope(), &wfv1.DAGTask{Name: nodeName}, dagCtx.boundaryID, wfv1.NodeError, &wfv1.NodeFlag{}, err.Error())
+	} else {
+		_, err = woc.executeTemplate(ctx, nodeName, &wfv1.DAGTask{Name: nodeName, Inline: tmpl}, dagCtx.tmplCtx, wfv1.Arguments{}, &executeTemplateOpts{boundaryID: dagCtx.boundaryID, onExitTemplate: dagCtx.onExitTemplate})
+		switch err {
+		case nil, ErrDeadlineExceeded, ErrParallelismReached, ErrMaxDepthExceeded:
+		case ErrTimeout:
+			_ = woc.markNodePhase(nodeName, wfv1.NodeFailed, err.Error())
+		default:
+			_ = woc.markNodeError(nodeName, fmt.Errorf("dag '%s' errored: %v", nodeName, err))
+		}
+	}
+	// The DAG node is connected to the task's outbound nodes, as its dependants would be. This keeps it out of the
+	// children of a Retry node, which are all taken to be attempts.
+	if _, err := woc.wf.GetNodeByName(nodeName); err == nil {
+		for _, outboundNodeID := range woc.getOutboundNodes(node.ID) {
+			outboundNodeName, err := woc.wf.Status.Nodes.GetName(outboundNodeID)
+			if err != nil {
+				woc.log.Errorf("was unable to obtain node for %s", outboundNodeID)
+				return
+			}
+			woc.addChildNode(outboundNodeName, nodeName)
+		}
+	}
+}
+
+// getDynamicDAGTemplate returns the template of a DAG node spliced in for a task which generated a DAG. Such nodes
+// cannot be resolved by name, so the template is rebuilt from the outputs of the task. It returns nil for other nodes.
+func (woc *wfOperationCtx) getDynamicDAGTemplate(node *wfv1.NodeStatus) (*wfv1.Template, error) {
+	if node.Type != wfv1.NodeTypeDAG || !strings.HasSuffix(node.Name, dynamicDAGNodeSuffix) {
+		return nil, nil
+	}
+	taskNode, err := woc.wf.GetNodeByName(strings.TrimSuffix(node.Name, dynamicDAGNodeSuffix))
+	if err != nil || !outputsDAG(taskNode) {
+		return nil, nil
+	}
+	return woc.parseDynamicDAG(taskNode.Outputs.DAG)
+}
+
+// parseDynamicDAG parses and validates a DAG generated by a task. When the controller restricts workflows to
+// referenced templates, the DAG may only run templates the workflow's spec already runs.
+func (woc *wfOperationCtx) parseDynamicDAG(output *wfv1.DAGOutput) (*wfv1.Template, error) {
+	tmpl := &wfv1.Template{Name: "dag", DAG: &wfv1.DAGTemplate{}}
+	if err := json.Unmarshal([]byte(output.Value), tmpl.DAG); err != nil {
+		return nil, errors.Errorf(errors.CodeBadRequest, "outputs.dag could not be parsed as a DAG: %v", err)
+	}
+	if err := validate.ValidateDynamicDAG(tmpl); err != nil {
+		return nil, errors.Errorf(errors.CodeBadRequest, "invalid outputs.dag: %v", err)
+	}
+	if woc.controller.Config.WorkflowRestrictions.MustUseReference() {
+		if err := validate.ValidateDynamicDAGReferences(tmpl, &woc.execWf.Spec); err != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "invalid outputs.dag: %v", err)
+		}
+	}
+	return tmpl, nil
+}
+
+func (woc *wfOperationCtx) buildLocalScopeFromTask(ctx context.Context, dagCtx *dagContext, task *wfv1.DAGTask) (*wfScope, error) {
 	// build up the scope
 	scope := createScope(dagCtx.tmpl)
 	woc.addOutputsToLocalScope("workflow", woc.wf.Status.Outputs, scope)
@@ -671,7 +789,7 @@ func (woc *wfOperationCtx) buildLocalScopeFromTask(dagCtx *dagContext, task *wfv
 				woc.updated = true
 			}
 
-			err = woc.processAggregateNodeOutputs(scope, prefix, ancestorNodes)
+			err = woc.processAggregateNodeOutputs(ctx, scope, prefix, ancestorNodes)
 			if err != nil {
 				return nil, errors.InternalWrapError(err)
 			}
@@ -684,8 +802,8 @@ func (woc *wfOperationCtx) buildLocalScopeFromTask(dagCtx *dagContext, task *wfv
 
 // resolveDependencyReferences replaces any references to outputs of task dependencies, or artifacts in the inputs
 // NOTE: by now, input parameters should have been substituted throughout the template
-func (woc *wfOperationCtx) resolveDependencyReferences(dagCtx *dagContext, task *wfv1.DAGTask) (*wfv1.DAGTask, error) {
-	scope, err := woc.buildLocalScopeFromTask(dagCtx, task)
+func (woc *wfOperationCtx) resolveDependencyReferences(ctx context.Context, dagCtx *dagContext, task *wfv1.DAGTask) (*wfv1.DAGTask, error) {
+	scope, err := woc.buildLocalScopeFromTask(ctx, dagCtx, task)
 	if err != nil {
 		return nil, err
 	}
@@ -857,6 +975,13 @@ func (d *dagContext) evaluateDependsLogic(taskName string) (bool, bool, error) {
 		if depNode == nil || !depNode.Fulfilled() || !common.CheckAllHooksFullfilled(depNode, d.wf.Status.Nodes) {
 			return false, false, nil
 		}
+		// If the task generated a DAG, its result is that of the generated DAG.
+		if outputsDAG(depNode) {
+			depNode = d.getTaskResultNode(taskName)
+			if depNode == nil || !depNode.Fulfilled() {
+				return false, false, nil
+			}
+		}
 
 		evalTaskName := strings.Replace(taskName, "-", "_", -1)
 		if _, ok := evalScope[evalTaskName]; ok {
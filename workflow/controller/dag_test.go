@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
@@ -3696,3 +3698,118 @@ func TestDagWftmplHookWithRetry(t *testing.T) {
 	finishNode := woc.wf.Status.Nodes.FindByDisplayName("finish")
 	assert.Equal(t, wfv1.NodeOmitted, finishNode.Phase)
 }
+
+const dynamicDAGWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dynamic-dag
+  namespace: argo
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      dag:
+        tasks:
+          - name: generate
+            template: generate
+          - name: finish
+            template: echo
+            depends: generate
+    - name: generate
+      container:
+        image: argoproj/argosay:v2
+      outputs:
+        dag:
+          path: /tmp/dag.json
+    - name: echo
+      container:
+        image: argoproj/argosay:v2
+`
+
+func TestDynamicDAG(t *testing.T) {
+	ctx := context.Background()
+	generate := func(t *testing.T, value string) *wfOperationCtx {
+		wf := wfv1.MustUnmarshalWorkflow(dynamicDAGWorkflow)
+		cancel, controller := newController(wf)
+		t.Cleanup(cancel)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, v1.PodSucceeded, withOutputs(wfv1.Outputs{DAG: &wfv1.DAGOutput{Path: "/tmp/dag.json", Value: value}}))
+		require.Eventually(t, func() bool {
+			return len(controller.taskResultInformer.GetStore().List()) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		return woc
+	}
+
+	t.Run("Spliced", func(t *testing.T) {
+		woc := generate(t, `{"tasks": [{"name": "b", "template": "echo", "depends": "a"}, {"name": "a", "template": "echo"}]}`)
+
+		generateNode, err := woc.wf.GetNodeByName("dynamic-dag.generate")
+		require.NoError(t, err)
+		dagNode, err := woc.wf.GetNodeByName("dynamic-dag.generate.dag")
+		require.NoError(t, err)
+		assert.Equal(t, wfv1.NodeTypeDAG, dagNode.Type)
+		assert.Equal(t, wfv1.NodeRunning, dagNode.Phase)
+		assert.Contains(t, generateNode.Children, dagNode.ID)
+		assert.NotNil(t, woc.wf.Status.Nodes.FindByName("dynamic-dag.generate.dag.a"))
+		assert.Nil(t, woc.wf.Status.Nodes.FindByName("dynamic-dag.generate.dag.b"))
+		assert.Nil(t, woc.wf.Status.Nodes.FindByName("dynamic-dag.finish"))
+
+		makePodsPhase(ctx, woc, v1.PodSucceeded)
+		woc.operate(ctx)
+		assert.NotNil(t, woc.wf.Status.Nodes.FindByName("dynamic-dag.generate.dag.b"))
+		assert.Nil(t, woc.wf.Status.Nodes.FindByName("dynamic-dag.finish"))
+
+		makePodsPhase(ctx, woc, v1.PodSucceeded)
+		woc.operate(ctx)
+		dagNode, err = woc.wf.GetNodeByName("dynamic-dag.generate.dag")
+		require.NoError(t, err)
+		assert.Equal(t, wfv1.NodeSucceeded, dagNode.Phase)
+		finishNode := woc.wf.Status.Nodes.FindByName("dynamic-dag.finish")
+		require.NotNil(t, finishNode)
+		bNode := woc.wf.Status.Nodes.FindByName("dynamic-dag.generate.dag.b")
+		assert.Contains(t, bNode.Children, finishNode.ID)
+
+		makePodsPhase(ctx, woc, v1.PodSucceeded)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		woc := generate(t, `{"tasks": [{"name": "a", "template": "echo", "depends": "b"}, {"name": "b", "template": "echo", "depends": "a"}]}`)
+
+		dagNode, err := woc.wf.GetNodeByName("dynamic-dag.generate.dag")
+		require.NoError(t, err)
+		assert.Equal(t, wfv1.NodeError, dagNode.Phase)
+		assert.Contains(t, dagNode.Message, "dependency cycle detected")
+		finishNode := woc.wf.Status.Nodes.FindByName("dynamic-dag.finish")
+		require.NotNil(t, finishNode)
+		assert.Equal(t, wfv1.NodeOmitted, finishNode.Phase)
+		assert.Equal(t, wfv1.WorkflowError, woc.wf.Status.Phase)
+	})
+
+	t.Run("ReferenceMode", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(dynamicDAGWorkflow)
+		wf.Spec.Templates[0].DAG.Tasks[1] = wfv1.DAGTask{Name: "finish", TemplateRef: &wfv1.TemplateRef{Name: "my-wftmpl", Template: "echo"}, Depends: "generate"}
+		cancel, controller := newController(wf, func(wfc *WorkflowController) {
+			wfc.Config.WorkflowRestrictions = &config.WorkflowRestrictions{TemplateReferencing: config.TemplateReferencingStrict}
+		})
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		parse := func(value string) error {
+			_, err := woc.parseDynamicDAG(&wfv1.DAGOutput{Value: value})
+			return err
+		}
+
+		require.NoError(t, parse(`{"tasks": [{"name": "a", "template": "echo"}]}`))
+		require.NoError(t, parse(`{"tasks": [{"name": "a", "templateRef": {"name": "my-wftmpl", "template": "echo"}}]}`))
+		err := parse(`{"tasks": [{"name": "a", "templateRef": {"name": "other-wftmpl", "template": "echo"}}]}`)
+		require.EqualError(t, err, "invalid outputs.dag: templates.dag.tasks.a.templateRef other-wftmpl/echo is not referenced by the workflow's spec, which is required when the controller is in reference mode")
+		err = parse(`{"tasks": [{"name": "a", "template": "echo", "hooks": {"exit": {"templateRef": {"name": "my-wftmpl", "template": "other"}}}}]}`)
+		require.ErrorContains(t, err, "templates.dag.tasks.a.hooks.exit.templateRef my-wftmpl/other is not referenced")
+		err = parse(`{"tasks": [{"name": "a", "inline": {"container": {"image": "my-image"}}}]}`)
+		require.EqualError(t, err, "invalid outputs.dag: templates.dag.tasks.a.inline is not allowed when the controller is in reference mode")
+	})
+}
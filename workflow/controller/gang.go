@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/env"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+)
+
+const gangWaitingMessage = "Waiting for gang"
+
+// gangAdmissionRequeueTime is how long to wait before checking again whether a group of pods can be admitted
+var gangAdmissionRequeueTime = env.LookupEnvDurationOr("GANG_ADMISSION_REQUEUE_TIME", 10*time.Second)
+
+// waitForGang returns whether the pods of a group of sibling nodes, all executing the template the holder refers to, may
+// be created. If not, it records why on the group's node and requeues the workflow to check again later.
+func (woc *wfOperationCtx) waitForGang(ctx context.Context, tmplCtx *templateresolution.Context, holder wfv1.TemplateReferenceHolder, groupNodeName string, nodeNames []string) bool {
+	// errors resolving the template are reported when the nodes are executed
+	_, tmpl, _, err := tmplCtx.ResolveTemplate(holder)
+	if err != nil || woc.mergedTemplateDefaultsInto(tmpl) != nil || tmpl.Gang == nil {
+		return true
+	}
+	groupNode, err := woc.wf.GetNodeByName(groupNodeName)
+	if err != nil {
+		return true
+	}
+	admitted, message, err := woc.admitGang(ctx, tmpl, nodeNames)
+	if err != nil {
+		woc.markNodeError(groupNodeName, err)
+		return false
+	}
+	if !admitted {
+		woc.log.WithField("nodeName", groupNodeName).Info(message)
+		woc.requeueAfter(gangAdmissionRequeueTime)
+	}
+	// only clear the message if it was one of ours
+	if groupNode.Message != message && !groupNode.Fulfilled() && (!admitted || strings.HasPrefix(groupNode.Message, gangWaitingMessage)) {
+		groupNode.Message = message
+		woc.wf.Status.Nodes.Set(groupNode.ID, *groupNode)
+		woc.updated = true
+	}
+	return admitted
+}
+
+// admitGang returns whether the pods of a group of sibling nodes, all executing the template, may be created, and if
+// not, why not. Groups are admitted once any of their nodes exist, so are only checked until their first pod is created.
+func (woc *wfOperationCtx) admitGang(ctx context.Context, tmpl *wfv1.Template, nodeNames []string) (bool, string, error) {
+	if tmpl == nil || tmpl.Gang == nil || len(nodeNames) == 0 {
+		return true, "", nil
+	}
+	for _, nodeName := range nodeNames {
+		if _, err := woc.wf.GetNodeByName(nodeName); err == nil {
+			return true, "", nil
+		}
+	}
+	minAvailable, err := tmpl.Gang.GetMinAvailable(len(nodeNames))
+	if err != nil {
+		return false, "", err
+	}
+	required := quotaRequirements(tmpl, woc.controller.Config.GetExecutor().Resources, minAvailable)
+	if !woc.controller.startQuotaInformer(ctx) {
+		return false, "", fmt.Errorf("timed out waiting for resource quota cache to sync")
+	}
+	quotas, err := woc.controller.unscopedResourceQuotas(woc.wf.Namespace)
+	if err != nil {
+		return false, "", err
+	}
+	names := make([]string, 0, len(required))
+	for name := range required {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	for _, quota := range quotas {
+		for _, name := range names {
+			hard, ok := quota.Status.Hard[apiv1.ResourceName(name)]
+			if !ok {
+				continue
+			}
+			need := required[apiv1.ResourceName(name)]
+			available := hard.DeepCopy()
+			available.Sub(quota.Status.Used[apiv1.ResourceName(name)])
+			if available.Cmp(need) < 0 {
+				return false, fmt.Sprintf("%s of %d pods to be admitted: they need %s %s, but resource quota %s only has %s available", gangWaitingMessage, minAvailable, need.String(), name, quota.Name, available.String()), nil
+			}
+		}
+	}
+	return true, "", nil
+}
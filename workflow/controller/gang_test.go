@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func newGangQuota(cpu string) *apiv1.ResourceQuota {
+	return &apiv1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-quota", Namespace: "my-ns"},
+		Status: apiv1.ResourceQuotaStatus{
+			Hard: apiv1.ResourceList{apiv1.ResourceRequestsCPU: resource.MustParse(cpu)},
+			Used: apiv1.ResourceList{apiv1.ResourceRequestsCPU: resource.MustParse("0")},
+		},
+	}
+}
+
+// setGangQuota creates or updates the quota, and waits for the controller to see it
+func setGangQuota(t *testing.T, controller *WorkflowController, cpu string) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.True(t, controller.startQuotaInformer(ctx))
+	quotas := controller.kubeclientset.CoreV1().ResourceQuotas("my-ns")
+	_, err := quotas.Update(ctx, newGangQuota(cpu), metav1.UpdateOptions{})
+	if apierr.IsNotFound(err) {
+		_, err = quotas.Create(ctx, newGangQuota(cpu), metav1.CreateOptions{})
+	}
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		quotas, err := controller.unscopedResourceQuotas("my-ns")
+		require.NoError(t, err)
+		if len(quotas) != 1 {
+			return false
+		}
+		hard := quotas[0].Status.Hard[apiv1.ResourceRequestsCPU]
+		return hard.Equal(resource.MustParse(cpu))
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestGangDAG(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  templates:
+   - name: main
+     dag:
+       tasks:
+       - name: pod
+         template: pod
+         withItems: [1, 2, 3]
+   - name: pod
+     gang: {}
+     container:
+       image: my-image
+       resources:
+         requests:
+           cpu: 500m
+`)
+	cancel, controller := newController(wf)
+	defer cancel()
+	ctx := context.Background()
+	setGangQuota(t, controller, "1")
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	pods, err := listPods(woc)
+	require.NoError(t, err)
+	assert.Empty(t, pods.Items)
+	taskGroupNode := woc.wf.Status.Nodes.FindByDisplayName("pod")
+	require.NotNil(t, taskGroupNode)
+	assert.Equal(t, wfv1.NodeRunning, taskGroupNode.Phase)
+	assert.Equal(t, "Waiting for gang of 3 pods to be admitted: they need 1500m requests.cpu, but resource quota my-quota only has 1 available", taskGroupNode.Message)
+
+	setGangQuota(t, controller, "2")
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	pods, err = listPods(woc)
+	require.NoError(t, err)
+	assert.Len(t, pods.Items, 3)
+	taskGroupNode = woc.wf.Status.Nodes.FindByDisplayName("pod")
+	require.NotNil(t, taskGroupNode)
+	assert.Empty(t, taskGroupNode.Message)
+
+	// once admitted, the group is not checked again
+	setGangQuota(t, controller, "0")
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	taskGroupNode = woc.wf.Status.Nodes.FindByDisplayName("pod")
+	require.NotNil(t, taskGroupNode)
+	assert.Empty(t, taskGroupNode.Message)
+}
+
+func TestGangSteps(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  templates:
+   - name: main
+     steps:
+     - - name: gang
+         template: gang
+         withItems: [1, 2, 3, 4]
+       - name: other
+         template: other
+   - name: gang
+     gang:
+       minAvailable: "50%"
+     container:
+       image: my-image
+       resources:
+         requests:
+           cpu: 500m
+   - name: other
+     container:
+       image: my-image
+`)
+	cancel, controller := newController(wf)
+	defer cancel()
+	ctx := context.Background()
+	setGangQuota(t, controller, "900m")
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	pods, err := listPods(woc)
+	require.NoError(t, err)
+	assert.Len(t, pods.Items, 1, "only the step that is not part of the gang is started")
+	stepGroupNode := woc.wf.Status.Nodes.FindByDisplayName("[0]")
+	require.NotNil(t, stepGroupNode)
+	assert.Equal(t, wfv1.NodeRunning, stepGroupNode.Phase)
+	assert.Equal(t, "Waiting for gang of 2 pods to be admitted: they need 1 requests.cpu, but resource quota my-quota only has 900m available", stepGroupNode.Message)
+
+	setGangQuota(t, controller, "1")
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	pods, err = listPods(woc)
+	require.NoError(t, err)
+	assert.Len(t, pods.Items, 5)
+	stepGroupNode = woc.wf.Status.Nodes.FindByDisplayName("[0]")
+	require.NotNil(t, stepGroupNode)
+	assert.Empty(t, stepGroupNode.Message)
+}
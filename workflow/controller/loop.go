@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
+	"github.com/argoproj/argo-workflows/v3/util/expr/env"
+	"github.com/argoproj/argo-workflows/v3/util/intstr"
+	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
+)
+
+// executeLoop executes the template of a step or task with a loop. Each iteration is a child of a single Loop node,
+// named after it with the index of the iteration, e.g. "my-step(0)". An iteration is only started once the previous
+// one has succeeded and loop.until evaluated false against its outputs.
+func (woc *wfOperationCtx) executeLoop(ctx context.Context, nodeName string, orgTmpl wfv1.TemplateReferenceHolder, loop *wfv1.Loop, tmplCtx *templateresolution.Context, args wfv1.Arguments, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node, err := woc.wf.GetNodeByName(nodeName)
+	if err != nil {
+		node = woc.initializeNode(nodeName, wfv1.NodeTypeLoop, tmplCtx.GetTemplateScope(), orgTmpl, opts.boundaryID, wfv1.NodeRunning, opts.nodeFlag)
+	}
+	if node.Fulfilled() {
+		return node, nil
+	}
+	maxIterations, err := intstr.Int32(loop.MaxIterations)
+	if err != nil {
+		return woc.markNodeError(nodeName, fmt.Errorf("invalid loop.maxIterations: %w", err)), nil
+	}
+	if maxIterations == nil {
+		return woc.markNodeError(nodeName, fmt.Errorf("loop.maxIterations is required")), nil
+	}
+	iterationOpts := &executeTemplateOpts{boundaryID: opts.boundaryID, onExitTemplate: opts.onExitTemplate}
+
+	for {
+		if numIterations := len(node.Children); numIterations > 0 {
+			lastIteration, err := woc.wf.Status.Nodes.Get(node.Children[numIterations-1])
+			if err != nil {
+				return nil, err
+			}
+			if !lastIteration.Fulfilled() {
+				lastIteration, err = woc.executeTemplate(ctx, lastIteration.Name, orgTmpl, tmplCtx, args, iterationOpts)
+				if err != nil {
+					return node, err
+				}
+				if !lastIteration.Fulfilled() {
+					return node, nil
+				}
+			}
+			if !lastIteration.Succeeded() {
+				return woc.completeLoopNode(nodeName, lastIteration, lastIteration.Phase, fmt.Sprintf("iteration %d %s", numIterations-1, lastIteration.Phase)), nil
+			}
+			done, err := evaluateLoopUntil(loop.Until, lastIteration, numIterations-1)
+			if err != nil {
+				return woc.markNodeError(nodeName, fmt.Errorf("failed to evaluate loop.until: %w", err)), nil
+			}
+			if done {
+				return woc.completeLoopNode(nodeName, lastIteration, wfv1.NodeSucceeded), nil
+			}
+			if numIterations >= int(*maxIterations) {
+				return woc.completeLoopNode(nodeName, lastIteration, wfv1.NodeFailed, fmt.Sprintf("loop did not complete within %d iterations", *maxIterations)), nil
+			}
+		}
+
+		iterationName := fmt.Sprintf("%s(%d)", nodeName, len(node.Children))
+		iteration, err := woc.executeTemplate(ctx, iterationName, orgTmpl, tmplCtx, args, iterationOpts)
+		// Some scenario, the iteration will be nil e.g: when parallelism reached.
+		if iteration == nil {
+			return node, err
+		}
+		woc.addChildNode(nodeName, iterationName)
+		node, _ = woc.wf.GetNodeByName(nodeName)
+		if err != nil || !iteration.Fulfilled() {
+			return node, err
+		}
+		// The iteration completed straight away, e.g. because it was memoized, so carry on with the loop.
+	}
+}
+
+// completeLoopNode marks the loop node with the given phase, exposing the outputs of the last iteration as its own
+func (woc *wfOperationCtx) completeLoopNode(nodeName string, lastIteration *wfv1.NodeStatus, phase wfv1.NodePhase, message ...string) *wfv1.NodeStatus {
+	node, err := woc.wf.GetNodeByName(nodeName)
+	if err != nil {
+		woc.log.Panicf("was unable to obtain node for %s", nodeName)
+	}
+	if lastIteration.Outputs != nil {
+		node.Outputs = lastIteration.Outputs.DeepCopy()
+		woc.wf.Status.Nodes.Set(node.ID, *node)
+	}
+	return woc.markNodePhase(nodeName, phase, message...)
+}
+
+// evaluateLoopUntil evaluates loop.until against the outputs of an iteration
+func evaluateLoopUntil(until string, iteration *wfv1.NodeStatus, index int) (bool, error) {
+	scope := map[string]interface{}{
+		"iteration": index,
+		"status":    string(iteration.Phase),
+	}
+	if outputs := iteration.Outputs; outputs != nil {
+		for _, param := range outputs.Parameters {
+			if param.Value != nil {
+				scope["outputs.parameters."+param.Name] = param.Value.String()
+			}
+		}
+		if outputs.Result != nil {
+			scope["outputs.result"] = *outputs.Result
+		}
+		if outputs.ExitCode != nil {
+			scope["outputs.exitCode"] = *outputs.ExitCode
+		}
+	}
+	return argoexpr.EvalBool(until, env.GetFuncMap(scope))
+}
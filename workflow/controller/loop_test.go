@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const loopWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: loop
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: poll
+        template: poll
+        loop:
+          until: "outputs.parameters.ready == 'true'"
+          maxIterations: 2
+  - name: poll
+    container:
+      image: busybox
+    outputs:
+      parameters:
+      - name: ready
+        valueFrom:
+          path: /tmp/ready
+`
+
+func TestLoop(t *testing.T) {
+	ctx := context.Background()
+	// iterate completes the running iteration with the given output, and operates on the workflow again
+	iterate := func(t *testing.T, woc *wfOperationCtx, ready string, taskResults int) *wfOperationCtx {
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "ready", Value: wfv1.AnyStringPtr(ready)}}}))
+		require.Eventually(t, func() bool {
+			return len(woc.controller.taskResultInformer.GetStore().List()) == taskResults
+		}, 5*time.Second, 10*time.Millisecond)
+		woc = newWorkflowOperationCtx(woc.wf, woc.controller)
+		woc.operate(ctx)
+		return woc
+	}
+	start := func(t *testing.T) *wfOperationCtx {
+		wf := wfv1.MustUnmarshalWorkflow(loopWorkflow)
+		cancel, controller := newController(wf)
+		t.Cleanup(cancel)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		loopNode, err := woc.wf.GetNodeByName("loop[0].poll")
+		require.NoError(t, err)
+		assert.Equal(t, wfv1.NodeTypeLoop, loopNode.Type)
+		assert.Equal(t, wfv1.NodeRunning, loopNode.Phase)
+		assert.Len(t, loopNode.Children, 1)
+		return woc
+	}
+
+	t.Run("Until", func(t *testing.T) {
+		woc := start(t)
+		woc = iterate(t, woc, "false", 1)
+		loopNode, err := woc.wf.GetNodeByName("loop[0].poll")
+		require.NoError(t, err)
+		assert.Equal(t, wfv1.NodeRunning, loopNode.Phase)
+		require.Len(t, loopNode.Children, 2)
+		iterationNode, err := woc.wf.Status.Nodes.Get(loopNode.Children[1])
+		require.NoError(t, err)
+		assert.Equal(t, "poll(1)", iterationNode.DisplayName)
+
+		woc = iterate(t, woc, "true", 2)
+		loopNode, err = woc.wf.GetNodeByName("loop[0].poll")
+		require.NoError(t, err)
+		assert.Equal(t, wfv1.NodeSucceeded, loopNode.Phase)
+		assert.Len(t, loopNode.Children, 2)
+		require.NotNil(t, loopNode.Outputs)
+		assert.Equal(t, "true", loopNode.Outputs.Parameters[0].Value.String())
+		assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	})
+
+	t.Run("MaxIterations", func(t *testing.T) {
+		woc := start(t)
+		woc = iterate(t, woc, "false", 1)
+		woc = iterate(t, woc, "false", 2)
+		loopNode, err := woc.wf.GetNodeByName("loop[0].poll")
+		require.NoError(t, err)
+		assert.Equal(t, wfv1.NodeFailed, loopNode.Phase)
+		assert.Equal(t, "loop did not complete within 2 iterations", loopNode.Message)
+		assert.Len(t, loopNode.Children, 2)
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+	})
+}
+
+func TestEvaluateLoopUntil(t *testing.T) {
+	node := &wfv1.NodeStatus{
+		Phase: wfv1.NodeSucceeded,
+		Outputs: &wfv1.Outputs{
+			Parameters: []wfv1.Parameter{{Name: "loss", Value: wfv1.AnyStringPtr("0.01")}},
+			Result:     ptr.To("done"),
+		},
+	}
+	for until, want := range map[string]bool{
+		"float(outputs.parameters.loss) < 0.05": true,
+		"outputs.result == 'done'":              true,
+		"iteration >= 3":                        false,
+		"status == 'Succeeded'":                 true,
+	} {
+		t.Run(until, func(t *testing.T) {
+			got, err := evaluateLoopUntil(until, node, 2)
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+	_, err := evaluateLoopUntil("outputs.result +", node, 0)
+	assert.Error(t, err)
+}
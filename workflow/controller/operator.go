@@ -54,7 +54,6 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/template"
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
-	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/estimation"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
@@ -87,6 +86,8 @@ type wfOperationCtx struct {
 	volumes []apiv1.Volume
 	// ArtifactRepository contains the default location of an artifact repository for container artifacts
 	artifactRepository *wfv1.ArtifactRepository
+	// artifactRetentionRun identifies the run of the artifact retention policies that is deleting artifacts of the workflow
+	artifactRetentionRun string
 	// deadline is the dealine time in which this operation should relinquish
 	// its hold on the workflow so that an operation does not run for too long
 	// and starve other workqueue items. It also enables workflow progress to
@@ -204,6 +205,11 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 
 	woc.log.WithFields(log.Fields{"Phase": woc.wf.Status.Phase, "ResourceVersion": woc.wf.ObjectMeta.ResourceVersion}).Info("Processing workflow")
 
+	// Populate the phase of all the nodes prior to execution
+	for _, node := range woc.wf.Status.Nodes {
+		woc.preExecutionNodePhases[node.ID] = node.Phase
+	}
+
 	// Set the Execute workflow spec for execution
 	// ExecWF is a runtime execution spec which merged from Wf, WFT and Wfdefault
 	err := woc.setExecWorkflow(ctx)
@@ -270,11 +276,6 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		}
 	}
 
-	// Populate the phase of all the nodes prior to execution
-	for _, node := range woc.wf.Status.Nodes {
-		woc.preExecutionNodePhases[node.ID] = node.Phase
-	}
-
 	if woc.execWf.Spec.Metrics != nil {
 		localScope, realTimeScope := woc.prepareDefaultMetricScope()
 		woc.computeMetrics(ctx, woc.execWf.Spec.Metrics.Prometheus, localScope, realTimeScope, true)
@@ -737,6 +738,7 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 		woc.log.Panic("cannot persist updates with mismatched resource versions")
 	}
 	wfClient := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.ObjectMeta.Namespace)
+	woc.compactNodes(ctx)
 	// try and compress nodes if needed
 	nodes := woc.wf.Status.Nodes
 	err := woc.controller.hydrator.Dehydrate(woc.wf)
@@ -787,6 +789,9 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 	// Create WorkflowNode* events for nodes that have changed phase
 	woc.recordNodePhaseChangeEvents(woc.orig.Status.Nodes, woc.wf.Status.Nodes)
 
+	// Emit spans for nodes and the workflow that have been fulfilled
+	woc.recordSpans(ctx)
+
 	if !woc.controller.hydrator.IsHydrated(woc.wf) {
 		panic("workflow should be hydrated")
 	}
@@ -990,6 +995,22 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 		return woc.markNodePhase(node.Name, lastChildNode.Phase, message), true, nil
 	}
 
+	// retries is the number of retries counted against the limit
+	retries := len(childNodeIds)
+	if len(retryStrategy.RetryRules) > 0 {
+		rule, ruleRetries, err := woc.findRetryRule(retryStrategy.RetryRules, childNodeIds, lastChildNode)
+		if err != nil {
+			return nil, false, err
+		}
+		if rule == nil {
+			woc.log.Infof("Node did not match any retry rule after status: %s", lastChildNode.Phase)
+			return woc.markNodePhase(node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
+		}
+		// the matching rule replaces the limit and backoff of the strategy, and the node is retried whatever its status
+		retries = ruleRetries
+		retryStrategy = wfv1.RetryStrategy{Limit: rule.Limit, RetryPolicy: wfv1.RetryPolicyAlways, Backoff: rule.Backoff, Affinity: retryStrategy.Affinity}
+	}
+
 	if retryStrategy.Backoff != nil {
 		maxDurationDeadline := time.Time{}
 		// Process max duration limit
@@ -1027,7 +1048,7 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 		if retryStrategyBackoffFactor != nil && *retryStrategyBackoffFactor > 0 {
 			// Formula: timeToWait = duration * factor^retry_number
 			// Note that timeToWait should equal to duration for the first retry attempt.
-			timeToWait = baseDuration * time.Duration(math.Pow(float64(*retryStrategyBackoffFactor), float64(len(childNodeIds)-1)))
+			timeToWait = baseDuration * time.Duration(math.Pow(float64(*retryStrategyBackoffFactor), float64(retries-1)))
 		}
 		waitingDeadline := lastChildNode.FinishedAt.Add(timeToWait)
 
@@ -1038,7 +1059,7 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 		}
 
 		// See if we have waited past the deadline
-		if time.Now().Before(waitingDeadline) && retryStrategy.Limit != nil && int32(len(childNodeIds)) <= int32(retryStrategy.Limit.IntValue()) {
+		if time.Now().Before(waitingDeadline) && retryStrategy.Limit != nil && int32(retries) <= int32(retryStrategy.Limit.IntValue()) {
 			woc.requeueAfter(timeToWait)
 			retryMessage := fmt.Sprintf("Backoff for %s", humanize.Duration(timeToWait))
 			return woc.markNodePhase(node.Name, node.Phase, retryMessage), false, nil
@@ -1086,7 +1107,7 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 	if err != nil {
 		return nil, false, err
 	}
-	if retryStrategy.Limit != nil && limit != nil && int32(len(childNodeIds)) > *limit {
+	if retryStrategy.Limit != nil && limit != nil && int32(retries) > *limit {
 		woc.log.Infoln("No more retries left. Failing...")
 		return woc.markNodePhase(node.Name, lastChildNode.Phase, "No more retries left"), true, nil
 	}
@@ -1149,7 +1170,7 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) (error, bool)
 				woc.addOutputsToGlobalScope(newState.Outputs)
 				if newState.MemoizationStatus != nil {
 					if newState.Succeeded() {
-						c := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, newState.MemoizationStatus.CacheName)
+						c := woc.controller.cacheFactory.GetCache(newState.MemoizationStatus.GetCacheType(), newState.MemoizationStatus.CacheName)
 						err := c.Save(ctx, newState.MemoizationStatus.Key, newState.ID, newState.Outputs)
 						if err != nil {
 							woc.log.WithFields(log.Fields{"nodeID": newState.ID}).WithError(err).Error("Failed to save node outputs to cache")
@@ -1366,6 +1387,9 @@ func (woc *wfOperationCtx) assessNodeStatus(ctx context.Context, pod *apiv1.Pod,
 		// ignore pod failure for daemoned steps
 		if tmpl != nil && tmpl.IsDaemon() {
 			new.Phase = wfv1.NodeSucceeded
+		} else if old.Succeeded() && tmpl.HasStreamedOutputArtifacts() {
+			// the pod failed after the node succeeded, while its wait container was serving the streamed output artifacts
+			woc.log.WithField("pod", pod.Name).Info("Ignoring pod failure after streaming output artifacts")
 		} else {
 			new.Phase, new.Message = woc.inferFailedReason(pod, tmpl)
 			woc.log.WithField("displayName", old.DisplayName).WithField("templateName", wfutil.GetTemplateFromNode(*old)).
@@ -1391,10 +1415,14 @@ func (woc *wfOperationCtx) assessNodeStatus(ctx context.Context, pod *apiv1.Pod,
 					woc.log.WithField("nodeId", old.ID).Info("Node became daemoned")
 				}
 			}
+		} else if isStreamingOutputArtifacts(pod, tmpl) && woc.wf.Status.IsTaskResultComplete(old.ID) {
+			// the outputs are saved, and the wait container keeps serving the streamed output artifacts to the
+			// nodes that depend on this one
+			new.Phase = wfv1.NodeSucceeded
 		} else {
 			new.Phase = wfv1.NodeRunning
 		}
-		if tmpl != nil {
+		if tmpl != nil && !isStreamingOutputArtifacts(pod, tmpl) {
 			woc.cleanUpPod(pod, *tmpl)
 		}
 	default:
@@ -1469,7 +1497,7 @@ func (woc *wfOperationCtx) assessNodeStatus(ctx context.Context, pod *apiv1.Pod,
 		if c.Name == common.WaitContainerName {
 			waitContainerCleanedUp = false
 			switch {
-			case c.State.Running != nil && new.Phase.Completed():
+			case c.State.Running != nil && new.Phase.Completed() && !isStreamingOutputArtifacts(pod, tmpl):
 				woc.log.WithField("new.phase", new.Phase).Info("leaving phase un-changed: wait container is not yet terminated ")
 				new.Phase = old.Phase
 			case c.State.Terminated != nil && c.State.Terminated.ExitCode != 0:
@@ -1526,6 +1554,20 @@ func getExitCode(pod *apiv1.Pod) *int32 {
 	return nil
 }
 
+// isStreamingOutputArtifacts returns whether the main containers of a pod whose template has streamed output artifacts
+// have succeeded, after which its wait container serves the artifacts until it is done
+func isStreamingOutputArtifacts(pod *apiv1.Pod, tmpl *wfv1.Template) bool {
+	if !tmpl.HasStreamedOutputArtifacts() {
+		return false
+	}
+	for _, c := range pod.Status.ContainerStatuses {
+		if tmpl.IsMainContainerName(c.Name) && (c.State.Terminated == nil || c.State.Terminated.ExitCode != 0) {
+			return false
+		}
+	}
+	return true
+}
+
 func podHasContainerNeedingTermination(pod *apiv1.Pod, tmpl wfv1.Template) bool {
 	// pod needs to be terminated if any of the following are true:
 	// 1. any main container has exited with non-zero exit code
@@ -1946,6 +1988,10 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, opts.nodeFlag, err), err
 	}
 
+	if overrides, ok := woc.wf.Status.NodeArgumentOverrides[nodeName]; ok {
+		args = overrideArguments(args, overrides)
+	}
+
 	// Inputs has been processed with arguments already, so pass empty arguments.
 	processedTmpl, err := common.ProcessArgs(resolvedTmpl, &args, woc.globalParams, localParams, false, woc.wf.Namespace, woc.controller.configMapInformer.GetIndexer())
 	if err != nil {
@@ -2016,10 +2062,10 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 	// Check memoization cache if the node is about to be created, or was created in the past but is only now allowed to run due to acquiring a lock
 	if processedTmpl.Memoize != nil {
 		if node == nil || unlockedNode {
-			memoizationCache := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, processedTmpl.Memoize.Cache.ConfigMap.Name)
+			memoizationCache := woc.controller.cacheFactory.GetCache(processedTmpl.Memoize.Cache.GetType(), processedTmpl.Memoize.Cache.GetName())
 			if memoizationCache == nil {
 				err := fmt.Errorf("cache could not be found or created")
-				woc.log.WithFields(log.Fields{"cacheName": processedTmpl.Memoize.Cache.ConfigMap.Name}).WithError(err)
+				woc.log.WithFields(log.Fields{"cacheName": processedTmpl.Memoize.Cache.GetName()}).WithError(err)
 				return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, opts.nodeFlag, err), err
 			}
 
@@ -2049,7 +2095,8 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 			memoizationStatus := &wfv1.MemoizationStatus{
 				Hit:       hit,
 				Key:       processedTmpl.Memoize.Key,
-				CacheName: processedTmpl.Memoize.Cache.ConfigMap.Name,
+				CacheName: processedTmpl.Memoize.Cache.GetName(),
+				CacheType: processedTmpl.Memoize.Cache.GetType(),
 			}
 			if hit {
 				if node == nil {
@@ -2513,7 +2560,8 @@ func (woc *wfOperationCtx) initializeExecutableNode(nodeName string, nodeType wf
 		memoizationStatus := &wfv1.MemoizationStatus{
 			Hit:       false,
 			Key:       executeTmpl.Memoize.Key,
-			CacheName: executeTmpl.Memoize.Cache.ConfigMap.Name,
+			CacheName: executeTmpl.Memoize.Cache.GetName(),
+			CacheType: executeTmpl.Memoize.Cache.GetType(),
 		}
 		node.MemoizationStatus = memoizationStatus
 	}
@@ -2565,6 +2613,29 @@ func (woc *wfOperationCtx) initializeCacheHitNode(nodeName string, resolvedTmpl
 	return node
 }
 
+// overrideArguments returns the arguments with the parameters and artifacts replaced by those of the same name in the
+// overrides
+func overrideArguments(args, overrides wfv1.Arguments) wfv1.Arguments {
+	result := *args.DeepCopy()
+	for _, param := range overrides.Parameters {
+		i := slices.IndexFunc(result.Parameters, func(p wfv1.Parameter) bool { return p.Name == param.Name })
+		if i >= 0 {
+			result.Parameters[i] = param
+		} else {
+			result.Parameters = append(result.Parameters, param)
+		}
+	}
+	for _, art := range overrides.Artifacts {
+		i := slices.IndexFunc(result.Artifacts, func(a wfv1.Artifact) bool { return a.Name == art.Name })
+		if i >= 0 {
+			result.Artifacts[i] = art
+		} else {
+			result.Artifacts = append(result.Artifacts, art)
+		}
+	}
+	return result
+}
+
 func (woc *wfOperationCtx) initializeNode(nodeName string, nodeType wfv1.NodeType, templateScope string, orgTmpl wfv1.TemplateReferenceHolder, boundaryID string, phase wfv1.NodePhase, nodeFlag *wfv1.NodeFlag, messages ...string) *wfv1.NodeStatus {
 	woc.log.Debugf("Initializing node %s: template: %s, boundaryID: %s", nodeName, common.GetTemplateHolderString(orgTmpl), boundaryID)
 
@@ -2587,6 +2658,9 @@ func (woc *wfOperationCtx) initializeNode(nodeName string, nodeType wfv1.NodeTyp
 		StartedAt:         metav1.Time{Time: time.Now().UTC()},
 		EstimatedDuration: woc.estimateNodeDuration(nodeName),
 	}
+	if overrides, ok := woc.wf.Status.NodeArgumentOverrides[nodeName]; ok {
+		node.ArgumentOverrides = overrides.DeepCopy()
+	}
 
 	if boundaryNode, err := woc.wf.Status.Nodes.Get(boundaryID); err == nil {
 		node.DisplayName = strings.TrimPrefix(node.Name, boundaryNode.Name)
@@ -2914,6 +2988,14 @@ func (woc *wfOperationCtx) getOutboundNodes(nodeID string) []string {
 		if numChildren > 0 {
 			return []string{node.Children[numChildren-1]}
 		}
+	case wfv1.NodeTypeCompacted:
+		return []string{node.ID}
+	case wfv1.NodeTypeLoop:
+		numChildren := len(node.Children)
+		if numChildren > 0 {
+			return woc.getOutboundNodes(node.Children[numChildren-1])
+		}
+		return []string{node.ID}
 	case wfv1.NodeTypeSteps, wfv1.NodeTypeDAG:
 		if node.MemoizationStatus != nil && node.MemoizationStatus.Hit {
 			return []string{node.ID}
@@ -3194,10 +3276,15 @@ func (n loopNodes) Swap(i, j int) {
 
 // processAggregateNodeOutputs adds the aggregated outputs of a withItems/withParam template as a
 // parameter in the form of a JSON list
-func (woc *wfOperationCtx) processAggregateNodeOutputs(scope *wfScope, prefix string, childNodes []wfv1.NodeStatus) error {
+func (woc *wfOperationCtx) processAggregateNodeOutputs(ctx context.Context, scope *wfScope, prefix string, childNodes []wfv1.NodeStatus) error {
 	if len(childNodes) == 0 {
 		return nil
 	}
+	// Some of the children may have been compacted, aggregate the outputs of the nodes collapsed into them
+	childNodes, err := woc.expandCompactedNodes(ctx, childNodes)
+	if err != nil {
+		return err
+	}
 	// Some of the children may be hooks, only keep those that aren't
 	nodeIdx := 0
 	for i := range childNodes {
@@ -3682,15 +3769,24 @@ func (woc *wfOperationCtx) substituteParamsInVolumes(params map[string]string) e
 	return nil
 }
 
+// templateRevisions returns where template revisions are held, or nil if persistence is not configured
+func (woc *wfOperationCtx) templateRevisions() templateresolution.TemplateRevisions {
+	if woc.controller.templateRevisions == nil {
+		return nil
+	}
+	return woc.controller.templateRevisions
+}
+
 // createTemplateContext creates a new template context.
 func (woc *wfOperationCtx) createTemplateContext(scope wfv1.ResourceScope, resourceName string) (*templateresolution.Context, error) {
 	var clusterWorkflowTemplateGetter templateresolution.ClusterWorkflowTemplateGetter
 	if woc.controller.cwftmplInformer != nil {
-		clusterWorkflowTemplateGetter = woc.controller.cwftmplInformer.Lister()
+		clusterWorkflowTemplateGetter = templateresolution.WithClusterWorkflowTemplateRevisions(woc.controller.cwftmplInformer.Lister(), woc.templateRevisions())
 	} else {
 		clusterWorkflowTemplateGetter = &templateresolution.NullClusterWorkflowTemplateGetter{}
 	}
-	ctx := templateresolution.NewContext(woc.controller.wftmplInformer.Lister().WorkflowTemplates(woc.wf.Namespace), clusterWorkflowTemplateGetter, woc.execWf, woc.wf)
+	wftmplGetter := templateresolution.WithWorkflowTemplateRevisions(woc.controller.wftmplInformer.Lister().WorkflowTemplates(woc.wf.Namespace), woc.templateRevisions(), woc.wf.Namespace)
+	ctx := templateresolution.NewContext(wftmplGetter, clusterWorkflowTemplateGetter, woc.execWf, woc.wf)
 
 	switch scope {
 	case wfv1.ResourceScopeNamespaced:
@@ -3906,10 +4002,12 @@ func (woc *wfOperationCtx) fetchWorkflowSpec(ctx context.Context) (wfv1.Workflow
 			return nil, fmt.Errorf("cannot get resource clusterWorkflowTemplate at cluster scope")
 		}
 		woc.controller.metrics.CountWorkflowTemplate(ctx, metrics.WorkflowNew, woc.wf.Spec.WorkflowTemplateRef.Name, woc.wf.Namespace, true) // not-woc-misuse
-		specHolder, err = woc.controller.cwftmplInformer.Lister().Get(woc.wf.Spec.WorkflowTemplateRef.Name)                                  // not-woc-misuse
+		cwftmplGetter := templateresolution.WithClusterWorkflowTemplateRevisions(woc.controller.cwftmplInformer.Lister(), woc.templateRevisions())
+		specHolder, err = templateresolution.GetClusterWorkflowTemplate(cwftmplGetter, woc.wf.Spec.WorkflowTemplateRef.Name, woc.wf.Spec.WorkflowTemplateRef.Revision) // not-woc-misuse
 	} else {
-		woc.controller.metrics.CountWorkflowTemplate(ctx, metrics.WorkflowNew, woc.wf.Spec.WorkflowTemplateRef.Name, woc.wf.Namespace, false)  // not-woc-misuse
-		specHolder, err = woc.controller.wftmplInformer.Lister().WorkflowTemplates(woc.wf.Namespace).Get(woc.wf.Spec.WorkflowTemplateRef.Name) // not-woc-misuse
+		woc.controller.metrics.CountWorkflowTemplate(ctx, metrics.WorkflowNew, woc.wf.Spec.WorkflowTemplateRef.Name, woc.wf.Namespace, false) // not-woc-misuse
+		wftmplGetter := templateresolution.WithWorkflowTemplateRevisions(woc.controller.wftmplInformer.Lister().WorkflowTemplates(woc.wf.Namespace), woc.templateRevisions(), woc.wf.Namespace)
+		specHolder, err = templateresolution.GetWorkflowTemplate(wftmplGetter, woc.wf.Spec.WorkflowTemplateRef.Name, woc.wf.Spec.WorkflowTemplateRef.Revision) // not-woc-misuse
 	}
 	if err != nil {
 		return nil, err
@@ -3949,8 +4047,8 @@ func (woc *wfOperationCtx) setExecWorkflow(ctx context.Context) error {
 	// Perform one-time workflow validation
 	if woc.wf.Status.Phase == wfv1.WorkflowUnknown {
 		validateOpts := validate.ValidateOpts{}
-		wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(woc.controller.wfclientset.ArgoprojV1alpha1().WorkflowTemplates(woc.wf.Namespace))
-		cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(woc.controller.wfclientset.ArgoprojV1alpha1().ClusterWorkflowTemplates())
+		wftmplGetter := templateresolution.WithWorkflowTemplateRevisions(templateresolution.WrapWorkflowTemplateInterface(woc.controller.wfclientset.ArgoprojV1alpha1().WorkflowTemplates(woc.wf.Namespace)), woc.templateRevisions(), woc.wf.Namespace)
+		cwftmplGetter := templateresolution.WithClusterWorkflowTemplateRevisions(templateresolution.WrapClusterWorkflowTemplateInterface(woc.controller.wfclientset.ArgoprojV1alpha1().ClusterWorkflowTemplates()), woc.templateRevisions())
 
 		// Validate the execution wfSpec
 		err := waitutil.Backoff(retry.DefaultRetry,
@@ -957,6 +957,61 @@ func TestProcessNodeRetriesWithExpression(t *testing.T) {
 	assert.Equal(t, "No more retries left", n.Message)
 }
 
+// TestProcessNodeRetriesWithRetryRules tests retrying with retry rules
+func TestProcessNodeRetriesWithRetryRules(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	woc := newWorkflowOperationCtx(wf, controller)
+
+	// Add the parent node for retries.
+	nodeName := "test-node"
+	node := woc.initializeNode(nodeName, wfv1.NodeTypeRetry, "", &wfv1.WorkflowStep{}, "", wfv1.NodeRunning, &wfv1.NodeFlag{})
+	retries := wfv1.RetryStrategy{
+		// ignored in favour of the rules
+		Limit: intstrutil.ParsePtr("0"),
+		RetryRules: []wfv1.RetryRule{
+			{Match: &wfv1.RetryRuleMatch{Evicted: true}, Limit: intstrutil.ParsePtr("10")},
+			{Match: &wfv1.RetryRuleMatch{ExitCodes: []int32{1}}, Limit: intstrutil.ParsePtr("1"), Backoff: &wfv1.Backoff{Duration: "1m"}},
+		},
+	}
+	// fail adds a failed child node, and processes the retries
+	fail := func(i int, message string, exitCode string) *wfv1.NodeStatus {
+		childNode := fmt.Sprintf("%s(%d)", nodeName, i)
+		woc.initializeNode(childNode, wfv1.NodeTypePod, "", &wfv1.WorkflowStep{}, "", wfv1.NodeRunning, &wfv1.NodeFlag{Retried: true})
+		woc.addChildNode(nodeName, childNode)
+		child := woc.markNodePhase(childNode, wfv1.NodeFailed, message)
+		child.Outputs = &wfv1.Outputs{ExitCode: &exitCode}
+		woc.wf.Status.Nodes.Set(child.ID, *child)
+		n, err := woc.wf.GetNodeByName(nodeName)
+		require.NoError(t, err)
+		n, _, err = woc.processNodeRetries(n, retries, &executeTemplateOpts{})
+		require.NoError(t, err)
+		return n
+	}
+
+	// Evictions are retried straight away.
+	n := fail(0, "The node was low on resource: memory.", "137")
+	assert.Equal(t, wfv1.NodeRunning, n.Phase)
+	assert.Empty(t, n.Message)
+
+	// Application errors are retried with a backoff.
+	n = fail(1, "Error (exit code 1)", "1")
+	assert.Equal(t, wfv1.NodeRunning, n.Phase)
+	assert.Contains(t, n.Message, "Backoff for")
+
+	// The eviction is not counted against the limit of application errors.
+	n = fail(2, "Error (exit code 1)", "1")
+	assert.Equal(t, wfv1.NodeFailed, n.Phase)
+	assert.Equal(t, "No more retries left", n.Message)
+
+	// Failures which match no rule are not retried.
+	woc.markNodePhase(node.Name, wfv1.NodeRunning)
+	n = fail(3, "Error (exit code 2)", "2")
+	assert.Equal(t, wfv1.NodeFailed, n.Phase)
+	assert.Equal(t, "Error (exit code 2)", n.Message)
+}
+
 func TestProcessNodeRetriesMessageOrder(t *testing.T) {
 	cancel, controller := newController()
 	defer cancel()
@@ -1786,6 +1841,73 @@ func TestAssessNodeStatus(t *testing.T) {
 	}
 }
 
+var streamingOutputArtifactsWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: streaming
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: busybox
+    outputs:
+      artifacts:
+      - name: data
+        path: /data
+        stream: true
+`
+
+func TestAssessNodeStatusStreamingOutputArtifacts(t *testing.T) {
+	pod := func(phase apiv1.PodPhase, mainExitCode int32) *apiv1.Pod {
+		return &apiv1.Pod{
+			Status: apiv1.PodStatus{
+				Phase: phase,
+				ContainerStatuses: []apiv1.ContainerStatus{
+					{
+						Name:  common.WaitContainerName,
+						State: apiv1.ContainerState{Running: &apiv1.ContainerStateRunning{}},
+					},
+					{
+						Name:  common.MainContainerName,
+						State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{ExitCode: mainExitCode}},
+					},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name                string
+		pod                 *apiv1.Pod
+		node                *wfv1.NodeStatus
+		taskResultCompleted bool
+		wantPhase           wfv1.NodePhase
+	}{
+		{"outputs saved", pod(apiv1.PodRunning, 0), &wfv1.NodeStatus{ID: "node", TemplateName: "main", Phase: wfv1.NodeRunning}, true, wfv1.NodeSucceeded},
+		{"outputs not saved", pod(apiv1.PodRunning, 0), &wfv1.NodeStatus{ID: "node", TemplateName: "main", Phase: wfv1.NodeRunning}, false, wfv1.NodeRunning},
+		{"main failed", pod(apiv1.PodRunning, 1), &wfv1.NodeStatus{ID: "node", TemplateName: "main", Phase: wfv1.NodeRunning}, true, wfv1.NodeRunning},
+		{"pod failed while streaming", pod(apiv1.PodFailed, 0), &wfv1.NodeStatus{ID: "node", TemplateName: "main", Phase: wfv1.NodeSucceeded}, true, wfv1.NodeSucceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cancel, controller := newController()
+			defer cancel()
+			woc := newWorkflowOperationCtx(wfv1.MustUnmarshalWorkflow(streamingOutputArtifactsWf), controller)
+			if tt.taskResultCompleted {
+				woc.wf.Status.MarkTaskResultComplete(tt.node.ID)
+			} else {
+				woc.wf.Status.MarkTaskResultIncomplete(tt.node.ID)
+			}
+			got := woc.assessNodeStatus(context.TODO(), tt.pod, tt.node)
+			if got == nil { // unchanged
+				got = tt.node
+			}
+			assert.Equal(t, tt.wantPhase, got.Phase)
+		})
+	}
+}
+
 func getPodTemplate(pod *apiv1.Pod) (*wfv1.Template, error) {
 	tmpl := &wfv1.Template{}
 	for _, c := range pod.Spec.InitContainers {
@@ -6519,7 +6641,7 @@ status:
       name: my-wf
       phase: Failed
 `)
-	wf, _, err := util.FormulateRetryWorkflow(context.Background(), wf, false, "", []string{"message=modified"})
+	wf, _, err := util.FormulateRetryWorkflow(context.Background(), wf, false, "", []string{"message=modified"}, nil, nil)
 	require.NoError(t, err)
 	cancel, controller := newController(wf)
 	defer cancel()
@@ -6530,6 +6652,67 @@ status:
 	assert.Equal(t, "modified", wf.Spec.Arguments.Parameters[0].Value.String())
 }
 
+func TestRetryNodeParamsOverride(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: a
+        template: echo
+        arguments:
+          parameters:
+          - name: message
+            value: hello
+          artifacts:
+          - name: data
+            s3:
+              key: my-data/original.tgz
+  - name: echo
+    inputs:
+      parameters:
+      - name: message
+      artifacts:
+      - name: data
+        path: /tmp/data
+    container:
+      image: my-image
+      args: ["{{inputs.parameters.message}}"]
+`)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodFailed)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	require.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+	deletePods(ctx, woc)
+
+	wf, _, err := util.FormulateRetryWorkflow(ctx, woc.wf, false, "", nil, []string{"my-wf.a=message=bye"}, []string{"my-wf.a=data=my-data/fixed.tgz"})
+	require.NoError(t, err)
+	woc = newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByName("my-wf.a")
+	require.NotNil(t, node)
+	assert.Equal(t, "bye", node.Inputs.Parameters[0].Value.String())
+	assert.Equal(t, "my-data/fixed.tgz", node.Inputs.Artifacts[0].S3.Key)
+	require.NotNil(t, node.ArgumentOverrides)
+	assert.Equal(t, "bye", node.ArgumentOverrides.Parameters[0].Value.String())
+	assert.Equal(t, "my-data/fixed.tgz", node.ArgumentOverrides.Artifacts[0].S3.Key)
+	pods, err := listPods(woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	assert.Equal(t, []string{"bye"}, pods.Items[0].Spec.Containers[1].Args)
+}
+
 func TestWorkflowOutputs(t *testing.T) {
 	wf := wfv1.MustUnmarshalWorkflow(`
 metadata:
@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util"
 )
@@ -30,6 +31,34 @@ func TestWorkflowTemplateRef(t *testing.T) {
 	assert.Equal(t, "77", woc.globalParams["workflow.priority"])
 }
 
+func TestWorkflowTemplateRefRevision(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(wfWithTmplRef)
+	wf.Spec.WorkflowTemplateRef.Revision = 1
+	wftmpl := wfv1.MustUnmarshalWorkflowTemplate(wfTmpl)
+	revision := wftmpl.DeepCopy()
+	revision.Spec.Templates[0].Container.Image = "docker/whalesay:v1"
+
+	ctx := context.Background()
+	t.Run("Pinned", func(t *testing.T) {
+		cancel, controller := newController(wf.DeepCopy(), wftmpl)
+		defer cancel()
+		revisions := &mocks.TemplateRevisionRepo{}
+		revisions.On("GetWorkflowTemplate", wf.Namespace, wf.Spec.WorkflowTemplateRef.Name, int64(1)).Return(revision, nil)
+		controller.templateRevisions = revisions
+		woc := newWorkflowOperationCtx(wf.DeepCopy(), controller)
+		woc.operate(ctx)
+		assert.Equal(t, revision.Spec.Templates, woc.execWf.Spec.Templates)
+	})
+	t.Run("NoPersistence", func(t *testing.T) {
+		cancel, controller := newController(wf.DeepCopy(), wftmpl)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf.DeepCopy(), controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowError, woc.wf.Status.Phase)
+		assert.Contains(t, woc.wf.Status.Message, "template revisions require persistence to be configured")
+	})
+}
+
 func TestWorkflowTemplateRefWithArgs(t *testing.T) {
 	wf := wfv1.MustUnmarshalWorkflow(wfWithTmplRef)
 	wftmpl := wfv1.MustUnmarshalWorkflowTemplate(wfTmpl)
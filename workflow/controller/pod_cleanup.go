@@ -3,6 +3,7 @@ package controller
 import (
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 
@@ -23,6 +24,7 @@ func (woc *wfOperationCtx) queuePodsForCleanup() {
 	selector, _ := podGC.GetLabelSelector()
 	workflowPhase := woc.wf.Status.Phase
 	objs, _ := woc.controller.podInformer.GetIndexer().ByIndex(indexes.WorkflowIndex, woc.wf.Namespace+"/"+woc.wf.Name)
+	compactedPrefixes := woc.compactedNodePrefixes()
 	for _, obj := range objs {
 		pod := obj.(*apiv1.Pod)
 		if _, ok := pod.Labels[common.LabelKeyComponent]; ok { // for these types we don't want to do PodGC
@@ -30,6 +32,10 @@ func (woc *wfOperationCtx) queuePodsForCleanup() {
 		}
 		nodeID := woc.nodeID(pod)
 		nodePhase, err := woc.wf.Status.Nodes.GetPhase(nodeID)
+		if err != nil && isCompactedPod(pod, compactedPrefixes) {
+			// only successful nodes are compacted
+			nodePhase, err = ptr.To(wfv1.NodeSucceeded), nil
+		}
 		if err != nil {
 			woc.log.Errorf("was unable to obtain node for %s", nodeID)
 			continue
@@ -0,0 +1,166 @@
+package controller
+
+import (
+	"context"
+	"sort"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	v1 "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// quotaRequirements returns the resource quota that the given number of the template's pods need, named as resource
+// quotas name it
+func quotaRequirements(tmpl *wfv1.Template, executorResources apiv1.ResourceRequirements, pods int) apiv1.ResourceList {
+	containers := []apiv1.ResourceRequirements{executorResources}
+	switch {
+	case tmpl.Container != nil:
+		containers = append(containers, tmpl.Container.Resources)
+	case tmpl.Script != nil:
+		containers = append(containers, tmpl.Script.Resources)
+	case tmpl.ContainerSet != nil:
+		for _, c := range tmpl.ContainerSet.Containers {
+			containers = append(containers, c.Resources)
+		}
+	}
+	for _, sidecar := range tmpl.Sidecars {
+		containers = append(containers, sidecar.Resources)
+	}
+	required := apiv1.ResourceList{
+		apiv1.ResourcePods: *resource.NewQuantity(int64(pods), resource.DecimalSI),
+	}
+	add := func(name apiv1.ResourceName, quantity resource.Quantity) {
+		total := required[name]
+		for i := 0; i < pods; i++ {
+			total.Add(quantity)
+		}
+		required[name] = total
+	}
+	for _, c := range containers {
+		// containers' requests default to their limits
+		requests := apiv1.ResourceList{}
+		for name, quantity := range c.Limits {
+			requests[name] = quantity
+		}
+		for name, quantity := range c.Requests {
+			requests[name] = quantity
+		}
+		for name, quantity := range requests {
+			add("requests."+name, quantity)
+			switch name {
+			case apiv1.ResourceCPU, apiv1.ResourceMemory, apiv1.ResourceEphemeralStorage:
+				add(name, quantity)
+			}
+		}
+		for name, quantity := range c.Limits {
+			add("limits."+name, quantity)
+		}
+	}
+	return required
+}
+
+// workflowQuotaDemand returns the resource quota that the workflow's pods need, assuming each of its pod templates
+// runs once
+func (wfc *WorkflowController) workflowQuotaDemand(key string) apiv1.ResourceList {
+	demand := apiv1.ResourceList{}
+	obj, exists, err := wfc.wfInformer.GetIndexer().GetByKey(key)
+	if err != nil || !exists {
+		return demand
+	}
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return demand
+	}
+	wf, err := util.FromUnstructured(un)
+	if err != nil {
+		return demand
+	}
+	executorResources := wfc.Config.GetExecutor().Resources
+	for _, tmpl := range wfc.workflowQuotaTemplates(wf) {
+		if !tmpl.IsPodType() {
+			continue
+		}
+		for name, quantity := range quotaRequirements(&tmpl, executorResources, 1) {
+			total := demand[name]
+			total.Add(quantity)
+			demand[name] = total
+		}
+	}
+	return demand
+}
+
+// workflowQuotaTemplates returns the templates of the workflow, or of the workflow template it refers to
+func (wfc *WorkflowController) workflowQuotaTemplates(wf *wfv1.Workflow) []wfv1.Template {
+	if wf.Status.StoredWorkflowSpec != nil {
+		return wf.Status.StoredWorkflowSpec.Templates
+	}
+	ref := wf.Spec.WorkflowTemplateRef
+	if ref == nil {
+		return wf.Spec.Templates
+	}
+	templates := wf.Spec.Templates
+	if ref.ClusterScope {
+		if wfc.cwftmplInformer == nil {
+			return templates
+		}
+		if cwftmpl, err := wfc.cwftmplInformer.Lister().Get(ref.Name); err == nil {
+			templates = append(templates, cwftmpl.Spec.Templates...)
+		}
+	} else if wftmpl, err := wfc.wftmplInformer.Lister().WorkflowTemplates(wf.Namespace).Get(ref.Name); err == nil {
+		templates = append(templates, wftmpl.Spec.Templates...)
+	}
+	return templates
+}
+
+// startQuotaInformer creates and runs the resource quota informer the first time it is called, and returns whether
+// it has synced. Resource quotas are only watched once a feature needs them: when workflows are admitted by them, or
+// when a gang of pods is first admitted.
+func (wfc *WorkflowController) startQuotaInformer(ctx context.Context) bool {
+	wfc.quotaInformerOnce.Do(func() {
+		wfc.quotaInformer = v1.NewResourceQuotaInformer(wfc.kubeclientset, wfc.GetManagedNamespace(), quotaResyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		go wfc.quotaInformer.Run(ctx.Done())
+	})
+	return cache.WaitForCacheSync(ctx.Done(), wfc.quotaInformer.HasSynced)
+}
+
+// unscopedResourceQuotas returns the namespace's resource quotas that apply to every pod. Quotas with scopes are
+// ignored, as whether they apply to a pod can only be known once it has been created.
+func (wfc *WorkflowController) unscopedResourceQuotas(namespace string) ([]*apiv1.ResourceQuota, error) {
+	objs, err := wfc.quotaInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		return nil, err
+	}
+	quotas := make([]*apiv1.ResourceQuota, 0, len(objs))
+	for _, obj := range objs {
+		quota, ok := obj.(*apiv1.ResourceQuota)
+		if ok && len(quota.Spec.Scopes) == 0 && quota.Spec.ScopeSelector == nil {
+			quotas = append(quotas, quota)
+		}
+	}
+	sort.Slice(quotas, func(i, j int) bool { return quotas[i].Name < quotas[j].Name })
+	return quotas, nil
+}
+
+// namespaceQuotaHeadroom returns the resources still available in the namespace's unscoped resource quotas
+func (wfc *WorkflowController) namespaceQuotaHeadroom(namespace string) (apiv1.ResourceList, error) {
+	headroom := apiv1.ResourceList{}
+	quotas, err := wfc.unscopedResourceQuotas(namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, quota := range quotas {
+		for name, hard := range quota.Status.Hard {
+			available := hard.DeepCopy()
+			available.Sub(quota.Status.Used[name])
+			if existing, ok := headroom[name]; !ok || available.Cmp(existing) < 0 {
+				headroom[name] = available
+			}
+		}
+	}
+	return headroom, nil
+}
@@ -0,0 +1,145 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestQuotaRequirements(t *testing.T) {
+	tmpl := &wfv1.Template{
+		Container: &apiv1.Container{Resources: apiv1.ResourceRequirements{
+			Limits: apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse("1"), "nvidia.com/gpu": resource.MustParse("1")},
+		}},
+		Sidecars: []wfv1.UserContainer{{Container: apiv1.Container{Resources: apiv1.ResourceRequirements{
+			Requests: apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("64Mi")},
+		}}}},
+	}
+	executor := apiv1.ResourceRequirements{Requests: apiv1.ResourceList{apiv1.ResourceCPU: resource.MustParse("100m")}}
+	required := quotaRequirements(tmpl, executor, 2)
+	assertQuantity := func(expected string, name apiv1.ResourceName) {
+		t.Helper()
+		expectedQuantity := resource.MustParse(expected)
+		quantity := required[name]
+		assert.Equal(t, 0, expectedQuantity.Cmp(quantity), "%s is %s", name, quantity.String())
+	}
+	assertQuantity("2", apiv1.ResourcePods)
+	assertQuantity("2200m", apiv1.ResourceRequestsCPU)
+	assertQuantity("2200m", apiv1.ResourceCPU)
+	assertQuantity("2", apiv1.ResourceLimitsCPU)
+	assertQuantity("2", "requests.nvidia.com/gpu")
+	assertQuantity("2", "limits.nvidia.com/gpu")
+	assertQuantity("128Mi", apiv1.ResourceRequestsMemory)
+	assertQuantity("128Mi", apiv1.ResourceMemory)
+	assert.NotContains(t, required, apiv1.ResourceName("nvidia.com/gpu"))
+}
+
+func TestWorkflowQuotaDemand(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: a
+        template: a
+      - name: b
+        template: b
+  - name: a
+    container:
+      image: my-image
+      resources:
+        requests:
+          cpu: 500m
+  - name: b
+    script:
+      image: my-image
+      source: echo
+      resources:
+        requests:
+          cpu: 250m
+`)
+	cancel, controller := newController(wf, func(controller *WorkflowController) {
+		controller.Config.ResourceQuotaAdmission = true
+	})
+	defer cancel()
+	demand := controller.workflowQuotaDemand("my-ns/my-wf")
+	cpu := demand[apiv1.ResourceRequestsCPU]
+	assert.Equal(t, "750m", cpu.String())
+	pods := demand[apiv1.ResourcePods]
+	assert.Equal(t, "2", pods.String())
+	assert.Empty(t, controller.workflowQuotaDemand("my-ns/missing"))
+}
+
+func TestNamespaceQuotaHeadroom(t *testing.T) {
+	cancel, controller := newController(func(controller *WorkflowController) {
+		controller.Config.ResourceQuotaAdmission = true
+	})
+	defer cancel()
+	ctx := context.Background()
+	for _, quota := range []*apiv1.ResourceQuota{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "small", Namespace: "my-ns"},
+			Status: apiv1.ResourceQuotaStatus{
+				Hard: apiv1.ResourceList{apiv1.ResourceRequestsCPU: resource.MustParse("2")},
+				Used: apiv1.ResourceList{apiv1.ResourceRequestsCPU: resource.MustParse("500m")},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "large", Namespace: "my-ns"},
+			Status: apiv1.ResourceQuotaStatus{
+				Hard: apiv1.ResourceList{apiv1.ResourceRequestsCPU: resource.MustParse("4"), apiv1.ResourcePods: resource.MustParse("10")},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "scoped", Namespace: "my-ns"},
+			Spec:       apiv1.ResourceQuotaSpec{Scopes: []apiv1.ResourceQuotaScope{apiv1.ResourceQuotaScopeBestEffort}},
+			Status: apiv1.ResourceQuotaStatus{
+				Hard: apiv1.ResourceList{apiv1.ResourcePods: resource.MustParse("1")},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "other-ns"},
+			Status: apiv1.ResourceQuotaStatus{
+				Hard: apiv1.ResourceList{apiv1.ResourceRequestsCPU: resource.MustParse("0")},
+			},
+		},
+	} {
+		_, err := controller.kubeclientset.CoreV1().ResourceQuotas(quota.Namespace).Create(ctx, quota, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	require.Eventually(t, func() bool {
+		return len(controller.quotaInformer.GetStore().List()) == 4
+	}, 5*time.Second, 10*time.Millisecond)
+	headroom, err := controller.namespaceQuotaHeadroom("my-ns")
+	require.NoError(t, err)
+	cpu := headroom[apiv1.ResourceRequestsCPU]
+	assert.Equal(t, "1500m", cpu.String())
+	pods := headroom[apiv1.ResourcePods]
+	assert.Equal(t, "10", pods.String())
+}
+
+func TestStartQuotaInformer(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	// resource quotas are not watched unless a feature needs them
+	assert.Nil(t, controller.quotaInformer)
+	ctx, cancelInformer := context.WithCancel(context.Background())
+	defer cancelInformer()
+	require.True(t, controller.startQuotaInformer(ctx))
+	informer := controller.quotaInformer
+	require.NotNil(t, informer)
+	require.True(t, controller.startQuotaInformer(ctx))
+	assert.Same(t, informer, controller.quotaInformer)
+}
@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// defaultResourceEscalationFactor is the factor used when retryStrategy.resourceEscalation.factor is not set
+const defaultResourceEscalationFactor = 2
+
+// escalateResources multiplies the resources of the main container of a node which is retried after running out of
+// memory, when retryStrategy.resourceEscalation is specified, and records them in the node
+func (woc *wfOperationCtx) escalateResources(node *wfv1.NodeStatus, tmpl *wfv1.Template, pod *apiv1.Pod) error {
+	retryStrategy := woc.retryStrategy(tmpl)
+	if node == nil || retryStrategy == nil || retryStrategy.ResourceEscalation == nil {
+		return nil
+	}
+	attempts := woc.oomKilledAttempts(node)
+	if attempts == 0 {
+		return nil
+	}
+	for i, c := range pod.Spec.Containers {
+		if c.Name != common.MainContainerName {
+			continue
+		}
+		resources, err := escalateResourceRequirements(*retryStrategy.ResourceEscalation, c.Resources, attempts)
+		if err != nil {
+			return err
+		}
+		woc.log.WithField("nodeName", node.Name).Infof("Escalated resources after %d attempts were OOMKilled", attempts)
+		pod.Spec.Containers[i].Resources = resources
+		node.EscalatedResources = resources.DeepCopy()
+		woc.wf.Status.Nodes.Set(node.ID, *node)
+	}
+	return nil
+}
+
+// oomKilledAttempts returns the number of the previous attempts of a retried node that were OOMKilled
+func (woc *wfOperationCtx) oomKilledAttempts(node *wfv1.NodeStatus) int {
+	for _, retryNode := range woc.wf.Status.Nodes {
+		if retryNode.Type != wfv1.NodeTypeRetry || !retryNode.HasChild(node.ID) {
+			continue
+		}
+		attempts := 0
+		for _, childNodeID := range getChildNodeIdsRetried(&retryNode, woc.wf.Status.Nodes) {
+			childNode, err := woc.wf.Status.Nodes.Get(childNodeID)
+			if err == nil && childNodeID != node.ID && isOOMKilled(childNode) {
+				attempts++
+			}
+		}
+		return attempts
+	}
+	return 0
+}
+
+// escalateResourceRequirements multiplies the memory, and optionally CPU, requests and limits by the escalation factor
+// once for each attempt, up to the maximums
+func escalateResourceRequirements(escalation wfv1.ResourceEscalation, resources apiv1.ResourceRequirements, attempts int) (apiv1.ResourceRequirements, error) {
+	factor := float64(defaultResourceEscalationFactor)
+	if escalation.Factor != nil {
+		var err error
+		factor, err = strconv.ParseFloat(escalation.Factor.String(), 64)
+		if err != nil {
+			return resources, fmt.Errorf("invalid retryStrategy.resourceEscalation.factor: %w", err)
+		}
+	}
+	multiplier := math.Pow(factor, float64(attempts))
+	escalated := *resources.DeepCopy()
+	for _, list := range []apiv1.ResourceList{escalated.Requests, escalated.Limits} {
+		if q, ok := list[apiv1.ResourceMemory]; ok {
+			list[apiv1.ResourceMemory] = escalateQuantity(q, multiplier, escalation.MaxMemory, false)
+		}
+		if q, ok := list[apiv1.ResourceCPU]; ok && escalation.CPU {
+			list[apiv1.ResourceCPU] = escalateQuantity(q, multiplier, escalation.MaxCPU, true)
+		}
+	}
+	return escalated, nil
+}
+
+// escalateQuantity multiplies the quantity, rounding up to whole units, or thousandths of units if milli, up to the
+// maximum. A quantity which already exceeds the maximum is left as it is.
+func escalateQuantity(q resource.Quantity, multiplier float64, maxQuantity *resource.Quantity, milli bool) resource.Quantity {
+	var escalated *resource.Quantity
+	if milli {
+		escalated = resource.NewMilliQuantity(int64(math.Ceil(float64(q.MilliValue())*multiplier)), q.Format)
+	} else {
+		escalated = resource.NewQuantity(int64(math.Ceil(float64(q.Value())*multiplier)), q.Format)
+	}
+	if maxQuantity != nil && escalated.Cmp(*maxQuantity) > 0 {
+		if q.Cmp(*maxQuantity) > 0 {
+			return q
+		}
+		return maxQuantity.DeepCopy()
+	}
+	return *escalated
+}
@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	intstrutil "github.com/argoproj/argo-workflows/v3/util/intstr"
+)
+
+const resourceEscalationWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: resource-escalation
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    retryStrategy:
+      limit: 3
+      resourceEscalation:
+        factor: 2
+        maxMemory: 3Gi
+    container:
+      image: busybox
+      resources:
+        requests:
+          memory: 1Gi
+          cpu: 500m
+        limits:
+          memory: 2Gi
+`
+
+func TestEscalateResources(t *testing.T) {
+	ctx := context.Background()
+	wf := wfv1.MustUnmarshalWorkflow(resourceEscalationWorkflow)
+	cancel, controller := newController(wf)
+	defer cancel()
+	oomKilled := func(pod *apiv1.Pod, _ *wfOperationCtx) {
+		pod.Status.Message = "main: OOMKilled (exit code 137)"
+	}
+	// mainResources returns the resources of the main container of the attempt's pod
+	mainResources := func(t *testing.T, woc *wfOperationCtx, attempt string) apiv1.ResourceRequirements {
+		node, err := woc.wf.GetNodeByName(attempt)
+		require.NoError(t, err)
+		pod, err := getPod(woc, woc.getPodName(node.Name, node.TemplateName))
+		require.NoError(t, err)
+		for _, c := range pod.Spec.Containers {
+			if c.Name == "main" {
+				return c.Resources
+			}
+		}
+		t.Fatalf("pod of %s has no main container", attempt)
+		return apiv1.ResourceRequirements{}
+	}
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	resources := mainResources(t, woc, "resource-escalation(0)")
+	assert.Equal(t, "1Gi", resources.Requests.Memory().String())
+	node, err := woc.wf.GetNodeByName("resource-escalation(0)")
+	require.NoError(t, err)
+	assert.Nil(t, node.EscalatedResources)
+
+	makePodsPhase(ctx, woc, apiv1.PodFailed, oomKilled)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	resources = mainResources(t, woc, "resource-escalation(1)")
+	assert.Equal(t, "2Gi", resources.Requests.Memory().String())
+	assert.Equal(t, "3Gi", resources.Limits.Memory().String())
+	assert.Equal(t, "500m", resources.Requests.Cpu().String())
+	node, err = woc.wf.GetNodeByName("resource-escalation(1)")
+	require.NoError(t, err)
+	require.NotNil(t, node.EscalatedResources)
+	assert.Equal(t, resources, *node.EscalatedResources)
+
+	// failures other than running out of memory do not escalate the resources any further
+	makePodsPhase(ctx, woc, apiv1.PodFailed)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	resources = mainResources(t, woc, "resource-escalation(2)")
+	assert.Equal(t, "2Gi", resources.Requests.Memory().String())
+}
+
+func TestEscalateResourceRequirements(t *testing.T) {
+	resources := apiv1.ResourceRequirements{
+		Requests: apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("1Gi"), apiv1.ResourceCPU: resource.MustParse("500m")},
+		Limits:   apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("8Gi"), apiv1.ResourceCPU: resource.MustParse("1")},
+	}
+	maxMemory := resource.MustParse("4Gi")
+	maxCPU := resource.MustParse("2")
+
+	t.Run("Memory", func(t *testing.T) {
+		escalated, err := escalateResourceRequirements(wfv1.ResourceEscalation{Factor: intstrutil.ParsePtr("1.5"), MaxMemory: &maxMemory}, resources, 2)
+		require.NoError(t, err)
+		assert.Equal(t, "2304Mi", escalated.Requests.Memory().String())
+		// already above the maximum
+		assert.Equal(t, "8Gi", escalated.Limits.Memory().String())
+		assert.Equal(t, "500m", escalated.Requests.Cpu().String())
+		assert.Equal(t, "1Gi", resources.Requests.Memory().String(), "the resources must not be modified")
+	})
+
+	t.Run("CPU", func(t *testing.T) {
+		escalated, err := escalateResourceRequirements(wfv1.ResourceEscalation{CPU: true, MaxCPU: &maxCPU}, resources, 2)
+		require.NoError(t, err)
+		assert.Equal(t, "4Gi", escalated.Requests.Memory().String())
+		assert.Equal(t, "2", escalated.Requests.Cpu().String())
+		assert.Equal(t, "2", escalated.Limits.Cpu().String())
+	})
+
+	t.Run("InvalidFactor", func(t *testing.T) {
+		_, err := escalateResourceRequirements(wfv1.ResourceEscalation{Factor: intstrutil.ParsePtr("double")}, resources, 1)
+		assert.Error(t, err)
+	})
+}
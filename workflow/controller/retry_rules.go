@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// evictedMessage matches the messages the kubelet gives the pods it evicts
+var evictedMessage = regexp.MustCompile(`^(The node was low on resource|Pod ephemeral local storage usage exceeds|Container \S+ exceeded its local ephemeral storage limit|Usage of EmptyDir volume)`)
+
+// isOOMKilled returns whether the node had a container killed for running out of memory
+func isOOMKilled(node *wfv1.NodeStatus) bool {
+	return strings.Contains(node.Message, "OOMKilled")
+}
+
+// findRetryRule returns the first retry rule matching the last child of a retry node, and the number of its children
+// that rule matched, i.e. the number of times the node has been retried under it
+func (woc *wfOperationCtx) findRetryRule(rules []wfv1.RetryRule, childNodeIds []string, lastChildNode *wfv1.NodeStatus) (*wfv1.RetryRule, int, error) {
+	i, err := matchRetryRules(rules, lastChildNode)
+	if err != nil || i < 0 {
+		return nil, 0, err
+	}
+	retries := 0
+	for _, childNodeID := range childNodeIds {
+		childNode, err := woc.wf.Status.Nodes.Get(childNodeID)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !childNode.FailedOrError() {
+			continue
+		}
+		j, err := matchRetryRules(rules, childNode)
+		if err != nil {
+			return nil, 0, err
+		}
+		if i == j {
+			retries++
+		}
+	}
+	return &rules[i], retries, nil
+}
+
+// matchRetryRules returns the index of the first retry rule matching the node, or -1 if none match
+func matchRetryRules(rules []wfv1.RetryRule, node *wfv1.NodeStatus) (int, error) {
+	for i, rule := range rules {
+		matched, err := matchRetryRule(rule.Match, node)
+		if err != nil {
+			return -1, fmt.Errorf("retryRules[%d]: %w", i, err)
+		}
+		if matched {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// matchRetryRule returns whether the node satisfies each of the conditions of the match
+func matchRetryRule(match *wfv1.RetryRuleMatch, node *wfv1.NodeStatus) (bool, error) {
+	if match == nil {
+		return true, nil
+	}
+	if match.Status != "" && node.Phase != match.Status {
+		return false, nil
+	}
+	if len(match.ExitCodes) > 0 {
+		if node.Outputs == nil || node.Outputs.ExitCode == nil {
+			return false, nil
+		}
+		exitCode, err := strconv.ParseInt(*node.Outputs.ExitCode, 10, 32)
+		if err != nil || !slices.Contains(match.ExitCodes, int32(exitCode)) {
+			return false, nil
+		}
+	}
+	if match.OOMKilled && !isOOMKilled(node) {
+		return false, nil
+	}
+	if match.Evicted && !evictedMessage.MatchString(node.Message) {
+		return false, nil
+	}
+	if match.Message != "" {
+		matched, err := regexp.MatchString(match.Message, node.Message)
+		if err != nil {
+			return false, fmt.Errorf("invalid message pattern: %w", err)
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestMatchRetryRule(t *testing.T) {
+	oomKilled := &wfv1.NodeStatus{Phase: wfv1.NodeFailed, Message: "main: OOMKilled (exit code 137)", Outputs: &wfv1.Outputs{ExitCode: ptr.To("137")}}
+	evicted := &wfv1.NodeStatus{Phase: wfv1.NodeFailed, Message: "The node was low on resource: ephemeral-storage."}
+	errored := &wfv1.NodeStatus{Phase: wfv1.NodeError, Message: "pod deleted"}
+	for name, tt := range map[string]struct {
+		match *wfv1.RetryRuleMatch
+		node  *wfv1.NodeStatus
+		want  bool
+	}{
+		"Any":            {nil, errored, true},
+		"ExitCode":       {&wfv1.RetryRuleMatch{ExitCodes: []int32{1, 137}}, oomKilled, true},
+		"OtherExitCode":  {&wfv1.RetryRuleMatch{ExitCodes: []int32{1}}, oomKilled, false},
+		"NoExitCode":     {&wfv1.RetryRuleMatch{ExitCodes: []int32{1}}, evicted, false},
+		"OOMKilled":      {&wfv1.RetryRuleMatch{OOMKilled: true}, oomKilled, true},
+		"NotOOMKilled":   {&wfv1.RetryRuleMatch{OOMKilled: true}, evicted, false},
+		"Evicted":        {&wfv1.RetryRuleMatch{Evicted: true}, evicted, true},
+		"NotEvicted":     {&wfv1.RetryRuleMatch{Evicted: true}, oomKilled, false},
+		"Message":        {&wfv1.RetryRuleMatch{Message: "^pod (deleted|evicted)$"}, errored, true},
+		"OtherMessage":   {&wfv1.RetryRuleMatch{Message: "^pod evicted$"}, errored, false},
+		"Status":         {&wfv1.RetryRuleMatch{Status: wfv1.NodeError}, errored, true},
+		"OtherStatus":    {&wfv1.RetryRuleMatch{Status: wfv1.NodeError}, oomKilled, false},
+		"AllConditions":  {&wfv1.RetryRuleMatch{Status: wfv1.NodeFailed, OOMKilled: true, ExitCodes: []int32{137}}, oomKilled, true},
+		"SomeConditions": {&wfv1.RetryRuleMatch{Status: wfv1.NodeError, OOMKilled: true}, oomKilled, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := matchRetryRule(tt.match, tt.node)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+	_, err := matchRetryRule(&wfv1.RetryRuleMatch{Message: "("}, errored)
+	assert.Error(t, err)
+}
@@ -17,7 +17,6 @@ import (
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/template"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
-	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
 )
 
@@ -150,7 +149,7 @@ func (woc *wfOperationCtx) executeSteps(ctx context.Context, nodeName string, tm
 						woc.updated = true
 					}
 
-					err = woc.processAggregateNodeOutputs(stepsCtx.scope, prefix, childNodes)
+					err = woc.processAggregateNodeOutputs(ctx, stepsCtx.scope, prefix, childNodes)
 					if err != nil {
 						return node, err
 					}
@@ -184,7 +183,7 @@ func (woc *wfOperationCtx) executeSteps(ctx context.Context, nodeName string, tm
 	}
 
 	if node.MemoizationStatus != nil {
-		c := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, node.MemoizationStatus.CacheName)
+		c := woc.controller.cacheFactory.GetCache(node.MemoizationStatus.GetCacheType(), node.MemoizationStatus.CacheName)
 		err := c.Save(ctx, node.MemoizationStatus.Key, node.ID, node.Outputs)
 		if err != nil {
 			woc.log.WithFields(log.Fields{"nodeID": node.ID}).WithError(err).Error("Failed to save node outputs to cache")
@@ -246,11 +245,32 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 	}
 
 	// Next, expand the step's withItems (if any)
+	unexpandedStepGroup := stepGroup
 	stepGroup, err = woc.expandStepGroup(sgNodeName, stepGroup, stepsCtx)
 	if err != nil {
 		return woc.markNodeError(sgNodeName, err), nil
 	}
 
+	// The pods of a gang are only created once enough of them can be placed, so skip the expanded steps of those that cannot be yet
+	waitingForGang := make(map[string]bool)
+	for _, step := range unexpandedStepGroup {
+		if !step.ShouldExpand() {
+			continue
+		}
+		var expandedStepNames, nodeNames []string
+		for _, expandedStep := range stepGroup {
+			if strings.HasPrefix(expandedStep.Name, step.Name+"(") {
+				expandedStepNames = append(expandedStepNames, expandedStep.Name)
+				nodeNames = append(nodeNames, fmt.Sprintf("%s.%s", sgNodeName, expandedStep.Name))
+			}
+		}
+		if !woc.waitForGang(ctx, stepsCtx.tmplCtx, &step, sgNodeName, nodeNames) {
+			for _, name := range expandedStepNames {
+				waitingForGang[name] = true
+			}
+		}
+	}
+
 	// Maps nodes to their steps
 	nodeSteps := make(map[string]wfv1.WorkflowStep)
 
@@ -259,6 +279,9 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 
 	// Kick off all parallel steps in the group
 	for _, step := range stepGroup {
+		if waitingForGang[step.Name] {
+			continue
+		}
 		childNodeName := fmt.Sprintf("%s.%s", sgNodeName, step.Name)
 
 		// Check the step's when clause to decide if it should execute
@@ -282,7 +305,13 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 		if stepsCtx.boundaryID == "" {
 			woc.log.Warnf("boundaryID was nil")
 		}
-		childNode, err := woc.executeTemplate(ctx, childNodeName, &step, stepsCtx.tmplCtx, step.Arguments, &executeTemplateOpts{boundaryID: stepsCtx.boundaryID, onExitTemplate: stepsCtx.onExitTemplate})
+		var childNode *wfv1.NodeStatus
+		opts := &executeTemplateOpts{boundaryID: stepsCtx.boundaryID, onExitTemplate: stepsCtx.onExitTemplate}
+		if step.Loop != nil {
+			childNode, err = woc.executeLoop(ctx, childNodeName, &step, step.Loop, stepsCtx.tmplCtx, step.Arguments, opts)
+		} else {
+			childNode, err = woc.executeTemplate(ctx, childNodeName, &step, stepsCtx.tmplCtx, step.Arguments, opts)
+		}
 		if err != nil {
 			switch err {
 			case ErrDeadlineExceeded:
@@ -306,6 +335,10 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 	if err != nil {
 		return nil, err
 	}
+	// Return if any steps have not been started yet
+	if len(waitingForGang) > 0 {
+		return node, nil
+	}
 	// Return if not all children completed
 	completed := true
 	for _, childNodeID := range node.Children {
@@ -111,6 +111,9 @@ func (woc *wfOperationCtx) taskResultReconciliation() {
 		if result.Progress.IsValid() {
 			newNode.Progress = result.Progress
 		}
+		if result.Checkpoint != nil {
+			newNode.Checkpoint = result.Checkpoint.DeepCopy()
+		}
 		if !reflect.DeepEqual(old, newNode) {
 			woc.log.
 				WithField("nodeID", nodeID).
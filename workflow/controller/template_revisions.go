@@ -0,0 +1,54 @@
+package controller
+
+import (
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// addTemplateRevisionHandler records a revision of each template the informer sees, so templates are versioned
+// however they are edited, whether through the API, kubectl or GitOps. Revisions are only recorded for new
+// generations of templates, so templates may be seen again, when they are resynced or the controller restarts, and
+// revisions that failed to be recorded are recorded when the templates are next resynced.
+func (wfc *WorkflowController) addTemplateRevisionHandler(informer cache.SharedIndexInformer, record func(obj interface{})) error {
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: record,
+		UpdateFunc: func(_, obj interface{}) {
+			record(obj)
+		},
+	})
+	return err
+}
+
+func (wfc *WorkflowController) recordWorkflowTemplateRevision(obj interface{}) {
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok || wfc.templateRevisions == nil {
+		return
+	}
+	wftmpl := &wfv1.WorkflowTemplate{}
+	err := util.FromUnstructuredObj(un, wftmpl)
+	if err == nil {
+		_, err = wfc.templateRevisions.SaveWorkflowTemplate(wftmpl)
+	}
+	if err != nil {
+		log.WithError(err).WithField("namespace", un.GetNamespace()).WithField("name", un.GetName()).Error("Failed to record workflow template revision")
+	}
+}
+
+func (wfc *WorkflowController) recordClusterWorkflowTemplateRevision(obj interface{}) {
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok || wfc.templateRevisions == nil {
+		return
+	}
+	cwftmpl := &wfv1.ClusterWorkflowTemplate{}
+	err := util.FromUnstructuredObj(un, cwftmpl)
+	if err == nil {
+		_, err = wfc.templateRevisions.SaveClusterWorkflowTemplate(cwftmpl)
+	}
+	if err != nil {
+		log.WithError(err).WithField("name", un.GetName()).Error("Failed to record cluster workflow template revision")
+	}
+}
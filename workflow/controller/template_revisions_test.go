@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestRecordTemplateRevision(t *testing.T) {
+	toUnstructured := func(obj interface{}) *unstructured.Unstructured {
+		un, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		require.NoError(t, err)
+		return &unstructured.Unstructured{Object: un}
+	}
+	wftmpl := wfv1.MustUnmarshalWorkflowTemplate(`
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: my-template
+  namespace: my-ns
+  generation: 2
+spec:
+  templates:
+  - name: main
+    container:
+      image: busybox
+`)
+	cwftmpl := wfv1.MustUnmarshalClusterWorkflowTemplate(`
+apiVersion: argoproj.io/v1alpha1
+kind: ClusterWorkflowTemplate
+metadata:
+  name: my-cluster-template
+spec:
+  templates:
+  - name: main
+    container:
+      image: busybox
+`)
+	cancel, controller := newController()
+	defer cancel()
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		controller.templateRevisions = nil
+		controller.recordWorkflowTemplateRevision(toUnstructured(wftmpl))
+	})
+	t.Run("WorkflowTemplate", func(t *testing.T) {
+		revisions := &mocks.TemplateRevisionRepo{}
+		revisions.On("SaveWorkflowTemplate", mock.MatchedBy(func(x *wfv1.WorkflowTemplate) bool {
+			return x.Namespace == "my-ns" && x.Name == "my-template" && x.Generation == 2 && x.Spec.Templates[0].Container.Image == "busybox"
+		})).Return(int64(1), nil)
+		controller.templateRevisions = revisions
+		controller.recordWorkflowTemplateRevision(toUnstructured(wftmpl))
+		revisions.AssertExpectations(t)
+	})
+	t.Run("ClusterWorkflowTemplate", func(t *testing.T) {
+		revisions := &mocks.TemplateRevisionRepo{}
+		revisions.On("SaveClusterWorkflowTemplate", mock.MatchedBy(func(x *wfv1.ClusterWorkflowTemplate) bool {
+			return x.Name == "my-cluster-template"
+		})).Return(int64(1), nil)
+		controller.templateRevisions = revisions
+		controller.recordClusterWorkflowTemplateRevision(toUnstructured(cwftmpl))
+		revisions.AssertExpectations(t)
+	})
+}
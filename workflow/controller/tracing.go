@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+// A workflow is traced as a span for the workflow, with a child span for each node. The IDs of the trace and spans
+// are derived from the workflow's UID and the nodes' IDs, rather than being random, so spans emitted across many
+// reconciliations (and by the workflow's pods) belong to the same trace, even if the controller restarts. Spans are
+// emitted once their node or workflow is fulfilled.
+
+// workflowTraceID returns the ID of the workflow's trace
+func workflowTraceID(wf *wfv1.Workflow) trace.TraceID {
+	if id, err := uuid.Parse(string(wf.UID)); err == nil {
+		return trace.TraceID(id)
+	}
+	sum := sha256.Sum256([]byte(wf.UID))
+	return trace.TraceID(sum[:16])
+}
+
+// spanID returns the ID of the span for the node, or of the workflow if nodeID is empty
+func spanID(wf *wfv1.Workflow, nodeID string) trace.SpanID {
+	sum := sha256.Sum256([]byte(string(wf.UID) + "/" + nodeID))
+	return trace.SpanID(sum[:8])
+}
+
+// nodeSpanContext returns the context of the span for the node, or of the workflow if nodeID is empty
+func nodeSpanContext(wf *wfv1.Workflow, nodeID string) trace.SpanContext {
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    workflowTraceID(wf),
+		SpanID:     spanID(wf, nodeID),
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+}
+
+// parentNodeID returns the ID of the node whose span is the parent of the node's span, or empty if that is the
+// workflow's span
+func parentNodeID(nodes wfv1.Nodes, node wfv1.NodeStatus) string {
+	for _, n := range nodes {
+		switch n.Type {
+		case wfv1.NodeTypeRetry, wfv1.NodeTypeLoop, wfv1.NodeTypeStepGroup, wfv1.NodeTypeTaskGroup, wfv1.NodeTypePod:
+			for _, child := range n.Children {
+				if child == node.ID {
+					return n.ID
+				}
+			}
+		}
+	}
+	if _, ok := nodes[node.BoundaryID]; ok && node.BoundaryID != node.ID {
+		return node.BoundaryID
+	}
+	return ""
+}
+
+// recordSpans emits spans for the nodes, and the workflow, that have been fulfilled by this operation
+func (woc *wfOperationCtx) recordSpans(ctx context.Context) {
+	if !woc.controller.tracing.Enabled() {
+		return
+	}
+	tracer := woc.controller.tracing.Tracer()
+	for id, node := range woc.wf.Status.Nodes {
+		if !node.Phase.Fulfilled() || node.StartedAt.IsZero() {
+			continue
+		}
+		if prevPhase, ok := woc.preExecutionNodePhases[id]; ok && prevPhase.Fulfilled() {
+			continue
+		}
+		parentCtx := trace.ContextWithRemoteSpanContext(ctx, nodeSpanContext(woc.wf, parentNodeID(woc.wf.Status.Nodes, node)))
+		spanCtx := telemetry.ContextWithSpanIDs(parentCtx, workflowTraceID(woc.wf), spanID(woc.wf, id))
+		attributes := []attribute.KeyValue{
+			attribute.String("argo.workflow.namespace", woc.wf.Namespace),
+			attribute.String("argo.workflow.name", woc.wf.Name),
+			attribute.String("argo.node.id", node.ID),
+			attribute.String("argo.node.name", node.Name),
+			attribute.String("argo.node.type", string(node.Type)),
+			attribute.String("argo.node.phase", string(node.Phase)),
+			attribute.String("argo.template.name", node.TemplateName),
+		}
+		if node.Type == wfv1.NodeTypePod {
+			attributes = append(attributes, attribute.String("k8s.pod.name", woc.getPodName(node.Name, node.TemplateName)))
+		}
+		_, span := tracer.Start(spanCtx, node.DisplayName,
+			trace.WithTimestamp(node.StartedAt.Time),
+			trace.WithAttributes(attributes...))
+		if node.FailedOrError() {
+			span.SetStatus(codes.Error, node.Message)
+		}
+		span.End(trace.WithTimestamp(node.FinishedAt.Time))
+	}
+	if woc.wf.Status.Fulfilled() && !woc.orig.Status.Fulfilled() && !woc.wf.Status.StartedAt.IsZero() {
+		spanCtx := telemetry.ContextWithSpanIDs(ctx, workflowTraceID(woc.wf), spanID(woc.wf, ""))
+		_, span := tracer.Start(spanCtx, woc.wf.Name,
+			trace.WithNewRoot(),
+			trace.WithTimestamp(woc.wf.Status.StartedAt.Time),
+			trace.WithAttributes(
+				attribute.String("argo.workflow.namespace", woc.wf.Namespace),
+				attribute.String("argo.workflow.name", woc.wf.Name),
+				attribute.String("argo.workflow.uid", string(woc.wf.UID)),
+				attribute.String("argo.workflow.phase", string(woc.wf.Status.Phase)),
+			))
+		if !woc.wf.Status.Successful() {
+			span.SetStatus(codes.Error, woc.wf.Status.Message)
+		}
+		span.End(trace.WithTimestamp(woc.wf.Status.FinishedAt.Time))
+	}
+}
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+func TestRecordSpans(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+  uid: 4f2e0f1c-2b5a-4f4e-9d0a-3c1b2a9e8d7f
+spec:
+  entrypoint: main
+  templates:
+   - name: main
+     dag:
+       tasks:
+       - name: pod
+         template: pod
+   - name: pod
+     container:
+       image: my-image
+`)
+	ctx := context.Background()
+	exporter := tracetest.NewInMemoryExporter()
+	tracing, err := telemetry.NewTracing(ctx, "test", tracesdk.WithSyncer(exporter))
+	require.NoError(t, err)
+	cancel, controller := newController(wf, func(controller *WorkflowController) {
+		controller.tracing = tracing
+	})
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	assert.Empty(t, exporter.GetSpans(), "no spans until nodes are fulfilled")
+
+	pods, err := listPods(woc)
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	podNode := woc.wf.Status.Nodes.FindByDisplayName("pod")
+	require.NotNil(t, podNode)
+	assert.Contains(t, pods.Items[0].Spec.Containers[0].Env, apiv1.EnvVar{
+		Name:  telemetry.EnvVarTraceParent,
+		Value: telemetry.TraceParent(nodeSpanContext(woc.wf, podNode.ID)),
+	})
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	require.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+
+	spans := map[trace.SpanID]tracetest.SpanStub{}
+	for _, span := range exporter.GetSpans() {
+		assert.Equal(t, workflowTraceID(woc.wf), span.SpanContext.TraceID())
+		spans[span.SpanContext.SpanID()] = span
+	}
+	require.Len(t, spans, 3)
+	if assert.Contains(t, spans, spanID(woc.wf, "")) {
+		assert.Equal(t, "my-wf", spans[spanID(woc.wf, "")].Name)
+		assert.False(t, spans[spanID(woc.wf, "")].Parent.IsValid())
+	}
+	if assert.Contains(t, spans, spanID(woc.wf, woc.wf.Name)) {
+		assert.Equal(t, spanID(woc.wf, ""), spans[spanID(woc.wf, woc.wf.Name)].Parent.SpanID())
+	}
+	if assert.Contains(t, spans, spanID(woc.wf, podNode.ID)) {
+		assert.Equal(t, "pod", spans[spanID(woc.wf, podNode.ID)].Name)
+		assert.Equal(t, spanID(woc.wf, woc.wf.Name), spans[spanID(woc.wf, podNode.ID)].Parent.SpanID())
+	}
+
+	exporter.Reset()
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Empty(t, exporter.GetSpans(), "spans are only emitted once")
+}
+
+func TestParentNodeID(t *testing.T) {
+	nodes := wfv1.Nodes{
+		"wf":       {ID: "wf", Type: wfv1.NodeTypeSteps, BoundaryID: ""},
+		"group":    {ID: "group", Type: wfv1.NodeTypeStepGroup, BoundaryID: "wf", Children: []string{"retry"}},
+		"retry":    {ID: "retry", Type: wfv1.NodeTypeRetry, BoundaryID: "wf", Children: []string{"attempt"}},
+		"attempt":  {ID: "attempt", Type: wfv1.NodeTypePod, BoundaryID: "wf"},
+		"orphaned": {ID: "orphaned", Type: wfv1.NodeTypePod, BoundaryID: "missing"},
+	}
+	assert.Equal(t, "", parentNodeID(nodes, nodes["wf"]))
+	assert.Equal(t, "wf", parentNodeID(nodes, nodes["group"]))
+	assert.Equal(t, "group", parentNodeID(nodes, nodes["retry"]))
+	assert.Equal(t, "retry", parentNodeID(nodes, nodes["attempt"]))
+	assert.Equal(t, "", parentNodeID(nodes, nodes["orphaned"]))
+}
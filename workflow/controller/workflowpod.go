@@ -24,6 +24,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/deprecation"
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/intstr"
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
 	"github.com/argoproj/argo-workflows/v3/util/template"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/entrypoint"
@@ -205,6 +206,7 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		pod.ObjectMeta.Labels[common.LabelKeyControllerInstanceID] = woc.controller.Config.InstanceID
 	}
 
+	woc.setCheckpointKey(tmpl, nodeName)
 	woc.addArchiveLocation(tmpl)
 
 	err = woc.setupServiceAccount(ctx, pod, tmpl)
@@ -216,6 +218,11 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		// we do not need the wait container for resource templates because
 		// argoexec runs as the main container and will perform the job of
 		// annotating the outputs or errors, making the wait container redundant.
+		if tmpl.HasStreamedOutputArtifacts() {
+			if err := woc.createArtifactStreamSecret(ctx); err != nil {
+				return nil, err
+			}
+		}
 		waitCtr := woc.newWaitContainer(tmpl)
 		pod.Spec.Containers = append(pod.Spec.Containers, *waitCtr)
 	}
@@ -270,6 +277,8 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		addScriptStagingVolume(pod)
 	}
 
+	addCheckpointVolume(pod, tmpl)
+
 	// addInitContainers, addSidecars and addOutputArtifactsVolumes should be called after all
 	// volumes have been manipulated in the main container since volumeMounts are mirrored
 	addInitContainers(pod, tmpl)
@@ -314,6 +323,11 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		)
 	}
 
+	// only propagate the trace context if tracing is enabled, so the pod's spans are children of the node's span
+	if woc.controller.tracing.Enabled() {
+		envVars = append(envVars, apiv1.EnvVar{Name: telemetry.EnvVarTraceParent, Value: telemetry.TraceParent(nodeSpanContext(woc.wf, nodeID))})
+	}
+
 	for i, c := range pod.Spec.InitContainers {
 		c.Env = append(c.Env, apiv1.EnvVar{Name: common.EnvVarContainerName, Value: c.Name})
 		c.Env = append(c.Env, apiv1.EnvVar{Name: common.EnvVarTemplate, Value: envVarTemplateValue})
@@ -500,6 +514,10 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		return nil, err
 	}
 
+	if err := woc.escalateResources(node, tmpl, pod); err != nil {
+		return nil, err
+	}
+
 	if templateDeadline != nil && (pod.Spec.ActiveDeadlineSeconds == nil || time.Since(*templateDeadline).Seconds() < float64(*pod.Spec.ActiveDeadlineSeconds)) {
 		newActiveDeadlineSeconds := int64(time.Until(*templateDeadline).Seconds())
 		if newActiveDeadlineSeconds <= 1 {
@@ -599,12 +617,27 @@ func substitutePodParams(pod *apiv1.Pod, globalParams common.Parameters, tmpl *w
 func (woc *wfOperationCtx) newInitContainer(tmpl *wfv1.Template) apiv1.Container {
 	ctr := woc.newExecContainer(common.InitContainerName, tmpl)
 	ctr.Command = append([]string{"argoexec", "init"}, woc.getExecutorLogOpts()...)
+	if hasStreamedInputArtifacts(tmpl) {
+		ctr.Env = append(ctr.Env, woc.artifactStreamTokenEnvVar(true))
+	}
 	return *ctr
 }
 
 func (woc *wfOperationCtx) newWaitContainer(tmpl *wfv1.Template) *apiv1.Container {
 	ctr := woc.newExecContainer(common.WaitContainerName, tmpl)
 	ctr.Command = append([]string{"argoexec", "wait"}, woc.getExecutorLogOpts()...)
+	if tmpl.HasStreamedOutputArtifacts() {
+		ctr.Env = append(ctr.Env, apiv1.EnvVar{
+			Name: common.EnvVarPodIP,
+			ValueFrom: &apiv1.EnvVarSource{
+				FieldRef: &apiv1.ObjectFieldSelector{
+					APIVersion: "v1",
+					FieldPath:  "status.podIP",
+				},
+			},
+		}, woc.artifactStreamTokenEnvVar(false))
+		ctr.Ports = append(ctr.Ports, apiv1.ContainerPort{Name: "artifacts", ContainerPort: common.ArtifactStreamPort})
+	}
 	return ctr
 }
 
@@ -843,6 +876,9 @@ func (woc *wfOperationCtx) GetTemplateByBoundaryID(boundaryID string) (*wfv1.Tem
 	if err != nil {
 		return nil, false, err
 	}
+	if tmpl, err := woc.getDynamicDAGTemplate(boundaryNode); tmpl != nil || err != nil {
+		return tmpl, false, err
+	}
 	tmplCtx, err := woc.createTemplateContext(boundaryNode.GetTemplateScope())
 	if err != nil {
 		return nil, false, err
@@ -1085,7 +1121,7 @@ func (woc *wfOperationCtx) addArchiveLocation(tmpl *wfv1.Template) {
 		return
 	}
 	archiveLogs := woc.IsArchiveLogs(tmpl)
-	needLocation := archiveLogs
+	needLocation := archiveLogs || tmpl.Checkpoint != nil
 	for _, art := range append(tmpl.Inputs.Artifacts, tmpl.Outputs.Artifacts...) {
 		if !art.HasLocation() {
 			needLocation = true
@@ -1267,6 +1303,9 @@ func createArchiveLocationSecret(tmpl *wfv1.Template, volMap map[string]apiv1.Vo
 
 func createSecretVolume(volMap map[string]apiv1.Volume, art wfv1.Artifact, keyMap map[string]bool) {
 	createSecretVolumesFromArtifactLocations(volMap, []*wfv1.ArtifactLocation{&art.ArtifactLocation}, keyMap)
+	if art.Encryption != nil {
+		createSecretVal(volMap, art.Encryption.KeySecret, keyMap)
+	}
 }
 
 func createSecretVolumesAndMountsFromArtifactLocations(artifactLocations []*wfv1.ArtifactLocation) ([]apiv1.Volume, []apiv1.VolumeMount) {
@@ -1311,6 +1350,9 @@ func createSecretVolumesFromArtifactLocations(volMap map[string]apiv1.Volume, ar
 			createSecretVal(volMap, artifactLocation.Git.UsernameSecret, keyMap)
 			createSecretVal(volMap, artifactLocation.Git.PasswordSecret, keyMap)
 			createSecretVal(volMap, artifactLocation.Git.SSHPrivateKeySecret, keyMap)
+			if artifactLocation.Git.Commit != nil {
+				createSecretVal(volMap, artifactLocation.Git.Commit.SigningKeySecret, keyMap)
+			}
 		} else if artifactLocation.Artifactory != nil {
 			createSecretVal(volMap, artifactLocation.Artifactory.UsernameSecret, keyMap)
 			createSecretVal(volMap, artifactLocation.Artifactory.PasswordSecret, keyMap)
@@ -1332,6 +1374,14 @@ func createSecretVolumesFromArtifactLocations(volMap map[string]apiv1.Volume, ar
 			createSecretVal(volMap, artifactLocation.HTTP.Auth.OAuth2.TokenURLSecret, keyMap)
 		} else if artifactLocation.Azure != nil {
 			createSecretVal(volMap, artifactLocation.Azure.AccountKeySecret, keyMap)
+		} else if artifactLocation.OCI != nil {
+			createSecretVal(volMap, artifactLocation.OCI.UsernameSecret, keyMap)
+			createSecretVal(volMap, artifactLocation.OCI.PasswordSecret, keyMap)
+		} else if artifactLocation.SFTP != nil {
+			createSecretVal(volMap, artifactLocation.SFTP.UsernameSecret, keyMap)
+			createSecretVal(volMap, artifactLocation.SFTP.PasswordSecret, keyMap)
+			createSecretVal(volMap, artifactLocation.SFTP.SSHPrivateKeySecret, keyMap)
+			createSecretVal(volMap, artifactLocation.SFTP.KnownHostsSecret, keyMap)
 		}
 	}
 }
@@ -1247,6 +1247,103 @@ func Test_createSecretVolumesFromArtifactLocations_SSECUsed(t *testing.T) {
 	}
 }
 
+func TestCreateSecretVolumesAndMountsEncryption(t *testing.T) {
+	tmpl := &wfv1.Template{
+		Outputs: wfv1.Outputs{
+			Artifacts: []wfv1.Artifact{{
+				Name: "foo",
+				Path: "/tmp/file",
+				Encryption: &wfv1.ArtifactEncryption{
+					KeySecret: &apiv1.SecretKeySelector{
+						LocalObjectReference: apiv1.LocalObjectReference{Name: "my-artifact-key"},
+						Key:                  "key",
+					},
+				},
+			}},
+		},
+	}
+
+	volumes, mounts := createSecretVolumesAndMounts(tmpl)
+
+	require.Len(t, volumes, 1)
+	assert.Equal(t, "my-artifact-key", volumes[0].Secret.SecretName)
+	assert.Equal(t, []apiv1.KeyToPath{{Key: "key", Path: "key"}}, volumes[0].Secret.Items)
+	require.Len(t, mounts, 1)
+	assert.Equal(t, common.SecretVolMountPath+"/my-artifact-key", mounts[0].MountPath)
+}
+
+func TestNewWaitContainerStreamedArtifacts(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	woc := newWorkflowOperationCtx(wfv1.MustUnmarshalWorkflow(helloWorldWf), controller)
+	tmpl := &wfv1.Template{Outputs: wfv1.Outputs{Artifacts: []wfv1.Artifact{{Name: "foo", Path: "/tmp/file"}}}}
+
+	ctr := woc.newWaitContainer(tmpl)
+	assert.Empty(t, ctr.Ports)
+
+	tmpl.Outputs.Artifacts[0].Stream = true
+	ctr = woc.newWaitContainer(tmpl)
+	assert.Contains(t, ctr.Env, apiv1.EnvVar{
+		Name:      common.EnvVarPodIP,
+		ValueFrom: &apiv1.EnvVarSource{FieldRef: &apiv1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "status.podIP"}},
+	})
+	assert.Contains(t, ctr.Env, apiv1.EnvVar{
+		Name: common.EnvVarArtifactStreamToken,
+		ValueFrom: &apiv1.EnvVarSource{SecretKeyRef: &apiv1.SecretKeySelector{
+			LocalObjectReference: apiv1.LocalObjectReference{Name: "hello-world-artifact-stream"},
+			Key:                  "token",
+			Optional:             ptr.To(false),
+		}},
+	})
+	assert.Equal(t, []apiv1.ContainerPort{{Name: "artifacts", ContainerPort: common.ArtifactStreamPort}}, ctr.Ports)
+}
+
+func TestNewInitContainerStreamedArtifacts(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	woc := newWorkflowOperationCtx(wfv1.MustUnmarshalWorkflow(helloWorldWf), controller)
+	tmpl := &wfv1.Template{Inputs: wfv1.Inputs{Artifacts: []wfv1.Artifact{{Name: "foo", Path: "/tmp/file"}}}}
+
+	ctr := woc.newInitContainer(tmpl)
+	for _, env := range ctr.Env {
+		assert.NotEqual(t, common.EnvVarArtifactStreamToken, env.Name)
+	}
+
+	tmpl.Inputs.Artifacts[0].StreamSource = &wfv1.ArtifactStreamSource{URL: "http://10.0.0.1:2747/artifacts/foo"}
+	ctr = woc.newInitContainer(tmpl)
+	assert.Contains(t, ctr.Env, apiv1.EnvVar{
+		Name: common.EnvVarArtifactStreamToken,
+		ValueFrom: &apiv1.EnvVarSource{SecretKeyRef: &apiv1.SecretKeySelector{
+			LocalObjectReference: apiv1.LocalObjectReference{Name: "hello-world-artifact-stream"},
+			Key:                  "token",
+			Optional:             ptr.To(true),
+		}},
+	})
+}
+
+func TestCreateWorkflowPodStreamedArtifacts(t *testing.T) {
+	ctx := context.Background()
+	wf := wfv1.MustUnmarshalWorkflow(streamingOutputArtifactsWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+
+	woc.operate(ctx)
+
+	secret, err := controller.kubeclientset.CoreV1().Secrets(wf.Namespace).Get(ctx, "streaming-artifact-stream", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Len(t, secret.StringData["token"], 64)
+	require.Len(t, secret.OwnerReferences, 1)
+	assert.Equal(t, "streaming", secret.OwnerReferences[0].Name)
+	assert.NotContains(t, wfv1.MustMarshallJSON(woc.wf), secret.StringData["token"])
+
+	// the token is kept when the secret exists
+	require.NoError(t, woc.createArtifactStreamSecret(ctx))
+	existing, err := controller.kubeclientset.CoreV1().Secrets(wf.Namespace).Get(ctx, "streaming-artifact-stream", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, secret.StringData, existing.StringData)
+}
+
 func TestCreateSecretVolumesFromArtifactLocationsSessionToken(t *testing.T) {
 	ctx := context.Background()
 
@@ -0,0 +1,131 @@
+package executor
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/archive"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// checkpointArtifact returns the artifact the template's checkpoints are saved as
+func (we *WorkflowExecutor) checkpointArtifact() (*wfv1.Artifact, error) {
+	if we.Template.ArchiveLocation == nil {
+		return nil, fmt.Errorf("template artifact location not set")
+	}
+	art := &wfv1.Artifact{
+		Name:             "checkpoint",
+		Path:             we.Template.Checkpoint.Path,
+		ArtifactLocation: *we.Template.ArchiveLocation.DeepCopy(),
+	}
+	art.ArchiveLogs = nil
+	return art, art.SetKey(we.Template.Checkpoint.Key)
+}
+
+// checkpointDir returns where the checkpointed directory of the main container is mounted in the executor
+func (we *WorkflowExecutor) checkpointDir() string {
+	return filepath.Join(common.ExecutorMainFilesystemDir, we.Template.Checkpoint.Path)
+}
+
+// RestoreCheckpoint restores the checkpointed directory from the latest checkpoint, if there is one
+func (we *WorkflowExecutor) RestoreCheckpoint(ctx context.Context) error {
+	if we.Template.Checkpoint == nil {
+		return nil
+	}
+	art, err := we.checkpointArtifact()
+	if err != nil {
+		return fmt.Errorf("failed to restore checkpoint: %w", err)
+	}
+	driver, err := we.InitDriver(ctx, art)
+	if err != nil {
+		return err
+	}
+	tempFile := filepath.Join(os.TempDir(), "checkpoint.tgz")
+	err = traceArtifact(ctx, "restore-checkpoint", art, func(context.Context) error {
+		return driver.Load(art, tempFile)
+	})
+	if argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+		log.Info("No checkpoint to restore")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+	defer func() { _ = os.Remove(tempFile) }()
+	// checkpoints are archives of the directory itself, so strip its name
+	if err := extractTar(tempFile, we.checkpointDir(), 1); err != nil {
+		return fmt.Errorf("failed to extract checkpoint: %w", err)
+	}
+	log.WithField("path", art.Path).Info("Restored checkpoint")
+	now := metav1.Now()
+	return we.reportResult(ctx, wfv1.NodeResult{Checkpoint: &wfv1.CheckpointStatus{Artifact: *art, RestoredAt: &now}})
+}
+
+// saveCheckpoint saves the checkpointed directory to the artifact repository
+func (we *WorkflowExecutor) saveCheckpoint(ctx context.Context) error {
+	art, err := we.checkpointArtifact()
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp("", "checkpoint-*.tgz")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	err = archive.TarGzToWriter(we.checkpointDir(), gzip.DefaultCompression, f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	driver, err := we.InitDriver(ctx, art)
+	if err != nil {
+		return err
+	}
+	err = traceArtifact(ctx, "save-checkpoint", art, func(context.Context) error {
+		return driver.Save(f.Name(), art)
+	})
+	if err != nil {
+		return err
+	}
+	log.WithField("path", art.Path).Info("Saved checkpoint")
+	now := metav1.Now()
+	return we.reportResult(ctx, wfv1.NodeResult{Checkpoint: &wfv1.CheckpointStatus{Artifact: *art, SavedAt: &now}})
+}
+
+// monitorCheckpoint saves the checkpointed directory periodically, and once more when the context is cancelled, either
+// because the main container has exited or the executor is terminated (e.g. because the pod is being evicted), so that
+// a retry of the node can resume where it left off
+func (we *WorkflowExecutor) monitorCheckpoint(ctx context.Context) {
+	interval, err := we.Template.Checkpoint.GetInterval()
+	if err != nil {
+		log.WithError(err).Warnf("Invalid checkpoint interval, using %v", wfv1.DefaultCheckpointInterval)
+		interval = wfv1.DefaultCheckpointInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// the context is cancelled, so save with one that is not
+			if err := we.saveCheckpoint(context.WithoutCancel(ctx)); err != nil {
+				log.WithError(err).Warn("Failed to save checkpoint")
+			}
+			return
+		case <-ticker.C:
+			if err := we.saveCheckpoint(ctx); err != nil {
+				log.WithError(err).Warn("Failed to save checkpoint")
+			}
+		}
+	}
+}
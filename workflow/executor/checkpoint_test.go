@@ -0,0 +1,99 @@
+package executor
+
+import (
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	argofake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/util/archive"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+	"github.com/argoproj/argo-workflows/v3/workflow/executor/mocks"
+)
+
+func TestCheckpointArtifact(t *testing.T) {
+	we := &WorkflowExecutor{Template: wfv1.Template{
+		Checkpoint: &wfv1.Checkpoint{Path: "/checkpoint", Key: "my-wf/checkpoints/my-node.tgz"},
+		ArchiveLocation: &wfv1.ArtifactLocation{
+			ArchiveLogs: ptr.To(true),
+			S3:          &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}, Key: "my-wf/my-pod"},
+		},
+	}}
+	art, err := we.checkpointArtifact()
+	require.NoError(t, err)
+	assert.Equal(t, "checkpoint", art.Name)
+	assert.Equal(t, "/checkpoint", art.Path)
+	assert.Equal(t, "my-bucket", art.S3.Bucket)
+	assert.Equal(t, "my-wf/checkpoints/my-node.tgz", art.S3.Key)
+	assert.Nil(t, art.ArchiveLogs)
+	assert.Equal(t, "my-wf/my-pod", we.Template.ArchiveLocation.S3.Key)
+
+	we.Template.ArchiveLocation = nil
+	_, err = we.checkpointArtifact()
+	require.Error(t, err)
+}
+
+func TestExtractTarStripComponents(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "checkpoint")
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "epochs"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "step"), []byte("42"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "epochs", "3"), []byte("weights"), 0o644))
+
+	tarPath := filepath.Join(dir, "checkpoint.tgz")
+	f, err := os.Create(tarPath)
+	require.NoError(t, err)
+	require.NoError(t, archive.TarGzToWriter(src, gzip.DefaultCompression, f))
+	require.NoError(t, f.Close())
+
+	// the destination already exists, as it is a volume mount
+	dest := filepath.Join(dir, "restored")
+	require.NoError(t, os.Mkdir(dest, 0o755))
+	require.NoError(t, extractTar(tarPath, dest, 1))
+
+	data, err := os.ReadFile(filepath.Join(dest, "step"))
+	require.NoError(t, err)
+	assert.Equal(t, "42", string(data))
+	data, err = os.ReadFile(filepath.Join(dest, "epochs", "3"))
+	require.NoError(t, err)
+	assert.Equal(t, "weights", string(data))
+	_, err = os.Stat(filepath.Join(dest, "checkpoint"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestWaitStopsCheckpointing(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "step"), []byte("42"), 0o644))
+	mockRuntimeExecutor := &mocks.ContainerRuntimeExecutor{}
+	mockRuntimeExecutor.On("Wait", mock.Anything, mock.Anything).Return(nil)
+	driver := &existerDriver{keys: map[string]bool{}}
+	we := &WorkflowExecutor{
+		Template: wfv1.Template{
+			// the checkpointed directory is relative to where the main container's filesystem is mounted
+			Checkpoint: &wfv1.Checkpoint{Path: "/.." + dir, Interval: "1h", Key: "my-wf/checkpoints/my-node.tgz"},
+			ArchiveLocation: &wfv1.ArtifactLocation{
+				S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}, Key: "my-wf/my-pod"},
+			},
+			Container: &corev1.Container{Name: "main"},
+		},
+		RuntimeExecutor:  mockRuntimeExecutor,
+		taskResultClient: argofake.NewSimpleClientset().ArgoprojV1alpha1().WorkflowTaskResults(fakeNamespace),
+		newDriver: func(context.Context, *wfv1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+			return driver, nil
+		},
+	}
+	require.NoError(t, we.Wait(context.Background()))
+	// the last checkpoint is saved before waiting is done
+	assert.Equal(t, 1, driver.saves)
+	assert.True(t, driver.keys["my-wf/checkpoints/my-node.tgz"])
+}
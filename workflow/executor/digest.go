@@ -0,0 +1,100 @@
+package executor
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/argoproj/argo-workflows/v3/util/file"
+)
+
+const digestAlgorithm = "sha256"
+
+// fileDigest returns the SHA-256 digest of a file, e.g. "sha256:e3b0c442...", or "" if the path is a directory.
+// The digest of a tarball is taken over the files it holds rather than the tarball itself, so that archiving the
+// same files again, with new modification times or another compression level, gives the same digest.
+func fileDigest(localPath string) (string, error) {
+	fi, err := os.Stat(localPath)
+	if err != nil {
+		return "", err
+	}
+	if !fi.Mode().IsRegular() {
+		return "", nil
+	}
+	isTar, err := isTarball(localPath)
+	if err != nil {
+		return "", err
+	}
+	f, err := os.Open(filepath.Clean(localPath))
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+	h := sha256.New()
+	if isTar {
+		err = digestTar(h, f)
+	} else {
+		_, err = io.Copy(h, f)
+	}
+	if err != nil {
+		return "", err
+	}
+	return digestAlgorithm + ":" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// digestTar writes the path, type, permissions, link target and content of each entry of a compressed tarball
+// to the hash, leaving out the modification times and ownership of the entries
+func digestTar(h hash.Hash, f *os.File) error {
+	r, err := file.GetDecompressedReader(f)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = r.Close() }()
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(h, "%s\x00%c\x00%o\x00%s\x00%d\x00", header.Name, header.Typeflag, header.Mode&0o7777, header.Linkname, header.Size); err != nil {
+			return err
+		}
+		if _, err := io.Copy(h, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// contentAddressedKey returns the key of an artifact stored by its digest, "sha256/<digest>"
+func contentAddressedKey(digest string) string {
+	return path.Join(digestAlgorithm, strings.TrimPrefix(digest, digestAlgorithm+":"))
+}
+
+// verifyDigest verifies the file at localPath has the expected digest
+func verifyDigest(localPath, expected string) error {
+	if !strings.HasPrefix(expected, digestAlgorithm+":") {
+		return fmt.Errorf("unsupported digest %q, only %s is supported", expected, digestAlgorithm)
+	}
+	actual, err := fileDigest(localPath)
+	if err != nil {
+		return err
+	}
+	if actual == "" {
+		// directories are not digested
+		return nil
+	}
+	if actual != expected {
+		return fmt.Errorf("digest mismatch: expected %s but got %s", expected, actual)
+	}
+	return nil
+}
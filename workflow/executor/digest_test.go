@@ -0,0 +1,134 @@
+package executor
+
+import (
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/archive"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+func TestFileDigest(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "hello.txt")
+	require.NoError(t, os.WriteFile(file, []byte("hello world"), 0o600))
+
+	t.Run("File", func(t *testing.T) {
+		digest, err := fileDigest(file)
+		require.NoError(t, err)
+		assert.Equal(t, "sha256:b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9", digest)
+		assert.Equal(t, "sha256/b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9", contentAddressedKey(digest))
+	})
+	t.Run("Directory", func(t *testing.T) {
+		digest, err := fileDigest(dir)
+		require.NoError(t, err)
+		assert.Empty(t, digest)
+	})
+	t.Run("Verify", func(t *testing.T) {
+		require.NoError(t, verifyDigest(file, "sha256:b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"))
+		require.EqualError(t, verifyDigest(file, "sha256:0000"), "digest mismatch: expected sha256:0000 but got sha256:b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9")
+		require.EqualError(t, verifyDigest(file, "md5:0000"), `unsupported digest "md5:0000", only sha256 is supported`)
+	})
+}
+
+// tarGz archives the source path to a new tarball in dir
+func tarGz(t *testing.T, src, dir string, level int) string {
+	f, err := os.CreateTemp(dir, "*.tgz")
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, archive.TarGzToWriter(src, level, f))
+	return f.Name()
+}
+
+func TestFileDigestTarball(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "data")
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "sub", "hello.txt"), []byte("hello world"), 0o600))
+	dir := t.TempDir()
+	first := tarGz(t, src, dir, gzip.DefaultCompression)
+	later := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(src, "sub", "hello.txt"), later, later))
+	require.NoError(t, os.Chtimes(filepath.Join(src, "sub"), later, later))
+	second := tarGz(t, src, dir, gzip.BestCompression)
+
+	firstData, err := os.ReadFile(first)
+	require.NoError(t, err)
+	secondData, err := os.ReadFile(second)
+	require.NoError(t, err)
+	assert.NotEqual(t, firstData, secondData)
+	firstDigest, err := fileDigest(first)
+	require.NoError(t, err)
+	secondDigest, err := fileDigest(second)
+	require.NoError(t, err)
+	assert.Equal(t, firstDigest, secondDigest)
+	require.NoError(t, verifyDigest(second, firstDigest))
+
+	t.Run("Changed", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(src, "sub", "hello.txt"), []byte("goodbye world"), 0o600))
+		digest, err := fileDigest(tarGz(t, src, dir, gzip.DefaultCompression))
+		require.NoError(t, err)
+		assert.NotEqual(t, firstDigest, digest)
+	})
+}
+
+// existerDriver is an artifact driver that records the keys it saves
+type existerDriver struct {
+	artifactscommon.ArtifactDriver
+	keys  map[string]bool
+	saves int
+}
+
+func (d *existerDriver) Save(_ string, a *wfv1.Artifact) error {
+	key, err := a.GetKey()
+	d.keys[key] = true
+	d.saves++
+	return err
+}
+
+func (d *existerDriver) Exists(a *wfv1.Artifact) (bool, error) {
+	key, err := a.GetKey()
+	return d.keys[key], err
+}
+
+func TestSaveArtifactFromFileContentAddressed(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "data")
+	require.NoError(t, os.MkdirAll(src, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "hello.txt"), []byte("hello world"), 0o600))
+	dir := t.TempDir()
+	driver := &existerDriver{keys: map[string]bool{}}
+	we := WorkflowExecutor{
+		Template: wfv1.Template{ArchiveLocation: &wfv1.ArtifactLocation{
+			ContentAddressable: ptr.To(true),
+			S3:                 &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}, Key: "my-wf/my-pod"},
+		}},
+		newDriver: func(context.Context, *wfv1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+			return driver, nil
+		},
+	}
+
+	save := func() *wfv1.Artifact {
+		art := &wfv1.Artifact{Name: "data"}
+		require.NoError(t, we.saveArtifactFromFile(context.Background(), art, "data.tgz", tarGz(t, src, dir, gzip.DefaultCompression)))
+		return art
+	}
+	first := save()
+	later := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(src, "hello.txt"), later, later))
+	second := save()
+
+	// the second save is skipped, as the content has the same digest
+	assert.Equal(t, 1, driver.saves)
+	assert.Equal(t, first.Digest, second.Digest)
+	assert.Equal(t, first.S3.Key, second.S3.Key)
+	assert.Equal(t, contentAddressedKey(first.Digest), first.S3.Key)
+}
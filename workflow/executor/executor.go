@@ -49,6 +49,8 @@ import (
 const (
 	// This directory temporarily stores the tarballs of the artifacts before uploading
 	tempOutArtDir = "/tmp/argo/outputs/artifacts"
+	// the zstd compression level used when the zstd archive strategy does not specify one
+	defaultZstdCompressionLevel = 3
 )
 
 // WorkflowExecutor is program which runs as the init/wait container
@@ -80,6 +82,13 @@ type WorkflowExecutor struct {
 
 	annotationPatchTickDuration  time.Duration
 	readProgressFileTickDuration time.Duration
+
+	// the token streamed artifacts are served with, and the local paths of the streamed artifacts by name
+	streamToken       string
+	streamedArtifacts map[string]string
+
+	// newDriver creates artifact drivers, artifact.NewDriver if nil
+	newDriver artifact.NewDriverFunc
 }
 
 type Initializer interface {
@@ -209,7 +218,18 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 		if err := os.MkdirAll(tempArtDir, 0o700); err != nil {
 			return fmt.Errorf("failed to create artifact temporary parent directory %s: %w", tempArtDir, err)
 		}
-		err = artDriver.Load(driverArt, tempArtPath)
+		err = traceArtifact(ctx, "load-artifact", &art, func(ctx context.Context) error {
+			// a sub-path is only available from the artifact repository
+			if art.StreamSource != nil && art.SubPath == "" {
+				err := loadStreamedArtifact(ctx, art.StreamSource, tempArtPath)
+				if err == nil {
+					log.Infof("Loaded streamed artifact %s from %s", art.Name, art.StreamSource.URL)
+					return nil
+				}
+				log.WithError(err).Warnf("Failed to load streamed artifact %s, loading it from the artifact repository", art.Name)
+			}
+			return artDriver.Load(driverArt, tempArtPath)
+		})
 		if err != nil {
 			if art.Optional && argoerrs.IsCode(argoerrs.CodeNotFound, err) {
 				log.Infof("Skipping optional input artifact that was not found: %s", art.Name)
@@ -217,6 +237,11 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 			}
 			return fmt.Errorf("artifact %s failed to load: %w", art.Name, err)
 		}
+		if art.Digest != "" {
+			if err := verifyDigest(tempArtPath, art.Digest); err != nil {
+				return fmt.Errorf("artifact %s failed verification: %w", art.Name, err)
+			}
+		}
 
 		isTar := false
 		isZip := false
@@ -224,14 +249,14 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 			// explicitly not a tar
 			isTar = false
 			isZip = false
-		} else if art.GetArchive().Tar != nil {
+		} else if art.GetArchive().Tar != nil || art.GetArchive().Zstd != nil {
 			// explicitly a tar
 			isTar = true
 		} else if art.GetArchive().Zip != nil {
 			// explicitly a zip
 			isZip = true
 		} else {
-			// auto-detect if tarball
+			// auto-detect if tarball, compressed with either gzip or zstd
 			// (don't try to autodetect zip files for backwards compatibility)
 			isTar, err = isTarball(tempArtPath)
 			if err != nil {
@@ -308,7 +333,12 @@ func (we *WorkflowExecutor) SaveArtifacts(ctx context.Context) (wfv1.Artifacts,
 
 	aggregateError := ""
 	for _, art := range we.Template.Outputs.Artifacts {
-		saved, err := we.saveArtifact(ctx, common.MainContainerName, &art)
+		var saved bool
+		err := traceArtifact(ctx, "save-artifact", &art, func(ctx context.Context) error {
+			var err error
+			saved, err = we.saveArtifact(ctx, common.MainContainerName, &art)
+			return err
+		})
 
 		if err != nil {
 			aggregateError += err.Error() + "; "
@@ -345,17 +375,61 @@ func (we *WorkflowExecutor) saveArtifact(ctx context.Context, containerName stri
 	if err != nil {
 		return false, err
 	}
-	size := fi.Size()
+	size, err := uploadSize(localArtPath, fi)
+	if err != nil {
+		return false, err
+	}
 	if size == 0 {
 		log.Warnf("The file %q is empty. It may not be uploaded successfully depending on the artifact driver", localArtPath)
 	}
 	err = we.saveArtifactFromFile(ctx, art, fileName, localArtPath)
-	return err == nil, err
+	if err != nil {
+		return false, err
+	}
+	art.SizeBytes = size
+	if art.Stream {
+		if !fi.Mode().IsRegular() {
+			log.Warnf("Not streaming artifact %s, only files can be streamed", art.Name)
+		} else if err := we.streamArtifact(art, localArtPath); err != nil {
+			log.WithError(err).Warnf("Not streaming artifact %s", art.Name)
+		}
+	}
+	return true, nil
+}
+
+// uploadSize returns the number of bytes uploaded for an artifact: the size of the file, or of the archive of a
+// directory, or for a directory which is not archived, the total size of the files within it
+func uploadSize(localArtPath string, fi os.FileInfo) (int64, error) {
+	if !fi.IsDir() {
+		return fi.Size(), nil
+	}
+	var size int64
+	err := filepath.WalkDir(localArtPath, func(_ string, d os.DirEntry, err error) error {
+		if err != nil || !d.Type().IsRegular() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
 }
 
 // fileBase is probably path.Base(filePath), but can be something else
 func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.Artifact, fileName, localArtPath string) error {
-	if !art.HasKey() {
+	digest, err := fileDigest(localArtPath)
+	if err != nil {
+		return err
+	}
+	art.Digest = digest
+	// content addressed artifacts may be shared by several workflows, so are never garbage collected,
+	// and are never encrypted, as the workflows may use different keys
+	contentAddressed := !art.HasLocationOrKey() && digest != "" && art.Encryption == nil && (art.IsContentAddressable() || we.Template.ArchiveLocation.IsContentAddressable())
+	// artifacts without a key, such as git artifacts, are saved to their location
+	if !art.HasLocationOrKey() {
 		key, err := we.Template.ArchiveLocation.GetKey()
 		if err != nil {
 			return err
@@ -367,7 +441,12 @@ func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.
 		if err = art.SetType(art_location); err != nil {
 			return err
 		}
-		if err := art.SetKey(path.Join(key, fileName)); err != nil {
+		key = path.Join(key, fileName)
+		if contentAddressed {
+			key = contentAddressedKey(digest)
+			art.ArtifactGC = &wfv1.ArtifactGC{Strategy: wfv1.ArtifactGCNever}
+		}
+		if err := art.SetKey(key); err != nil {
 			return err
 		}
 	}
@@ -379,17 +458,31 @@ func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.
 	if err != nil {
 		return err
 	}
+	if contentAddressed {
+		if exister, ok := artDriver.(artifactcommon.ArtifactExister); ok {
+			exists, err := exister.Exists(driverArt)
+			if err != nil {
+				log.WithError(err).Warnf("Failed to check if artifact %s exists, uploading it", art.Name)
+			} else if exists {
+				we.maybeDeleteLocalArtPath(art, localArtPath)
+				log.Infof("Artifact %s with digest %s already exists, skipping upload", art.Name, digest)
+				return nil
+			}
+		}
+	}
 	err = artDriver.Save(localArtPath, driverArt)
 	if err != nil {
 		return err
 	}
-	we.maybeDeleteLocalArtPath(localArtPath)
+	we.maybeDeleteLocalArtPath(art, localArtPath)
 	log.Infof("Successfully saved file: %s", localArtPath)
 	return nil
 }
 
-func (we *WorkflowExecutor) maybeDeleteLocalArtPath(localArtPath string) {
-	if os.Getenv("REMOVE_LOCAL_ART_PATH") == "true" {
+func (we *WorkflowExecutor) maybeDeleteLocalArtPath(art *wfv1.Artifact, localArtPath string) {
+	if art.Stream {
+		log.WithField("localArtPath", localArtPath).Info("not deleting streamed artifact")
+	} else if os.Getenv("REMOVE_LOCAL_ART_PATH") == "true" {
 		log.WithField("localArtPath", localArtPath).Info("deleting local artifact")
 		// remove is best effort (the container will go away anyways).
 		// we just want reduce peak space usage
@@ -410,7 +503,12 @@ func (we *WorkflowExecutor) maybeDeleteLocalArtPath(localArtPath string) {
 func (we *WorkflowExecutor) stageArchiveFile(containerName string, art *wfv1.Artifact) (string, string, error) {
 	log.Infof("Staging artifact: %s", art.Name)
 	strategy := art.Archive
-	if strategy == nil {
+	if strategy == nil && art.Git != nil {
+		// git output artifacts are committed as they are
+		strategy = &wfv1.ArchiveStrategy{
+			None: &wfv1.NoneStrategy{},
+		}
+	} else if strategy == nil {
 		// If no strategy is specified, default to the tar strategy
 		strategy = &wfv1.ArchiveStrategy{
 			Tar: &wfv1.TarStrategy{},
@@ -424,6 +522,10 @@ func (we *WorkflowExecutor) stageArchiveFile(containerName string, art *wfv1.Art
 			compressionLevel = gzip.DefaultCompression
 		}
 	}
+	zstdCompressionLevel := defaultZstdCompressionLevel
+	if strategy.Zstd != nil && strategy.Zstd.CompressionLevel != nil {
+		zstdCompressionLevel = int(*strategy.Zstd.CompressionLevel)
+	}
 
 	if !we.isBaseImagePath(art.Path) {
 		// If we get here, we are uploading an artifact from a mirrored volume mount which the wait
@@ -455,6 +557,15 @@ func (we *WorkflowExecutor) stageArchiveFile(containerName string, art *wfv1.Art
 			log.Infof("Successfully staged %s from mirrored volume mount %s", art.Path, mountedArtPath)
 			return fileName, localArtPath, nil
 		}
+		if strategy.Zstd != nil {
+			fileName := fmt.Sprintf("%s.tar.zst", art.Name)
+			localArtPath := filepath.Join(tempOutArtDir, fileName)
+			if err := tarZstdToFile(mountedArtPath, zstdCompressionLevel, localArtPath); err != nil {
+				return "", "", err
+			}
+			log.Infof("Successfully staged %s from mirrored volume mount %s", art.Path, mountedArtPath)
+			return fileName, localArtPath, nil
+		}
 		fileName := fmt.Sprintf("%s.tgz", art.Name)
 		localArtPath := filepath.Join(tempOutArtDir, fileName)
 		f, err := os.Create(localArtPath)
@@ -529,9 +640,32 @@ func (we *WorkflowExecutor) stageArchiveFile(containerName string, art *wfv1.Art
 		log.Infof("Successfully zipped %s to %s", unarchivedArtPath, localArtPath)
 		return fileName, localArtPath, nil
 	}
+	if strategy.Zstd != nil {
+		fileName = fmt.Sprintf("%s.tar.zst", art.Name)
+		zstdArtPath := filepath.Join(tempOutArtDir, fileName)
+		if err := tarZstdToFile(localArtPath, zstdCompressionLevel, zstdArtPath); err != nil {
+			return "", "", err
+		}
+		log.Infof("Successfully compressed %s to %s", localArtPath, zstdArtPath)
+		return fileName, zstdArtPath, nil
+	}
 	return fileName, localArtPath, nil
 }
 
+// tarZstdToFile tars and zstd compresses the source path into a new file
+func tarZstdToFile(sourcePath string, level int, dest string) error {
+	f, err := os.Create(dest)
+	if err != nil {
+		return argoerrs.InternalWrapError(err)
+	}
+	w := bufio.NewWriter(f)
+	if err := archive.TarZstdToWriter(sourcePath, level, w); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
 // isBaseImagePath checks if the given artifact path resides in the base image layer of the container
 // versus a shared volume mount between the wait and main container
 func (we *WorkflowExecutor) isBaseImagePath(path string) bool {
@@ -609,6 +743,32 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 	return nil
 }
 
+// SaveDAG will save the content in the specified file path as the generated DAG
+func (we *WorkflowExecutor) SaveDAG(ctx context.Context) error {
+	if !we.Template.Outputs.HasDAG() {
+		return nil
+	}
+	path := we.Template.Outputs.DAG.Path
+	log.Infof("Saving output DAG from %s", path)
+	var data string
+	if we.isBaseImagePath(path) {
+		fileContents, err := we.RuntimeExecutor.GetFileContents(common.MainContainerName, path)
+		if err != nil {
+			return err
+		}
+		data = fileContents
+	} else {
+		fileContents, err := os.ReadFile(filepath.Clean(filepath.Join(common.ExecutorMainFilesystemDir, path)))
+		if err != nil {
+			return err
+		}
+		data = string(fileContents)
+	}
+	we.Template.Outputs.DAG.Value = strings.TrimSpace(data)
+	log.Info("Successfully saved output DAG")
+	return nil
+}
+
 func (we *WorkflowExecutor) SaveLogs(ctx context.Context) []wfv1.Artifact {
 	var logArtifacts []wfv1.Artifact
 	tempLogsDir := "/tmp/argo/outputs/logs"
@@ -690,7 +850,11 @@ func (we *WorkflowExecutor) newDriverArt(art *wfv1.Artifact) (*wfv1.Artifact, er
 
 // InitDriver initializes an instance of an artifact driver
 func (we *WorkflowExecutor) InitDriver(ctx context.Context, art *wfv1.Artifact) (artifactcommon.ArtifactDriver, error) {
-	driver, err := artifact.NewDriver(ctx, art, we)
+	newDriver := we.newDriver
+	if newDriver == nil {
+		newDriver = artifact.NewDriver
+	}
+	driver, err := newDriver(ctx, art, we)
 	if err == artifact.ErrUnsupportedDriver {
 		return nil, argoerrs.Errorf(argoerrs.CodeBadRequest, "Unsupported artifact driver for %s", art.Name)
 	}
@@ -906,12 +1070,12 @@ func isTarball(filePath string) (bool, error) {
 			log.Fatalf("Error closing file[%s]: %v", filePath, err)
 		}
 	}()
-	gzr, err := gzip.NewReader(f)
+	r, err := file.GetDecompressedReader(f)
 	if err != nil {
 		return false, nil
 	}
-	defer gzr.Close()
-	tarr := tar.NewReader(gzr)
+	defer r.Close()
+	tarr := tar.NewReader(r)
 	_, err = tarr.Next()
 	return err == nil, nil
 }
@@ -920,60 +1084,74 @@ func isTarball(filePath string) (bool, error) {
 // renaming it to the desired location
 func untar(tarPath string, destPath string) error {
 	decompressor := func(src string, dest string) error {
-		f, err := os.Open(src)
-		if err != nil {
+		return extractTar(src, dest, 0)
+	}
+
+	return unpack(tarPath, destPath, decompressor)
+}
+
+// extractTar extracts a tar.gz or tar.zst into a directory, stripping the given number of leading components from the paths of
+// its entries, and skipping entries with no path left
+func extractTar(src string, dest string, stripComponents int) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r, err := file.GetDecompressedReader(f)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
 			return err
+		case header == nil:
+			continue
 		}
-		defer f.Close()
-		gzr, err := file.GetGzipReader(f)
-		if err != nil {
+		name := filepath.Clean(header.Name)
+		if stripComponents > 0 {
+			parts := strings.SplitN(filepath.ToSlash(name), "/", stripComponents+1)
+			if len(parts) <= stripComponents {
+				continue
+			}
+			name = parts[stripComponents]
+		}
+		target := filepath.Join(dest, name)
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil && os.IsExist(err) {
 			return err
 		}
-		defer gzr.Close()
-		tr := tar.NewReader(gzr)
-		for {
-			header, err := tr.Next()
-			switch {
-			case err == io.EOF:
-				return nil
-			case err != nil:
+		switch header.Typeflag {
+		case tar.TypeSymlink:
+			err := os.Symlink(header.Linkname, target)
+			if err != nil {
 				return err
-			case header == nil:
-				continue
 			}
-			target := filepath.Join(dest, filepath.Clean(header.Name))
-			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil && os.IsExist(err) {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
 				return err
 			}
-			switch header.Typeflag {
-			case tar.TypeSymlink:
-				err := os.Symlink(header.Linkname, target)
-				if err != nil {
-					return err
-				}
-			case tar.TypeDir:
-				if err := os.MkdirAll(target, 0o755); err != nil {
-					return err
-				}
-			case tar.TypeReg:
-				f, err := os.OpenFile(target, os.O_CREATE|os.O_RDWR, os.FileMode(header.Mode))
-				if err != nil {
-					return err
-				}
-				if _, err := io.Copy(f, tr); err != nil {
-					return err
-				}
-				if err := f.Close(); err != nil {
-					return err
-				}
-				if err := os.Chtimes(target, header.AccessTime, header.ModTime); err != nil {
-					return err
-				}
+		case tar.TypeReg:
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_RDWR, os.FileMode(header.Mode))
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(f, tr); err != nil {
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+			if err := os.Chtimes(target, header.AccessTime, header.ModTime); err != nil {
+				return err
 			}
 		}
 	}
-
-	return unpack(tarPath, destPath, decompressor)
 }
 
 // unzip extracts a zip folder to a temporary directory,
@@ -1124,6 +1302,20 @@ func (we *WorkflowExecutor) Wait(ctx context.Context) error {
 
 	go we.monitorDeadline(ctx, containerNames)
 
+	if we.Template.Checkpoint != nil {
+		// stop checkpointing once the main container has exited, before its outputs are collected
+		checkpointCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			we.monitorCheckpoint(checkpointCtx)
+		}()
+		defer func() {
+			cancel()
+			<-done
+		}()
+	}
+
 	err := retryutil.OnError(executorretry.ExecutorRetry, errorsutil.IsTransientErr, func() error {
 		return we.RuntimeExecutor.Wait(ctx, containerNames)
 	})
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"testing"
@@ -20,6 +21,7 @@ import (
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	argofake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/util/archive"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor/mocks"
 )
@@ -115,6 +117,24 @@ func TestSaveParameters(t *testing.T) {
 	assert.Equal(t, "has a newline", we.Template.Outputs.Parameters[0].Value.String())
 }
 
+func TestSaveDAG(t *testing.T) {
+	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+	we := WorkflowExecutor{
+		PodName: fakePodName,
+		Template: wfv1.Template{
+			Outputs: wfv1.Outputs{DAG: &wfv1.DAGOutput{Path: "/dag.json"}},
+		},
+		ClientSet:       fake.NewSimpleClientset(),
+		Namespace:       fakeNamespace,
+		RuntimeExecutor: &mockRuntimeExecutor,
+	}
+	mockRuntimeExecutor.On("GetFileContents", fakeContainerName, "/dag.json").Return(`{"tasks": []}`+"\n", nil)
+
+	err := we.SaveDAG(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, `{"tasks": []}`, we.Template.Outputs.DAG.Value)
+}
+
 // TestIsBaseImagePath tests logic of isBaseImagePath which determines if a path is coming from a
 // base image layer versus a shared volumeMount.
 func TestIsBaseImagePath(t *testing.T) {
@@ -245,6 +265,7 @@ func TestIsTarball(t *testing.T) {
 		{"testdata/file.gz", false, false},
 		{"testdata/file.tar.gz", true, false},
 		{"testdata/file.tgz", true, false},
+		{"testdata/file.tar.zst", true, false},
 		{"testdata/not-found", false, true},
 	}
 
@@ -278,43 +299,46 @@ func TestUnzip(t *testing.T) {
 }
 
 func TestUntar(t *testing.T) {
-	tarPath := "testdata/file.tar.gz"
-	destPath := "testdata/untarredDir"
-	filePath := "testdata/untarredDir/file"
-	linkPath := "testdata/untarredDir/link"
-	emptyDirPath := "testdata/untarredDir/empty-dir"
-
-	// test
-	err := untar(tarPath, destPath)
-	require.NoError(t, err)
-
-	// check untarred contents
-	fileInfo, err := os.Stat(destPath)
-	require.NoError(t, err)
-	assert.True(t, fileInfo.Mode().IsDir())
-	fileInfo, err = os.Stat(filePath)
-	require.NoError(t, err)
-	assert.True(t, fileInfo.Mode().IsRegular())
-	dirInfo, err := os.Stat(destPath)
-	require.NoError(t, err)
-	// check that the modification time of the file is retained
-	assert.True(t, fileInfo.ModTime().Before(dirInfo.ModTime()))
-	fileInfo, err = os.Stat(linkPath)
-	require.NoError(t, err)
-	assert.True(t, fileInfo.Mode().IsRegular())
-	fileInfo, err = os.Stat(emptyDirPath)
-	require.NoError(t, err)
-	assert.True(t, fileInfo.Mode().IsDir())
-
-	// cleanup
-	err = os.Remove(linkPath)
-	require.NoError(t, err)
-	err = os.Remove(filePath)
-	require.NoError(t, err)
-	err = os.Remove(emptyDirPath)
-	require.NoError(t, err)
-	err = os.Remove(destPath)
-	require.NoError(t, err)
+	for _, tarPath := range []string{"testdata/file.tar.gz", "testdata/file.tar.zst"} {
+		t.Run(tarPath, func(t *testing.T) {
+			destPath := "testdata/untarredDir"
+			filePath := "testdata/untarredDir/file"
+			linkPath := "testdata/untarredDir/link"
+			emptyDirPath := "testdata/untarredDir/empty-dir"
+
+			// test
+			err := untar(tarPath, destPath)
+			require.NoError(t, err)
+
+			// check untarred contents
+			fileInfo, err := os.Stat(destPath)
+			require.NoError(t, err)
+			assert.True(t, fileInfo.Mode().IsDir())
+			fileInfo, err = os.Stat(filePath)
+			require.NoError(t, err)
+			assert.True(t, fileInfo.Mode().IsRegular())
+			dirInfo, err := os.Stat(destPath)
+			require.NoError(t, err)
+			// check that the modification time of the file is retained
+			assert.True(t, fileInfo.ModTime().Before(dirInfo.ModTime()))
+			fileInfo, err = os.Stat(linkPath)
+			require.NoError(t, err)
+			assert.True(t, fileInfo.Mode().IsRegular())
+			fileInfo, err = os.Stat(emptyDirPath)
+			require.NoError(t, err)
+			assert.True(t, fileInfo.Mode().IsDir())
+
+			// cleanup
+			err = os.Remove(linkPath)
+			require.NoError(t, err)
+			err = os.Remove(filePath)
+			require.NoError(t, err)
+			err = os.Remove(emptyDirPath)
+			require.NoError(t, err)
+			err = os.Remove(destPath)
+			require.NoError(t, err)
+		})
+	}
 }
 
 func TestChmod(t *testing.T) {
@@ -487,6 +511,57 @@ func TestSaveArtifacts(t *testing.T) {
 	}
 }
 
+func TestStageArchiveFileZstd(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "data.txt")
+	require.NoError(t, os.WriteFile(src, []byte("hello world"), 0o600))
+	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+	mockRuntimeExecutor.On("CopyFile", fakeContainerName, "/data.txt", mock.AnythingOfType("string"), mock.AnythingOfType("int")).
+		Run(func(args mock.Arguments) {
+			require.NoError(t, os.MkdirAll(tempOutArtDir, 0o755))
+			f, err := os.Create(args.String(2))
+			require.NoError(t, err)
+			defer f.Close()
+			require.NoError(t, archive.TarGzToWriter(src, args.Int(3), f))
+		}).
+		Return(nil)
+	we := WorkflowExecutor{RuntimeExecutor: &mockRuntimeExecutor}
+
+	fileName, localArtPath, err := we.stageArchiveFile(fakeContainerName, &wfv1.Artifact{
+		Name:    "my-art",
+		Path:    "/data.txt",
+		Archive: &wfv1.ArchiveStrategy{Zstd: &wfv1.ZstdStrategy{CompressionLevel: ptr.To(int32(19))}},
+	})
+	require.NoError(t, err)
+	defer os.Remove(localArtPath)
+	defer os.Remove(filepath.Join(tempOutArtDir, "data.txt"))
+	assert.Equal(t, "my-art.tar.zst", fileName)
+
+	dest := filepath.Join(t.TempDir(), "out")
+	require.NoError(t, untar(localArtPath, dest))
+	data, err := os.ReadFile(dest)
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(data))
+}
+
+func TestUploadSize(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("my-content"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "b.txt"), []byte("more"), 0o600))
+
+	fi, err := os.Stat(filepath.Join(dir, "a.txt"))
+	require.NoError(t, err)
+	size, err := uploadSize(filepath.Join(dir, "a.txt"), fi)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), size)
+
+	fi, err = os.Stat(dir)
+	require.NoError(t, err)
+	size, err = uploadSize(dir, fi)
+	require.NoError(t, err)
+	assert.Equal(t, int64(14), size)
+}
+
 func TestMonitorProgress(t *testing.T) {
 	ctx := context.Background()
 
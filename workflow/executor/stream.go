@@ -0,0 +1,151 @@
+package executor
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/env"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// streamedArtifactsPath is the path streamed artifacts are served under, followed by their name
+const streamedArtifactsPath = "/artifacts/"
+
+var (
+	// streamTimeout is how long the wait container serves streamed artifacts for
+	streamTimeout = env.LookupEnvDurationOr(common.EnvVarArtifactStreamTimeout, 5*time.Minute)
+	// streamClient fails fast when the pod that streams an artifact cannot be reached, so that the artifact is loaded
+	// from the artifact repository instead
+	streamClient = &http.Client{
+		Transport: &http.Transport{
+			DialContext:           (&net.Dialer{Timeout: 5 * time.Second}).DialContext,
+			ResponseHeaderTimeout: 10 * time.Second,
+		},
+	}
+)
+
+// streamArtifact makes a saved output artifact available to be served from this pod, and sets where it is served from
+func (we *WorkflowExecutor) streamArtifact(art *wfv1.Artifact, localArtPath string) error {
+	podIP := os.Getenv(common.EnvVarPodIP)
+	if podIP == "" {
+		return fmt.Errorf("%s is not set", common.EnvVarPodIP)
+	}
+	if we.streamToken == "" {
+		// the token is kept in a secret owned by the workflow, so that it is not recorded in the workflow's status
+		we.streamToken = os.Getenv(common.EnvVarArtifactStreamToken)
+		if we.streamToken == "" {
+			return fmt.Errorf("%s is not set", common.EnvVarArtifactStreamToken)
+		}
+		we.streamedArtifacts = map[string]string{}
+	}
+	we.streamedArtifacts[art.Name] = localArtPath
+	art.StreamSource = &wfv1.ArtifactStreamSource{
+		URL: "http://" + net.JoinHostPort(podIP, strconv.Itoa(common.ArtifactStreamPort)) + streamedArtifactsPath + url.PathEscape(art.Name),
+	}
+	return nil
+}
+
+// ServeStreamedArtifacts serves the streamed output artifacts until the stream timeout, or until the context is done
+func (we *WorkflowExecutor) ServeStreamedArtifacts(ctx context.Context) {
+	if len(we.streamedArtifacts) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, streamTimeout)
+	defer cancel()
+	server := &http.Server{
+		Addr:              ":" + strconv.Itoa(common.ArtifactStreamPort),
+		Handler:           we.streamHandler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	shutdownErr := make(chan error, 1)
+	go func() {
+		<-ctx.Done()
+		// let the artifacts that are being streamed finish
+		shutdownErr <- server.Shutdown(context.Background())
+	}()
+	log.WithField("timeout", streamTimeout).Infof("Serving %d streamed artifacts", len(we.streamedArtifacts))
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		log.WithError(err).Warn("Failed to serve streamed artifacts")
+		return
+	}
+	if err := <-shutdownErr; err != nil {
+		log.WithError(err).Warn("Failed to stop serving streamed artifacts")
+	}
+	log.Info("Stopped serving streamed artifacts")
+}
+
+func (we *WorkflowExecutor) streamHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(we.streamToken)) != 1 {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		name := strings.TrimPrefix(r.URL.Path, streamedArtifactsPath)
+		localArtPath, ok := we.streamedArtifacts[name]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		f, err := os.Open(localArtPath)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		fi, err := f.Stat()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		log.WithFields(log.Fields{"artifact": name, "remoteAddr": r.RemoteAddr}).Info("Streaming artifact")
+		http.ServeContent(w, r, fi.Name(), fi.ModTime(), f)
+	})
+}
+
+// loadStreamedArtifact loads an artifact from the pod that streams it, authenticating with the workflow's token
+func loadStreamedArtifact(ctx context.Context, src *wfv1.ArtifactStreamSource, path string) error {
+	token := os.Getenv(common.EnvVarArtifactStreamToken)
+	if token == "" {
+		return fmt.Errorf("%s is not set", common.EnvVarArtifactStreamToken)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, src.URL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := streamClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to stream artifact from %s: %s", src.URL, resp.Status)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, resp.Body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return fmt.Errorf("failed to stream artifact from %s: %w", src.URL, err)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package executor
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestStreamArtifact(t *testing.T) {
+	t.Setenv(common.EnvVarPodIP, "10.0.0.1")
+	t.Setenv(common.EnvVarArtifactStreamToken, "my-token")
+	dir := t.TempDir()
+	localArtPath := filepath.Join(dir, "my-art.tgz")
+	require.NoError(t, os.WriteFile(localArtPath, []byte("my-data"), 0o600))
+	we := WorkflowExecutor{}
+	art := &wfv1.Artifact{Name: "my-art"}
+
+	require.NoError(t, we.streamArtifact(art, localArtPath))
+	require.NotNil(t, art.StreamSource)
+	assert.Equal(t, "http://10.0.0.1:2747/artifacts/my-art", art.StreamSource.URL)
+
+	server := httptest.NewServer(we.streamHandler())
+	defer server.Close()
+	ctx := context.Background()
+
+	t.Run("Load", func(t *testing.T) {
+		path := filepath.Join(dir, "loaded")
+		require.NoError(t, loadStreamedArtifact(ctx, &wfv1.ArtifactStreamSource{URL: server.URL + "/artifacts/my-art"}, path))
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "my-data", string(data))
+	})
+	t.Run("WrongToken", func(t *testing.T) {
+		t.Setenv(common.EnvVarArtifactStreamToken, "wrong")
+		path := filepath.Join(dir, "unauthorized")
+		err := loadStreamedArtifact(ctx, &wfv1.ArtifactStreamSource{URL: server.URL + "/artifacts/my-art"}, path)
+		require.ErrorContains(t, err, "401 Unauthorized")
+		assert.NoFileExists(t, path)
+	})
+	t.Run("NoToken", func(t *testing.T) {
+		t.Setenv(common.EnvVarArtifactStreamToken, "")
+		err := loadStreamedArtifact(ctx, &wfv1.ArtifactStreamSource{URL: server.URL + "/artifacts/my-art"}, filepath.Join(dir, "no-token"))
+		require.ErrorContains(t, err, common.EnvVarArtifactStreamToken+" is not set")
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		err := loadStreamedArtifact(ctx, &wfv1.ArtifactStreamSource{URL: server.URL + "/artifacts/other-art"}, filepath.Join(dir, "not-found"))
+		require.ErrorContains(t, err, "404 Not Found")
+	})
+	t.Run("Unreachable", func(t *testing.T) {
+		err := loadStreamedArtifact(ctx, &wfv1.ArtifactStreamSource{URL: "http://127.0.0.1:1/artifacts/my-art"}, filepath.Join(dir, "unreachable"))
+		require.Error(t, err)
+	})
+}
+
+func TestStreamArtifactWithoutPodIP(t *testing.T) {
+	t.Setenv(common.EnvVarPodIP, "")
+	we := WorkflowExecutor{}
+	art := &wfv1.Artifact{Name: "my-art"}
+	require.Error(t, we.streamArtifact(art, "my-art.tgz"))
+	assert.Nil(t, art.StreamSource)
+}
+
+func TestStreamArtifactWithoutToken(t *testing.T) {
+	t.Setenv(common.EnvVarPodIP, "10.0.0.1")
+	t.Setenv(common.EnvVarArtifactStreamToken, "")
+	we := WorkflowExecutor{}
+	art := &wfv1.Artifact{Name: "my-art"}
+	require.Error(t, we.streamArtifact(art, "my-art.tgz"))
+	assert.Nil(t, art.StreamSource)
+}
@@ -0,0 +1,29 @@
+package executor
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// traceArtifact runs f within a span for loading or saving the artifact. Spans are only exported if the executor
+// has set up tracing, and are children of the node's span if the controller propagated it to the pod.
+func traceArtifact(ctx context.Context, spanName string, art *wfv1.Artifact, f func(ctx context.Context) error) error {
+	attributes := []attribute.KeyValue{attribute.String("argo.artifact.name", art.Name)}
+	if key, err := art.GetKey(); err == nil {
+		attributes = append(attributes, attribute.String("argo.artifact.key", key))
+	}
+	ctx, span := otel.Tracer("argoexec").Start(ctx, spanName)
+	defer span.End()
+	span.SetAttributes(attributes...)
+	err := f(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,17 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-workflows/v3/util/telemetry"
+)
+
+func addArtifactRetentionCounter(_ context.Context, m *Metrics) error {
+	return m.CreateBuiltinInstrument(telemetry.InstrumentArtifactRetentionReclaimedBytes)
+}
+
+func (m *Metrics) ArtifactRetentionReclaimed(ctx context.Context, namespace string, bytes int64) {
+	m.AddInt(ctx, telemetry.InstrumentArtifactRetentionReclaimedBytes.Name(), bytes, telemetry.InstAttribs{
+		{Name: telemetry.AttribWorkflowNamespace, Value: namespace},
+	})
+}
@@ -53,6 +53,7 @@ func New(ctx context.Context, serviceName, prometheusName string, config *teleme
 		addK8sRequests,
 		addWorkflowConditionGauge,
 		addWorkQueueMetrics,
+		addArtifactRetentionCounter,
 	)
 	if err != nil {
 		return nil, err
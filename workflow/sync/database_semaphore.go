@@ -0,0 +1,282 @@
+package sync
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+)
+
+// dbInfo is the shared state of all database locks held by this controller
+type dbInfo struct {
+	repo                      sqldb.SyncRepo
+	controllerName            string
+	inactiveControllerTimeout time.Duration
+}
+
+// since returns the time from which controllers are considered alive
+func (d *dbInfo) since() time.Time {
+	return time.Now().Add(-d.inactiveControllerTimeout)
+}
+
+// databaseSemaphore is a semaphore whose holders and queue are stored in a database,
+// so that it can be shared between controllers
+type databaseSemaphore struct {
+	name         string
+	limit        int
+	nextWorkflow NextWorkflow
+	info         *dbInfo
+	log          *log.Entry
+}
+
+var _ semaphore = &databaseSemaphore{}
+
+func newDatabaseSemaphore(name string, limit int, nextWorkflow NextWorkflow, info *dbInfo, lockType string) *databaseSemaphore {
+	return &databaseSemaphore{
+		name:         name,
+		limit:        limit,
+		nextWorkflow: nextWorkflow,
+		info:         info,
+		log: log.WithFields(log.Fields{
+			lockType:     name,
+			"controller": info.controllerName,
+		}),
+	}
+}
+
+func newDatabaseMutex(name string, nextWorkflow NextWorkflow, info *dbInfo) *databaseSemaphore {
+	return newDatabaseSemaphore(name, 1, nextWorkflow, info, "mutex")
+}
+
+func (s *databaseSemaphore) getName() string {
+	return s.name
+}
+
+func (s *databaseSemaphore) getLimit() int {
+	return s.limit
+}
+
+// states returns the holders of the lock, and the workflows waiting for it in priority order,
+// across all live controllers
+func (s *databaseSemaphore) states() ([]sqldb.SyncStateRecord, []sqldb.SyncStateRecord, error) {
+	records, err := s.info.repo.ListStates(s.name, s.info.since())
+	if err != nil {
+		return nil, nil, err
+	}
+	var holders, pending []sqldb.SyncStateRecord
+	for _, record := range records {
+		if record.Held {
+			holders = append(holders, record)
+		} else {
+			pending = append(pending, record)
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		if pending[i].Priority != pending[j].Priority {
+			return pending[i].Priority > pending[j].Priority
+		}
+		return pending[i].CreationTime.Before(pending[j].CreationTime)
+	})
+	return holders, pending, nil
+}
+
+func (s *databaseSemaphore) isLocal(record sqldb.SyncStateRecord) bool {
+	return record.Controller == s.info.controllerName
+}
+
+// getCurrentHolders returns the holders belonging to this controller
+func (s *databaseSemaphore) getCurrentHolders() []string {
+	holders, _, err := s.states()
+	if err != nil {
+		s.log.WithError(err).Error("Failed to get current lock holders")
+		return nil
+	}
+	var keys []string
+	for _, holder := range holders {
+		if s.isLocal(holder) {
+			keys = append(keys, holder.Key)
+		}
+	}
+	return keys
+}
+
+// getCurrentPending returns the workflows of this controller waiting for the lock
+func (s *databaseSemaphore) getCurrentPending() []string {
+	_, pending, err := s.states()
+	if err != nil {
+		s.log.WithError(err).Error("Failed to get pending lock holders")
+		return nil
+	}
+	var keys []string
+	for _, item := range pending {
+		if s.isLocal(item) {
+			keys = append(keys, item.Key)
+		}
+	}
+	return keys
+}
+
+// resize updates the limit, which is read from the database by the manager
+func (s *databaseSemaphore) resize(n int) bool {
+	s.log.Infof("%s semaphore resized from %d to %d", s.name, s.limit, n)
+	s.limit = n
+	return true
+}
+
+func (s *databaseSemaphore) release(key string) bool {
+	if err := s.info.repo.Release(s.name, key, s.info.controllerName); err != nil {
+		s.log.WithError(err).Errorf("Failed to release lock held by %s", key)
+		return false
+	}
+	s.log.Infof("Lock has been released by %s", key)
+	s.notifyWaiters()
+	return true
+}
+
+// notifyWaiters enqueues this controller's workflows that are within the first N waiting for the lock,
+// where N is the availability of the lock. Other controllers are notified by their own polling.
+func (s *databaseSemaphore) notifyWaiters() {
+	holders, pending, err := s.states()
+	if err != nil {
+		s.log.WithError(err).Error("Failed to notify waiting workflows")
+		return
+	}
+	triggerCount := s.limit - len(holders)
+	if len(pending) < triggerCount {
+		triggerCount = len(pending)
+	}
+	for idx := 0; idx < triggerCount; idx++ {
+		if !s.isLocal(pending[idx]) {
+			continue
+		}
+		wfKey := workflowKey(&item{key: pending[idx].Key})
+		s.log.Debugf("Enqueue the workflow %s", wfKey)
+		s.nextWorkflow(wfKey)
+	}
+}
+
+func (s *databaseSemaphore) addToQueue(holderKey string, priority int32, creationTime time.Time) {
+	err := s.info.repo.AddPending(sqldb.SyncStateRecord{
+		Name:         s.name,
+		Key:          holderKey,
+		Controller:   s.info.controllerName,
+		Priority:     priority,
+		CreationTime: creationTime,
+	})
+	if err != nil {
+		s.log.WithError(err).Errorf("Failed to add %s into queue", holderKey)
+		return
+	}
+	s.log.Debugf("Added into queue: %s", holderKey)
+}
+
+func (s *databaseSemaphore) removeFromQueue(holderKey string) {
+	if err := s.info.repo.RemovePending(s.name, holderKey, s.info.controllerName); err != nil {
+		s.log.WithError(err).Errorf("Failed to remove %s from queue", holderKey)
+		return
+	}
+	s.log.Debugf("Removed from queue: %s", holderKey)
+}
+
+func (s *databaseSemaphore) acquire(holderKey string) bool {
+	acquired, err := s.info.repo.Acquire(s.name, holderKey, s.info.controllerName, s.limit, s.info.since())
+	if err != nil {
+		s.log.WithError(err).Errorf("Failed to acquire lock for %s", holderKey)
+		return false
+	}
+	return acquired
+}
+
+// checkAcquire examines if tryAcquire would succeed, see prioritySemaphore.checkAcquire
+func (s *databaseSemaphore) checkAcquire(holderKey string) (bool, bool, string) {
+	if holderKey == "" {
+		return false, false, "bug: attempt to check semaphore with empty holder key"
+	}
+
+	holders, pending, err := s.states()
+	if err != nil {
+		return false, false, fmt.Sprintf("Failed to check %s lock: %v", s.name, err)
+	}
+	for _, holder := range holders {
+		if s.isLocal(holder) && holder.Key == holderKey {
+			s.log.Debugf("%s is already holding a lock", holderKey)
+			return false, true, ""
+		}
+	}
+
+	waitingMsg := fmt.Sprintf("Waiting for %s lock. Lock status: %d/%d", s.name, s.limit-len(holders), s.limit)
+
+	// The front of the queue may belong to another controller, in which case we must wait for it
+	if len(pending) > 0 {
+		front := pending[0]
+		if !s.isLocal(front) || !isSameWorkflowNodeKeys(holderKey, front.Key) {
+			// Enqueue the front workflow if lock is available
+			if s.isLocal(front) && len(holders) < s.limit {
+				s.nextWorkflow(workflowKey(&item{key: front.Key}))
+			}
+			s.log.Infof("%s isn't at the front", holderKey)
+			return false, false, waitingMsg
+		}
+	}
+	if len(holders) < s.limit {
+		return true, false, ""
+	}
+
+	s.log.Debugf("Current semaphore Holders. %v", holders)
+	return false, false, waitingMsg
+}
+
+func (s *databaseSemaphore) tryAcquire(holderKey string) (bool, string) {
+	acq, already, msg := s.checkAcquire(holderKey)
+	if already {
+		return true, msg
+	}
+	if !acq {
+		return false, msg
+	}
+	if s.acquire(holderKey) {
+		s.log.Infof("%s acquired by %s", s.name, holderKey)
+		s.notifyWaiters()
+		return true, ""
+	}
+	return false, msg
+}
+
+// limitCache caches the limits of database semaphores, to avoid querying the database on every reconciliation
+type limitCache struct {
+	lock     sync.Mutex
+	ttl      time.Duration
+	getLimit func(name string) (int, error)
+	entries  map[string]limitCacheEntry
+}
+
+type limitCacheEntry struct {
+	limit     int
+	timestamp time.Time
+}
+
+func newLimitCache(ttl time.Duration, getLimit func(name string) (int, error)) *limitCache {
+	return &limitCache{
+		ttl:      ttl,
+		getLimit: getLimit,
+		entries:  make(map[string]limitCacheEntry),
+	}
+}
+
+func (c *limitCache) get(name string) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if entry, ok := c.entries[name]; ok && time.Since(entry.timestamp) < c.ttl {
+		return entry.limit, nil
+	}
+	limit, err := c.getLimit(name)
+	if err != nil {
+		return 0, err
+	}
+	c.entries[name] = limitCacheEntry{limit: limit, timestamp: time.Now()}
+	return limit, nil
+}
@@ -0,0 +1,266 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	gosync "sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// fakeSyncRepo is an in-memory sqldb.SyncRepo shared by several controllers
+type fakeSyncRepo struct {
+	lock       gosync.Mutex
+	limits     map[string]int
+	heartbeats map[string]time.Time
+	states     []sqldb.SyncStateRecord
+}
+
+var _ sqldb.SyncRepo = &fakeSyncRepo{}
+
+func newFakeSyncRepo(limits map[string]int) *fakeSyncRepo {
+	return &fakeSyncRepo{limits: limits, heartbeats: map[string]time.Time{}}
+}
+
+func (r *fakeSyncRepo) GetLimit(name string) (int, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	limit, ok := r.limits[name]
+	if !ok {
+		return 0, fmt.Errorf("semaphore %s not found", name)
+	}
+	return limit, nil
+}
+
+func (r *fakeSyncRepo) Heartbeat(controller string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.heartbeats[controller] = time.Now()
+	return nil
+}
+
+func (r *fakeSyncRepo) ListStates(name string, since time.Time) ([]sqldb.SyncStateRecord, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.listStates(name, since), nil
+}
+
+func (r *fakeSyncRepo) listStates(name string, since time.Time) []sqldb.SyncStateRecord {
+	var records []sqldb.SyncStateRecord
+	for _, record := range r.states {
+		if record.Name == name && r.heartbeats[record.Controller].After(since) {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func (r *fakeSyncRepo) find(name, key, controller string) int {
+	for i, record := range r.states {
+		if record.Name == name && record.Key == key && record.Controller == controller {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *fakeSyncRepo) AddPending(record sqldb.SyncStateRecord) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.find(record.Name, record.Key, record.Controller) < 0 {
+		record.Held = false
+		r.states = append(r.states, record)
+	}
+	return nil
+}
+
+func (r *fakeSyncRepo) deleteState(name, key, controller string, held bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if i := r.find(name, key, controller); i >= 0 && r.states[i].Held == held {
+		r.states = append(r.states[:i], r.states[i+1:]...)
+	}
+}
+
+func (r *fakeSyncRepo) RemovePending(name, key, controller string) error {
+	r.deleteState(name, key, controller, false)
+	return nil
+}
+
+func (r *fakeSyncRepo) Release(name, key, controller string) error {
+	r.deleteState(name, key, controller, true)
+	return nil
+}
+
+func (r *fakeSyncRepo) Acquire(name, key, controller string, limit int, since time.Time) (bool, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	holders := 0
+	for _, record := range r.listStates(name, since) {
+		if record.Held {
+			if record.Key == key && record.Controller == controller {
+				return true, nil
+			}
+			holders++
+		}
+	}
+	if holders >= limit {
+		return false, nil
+	}
+	if i := r.find(name, key, controller); i >= 0 {
+		r.states[i].Held = true
+	} else {
+		r.states = append(r.states, sqldb.SyncStateRecord{Name: name, Key: key, Controller: controller, Held: true, CreationTime: time.Now()})
+	}
+	return true, nil
+}
+
+func (r *fakeSyncRepo) DeleteExpired(since time.Time) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	var states []sqldb.SyncStateRecord
+	for _, record := range r.states {
+		if r.heartbeats[record.Controller].After(since) {
+			states = append(states, record)
+		}
+	}
+	r.states = states
+	return nil
+}
+
+var databaseMutexWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: one
+  namespace: default
+spec:
+  entrypoint: whalesay
+  synchronization:
+    mutex:
+      name: test
+      database: true
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+`
+
+var databaseSemaphoreWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: one
+  namespace: default
+spec:
+  entrypoint: whalesay
+  synchronization:
+    semaphore:
+      database:
+        key: my-sem
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+`
+
+func newDatabaseLockManager(t *testing.T, ctx context.Context, repo sqldb.SyncRepo, controllerName string, nextWorkflow NextWorkflow) *Manager {
+	syncManager := NewLockManager(GetSyncLimitFunc(fake.NewSimpleClientset()), nextWorkflow, WorkflowExistenceFunc)
+	err := syncManager.EnableDatabase(ctx, repo, &config.SyncConfig{
+		ControllerName: controllerName,
+		PollInterval:   &metav1.Duration{Duration: time.Hour},
+	})
+	require.NoError(t, err)
+	return syncManager
+}
+
+func TestDatabaseMutex(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	repo := newFakeSyncRepo(nil)
+	var nextWorkflow string
+	syncManager1 := newDatabaseLockManager(t, ctx, repo, "controller-1", func(key string) {})
+	syncManager2 := newDatabaseLockManager(t, ctx, repo, "controller-2", func(key string) { nextWorkflow = key })
+
+	wf1 := wfv1.MustUnmarshalWorkflow(databaseMutexWf)
+	wf2 := wf1.DeepCopy()
+	wf2.Name = "two"
+
+	status, wfUpdate, msg, failedLockName, err := syncManager1.TryAcquire(ctx, wf1, "", wf1.Spec.Synchronization)
+	require.NoError(t, err)
+	assert.Empty(t, msg)
+	assert.Empty(t, failedLockName)
+	assert.True(t, status)
+	assert.True(t, wfUpdate)
+
+	// the mutex is held by the other controller
+	status, _, msg, failedLockName, err = syncManager2.TryAcquire(ctx, wf2, "", wf2.Spec.Synchronization)
+	require.NoError(t, err)
+	assert.NotEmpty(t, msg)
+	assert.Equal(t, "default/DatabaseMutex/test", failedLockName)
+	assert.False(t, status)
+
+	syncManager1.Release(ctx, wf1, "", wf1.Spec.Synchronization)
+	syncManager2.notifyDatabaseWaiters(ctx)
+	assert.Equal(t, "default/two", nextWorkflow)
+
+	status, _, msg, _, err = syncManager2.TryAcquire(ctx, wf2, "", wf2.Spec.Synchronization)
+	require.NoError(t, err)
+	assert.Empty(t, msg)
+	assert.True(t, status)
+}
+
+func TestDatabaseSemaphore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	repo := newFakeSyncRepo(map[string]int{"default/my-sem": 2})
+	syncManager1 := newDatabaseLockManager(t, ctx, repo, "controller-1", func(key string) {})
+	syncManager2 := newDatabaseLockManager(t, ctx, repo, "controller-2", func(key string) {})
+
+	wf := wfv1.MustUnmarshalWorkflow(databaseSemaphoreWf)
+	wf2 := wf.DeepCopy()
+	wf2.Name = "two"
+	wf3 := wf.DeepCopy()
+	wf3.Name = "three"
+
+	status, _, _, _, err := syncManager1.TryAcquire(ctx, wf, "", wf.Spec.Synchronization)
+	require.NoError(t, err)
+	assert.True(t, status)
+	status, _, _, _, err = syncManager2.TryAcquire(ctx, wf2, "", wf2.Spec.Synchronization)
+	require.NoError(t, err)
+	assert.True(t, status)
+	status, _, _, failedLockName, err := syncManager1.TryAcquire(ctx, wf3, "", wf3.Spec.Synchronization)
+	require.NoError(t, err)
+	assert.False(t, status)
+	assert.Equal(t, "default/Database/my-sem", failedLockName)
+
+	t.Run("ExpiredController", func(t *testing.T) {
+		repo.heartbeats["controller-2"] = time.Now().Add(-time.Hour)
+		require.NoError(t, repo.DeleteExpired(time.Now().Add(-time.Minute)))
+		status, _, _, _, err := syncManager1.TryAcquire(ctx, wf3, "", wf3.Spec.Synchronization)
+		require.NoError(t, err)
+		assert.True(t, status)
+	})
+}
+
+func TestDatabaseNotEnabled(t *testing.T) {
+	syncManager := NewLockManager(GetSyncLimitFunc(fake.NewSimpleClientset()), func(key string) {}, WorkflowExistenceFunc)
+	wf := wfv1.MustUnmarshalWorkflow(databaseMutexWf)
+	_, _, _, _, err := syncManager.TryAcquire(context.Background(), wf, "", wf.Spec.Synchronization)
+	assert.ErrorIs(t, err, errDatabaseNotEnabled)
+}
+
+func TestEnableDatabaseRequiresControllerName(t *testing.T) {
+	syncManager := NewLockManager(GetSyncLimitFunc(fake.NewSimpleClientset()), func(key string) {}, WorkflowExistenceFunc)
+	err := syncManager.EnableDatabase(context.Background(), newFakeSyncRepo(nil), &config.SyncConfig{})
+	assert.Error(t, err)
+}
@@ -11,8 +11,10 @@ import (
 type lockKind string
 
 const (
-	lockKindConfigMap lockKind = "ConfigMap"
-	lockKindMutex     lockKind = "Mutex"
+	lockKindConfigMap     lockKind = "ConfigMap"
+	lockKindMutex         lockKind = "Mutex"
+	lockKindDatabase      lockKind = "Database"
+	lockKindDatabaseMutex lockKind = "DatabaseMutex"
 )
 
 type lockName struct {
@@ -32,14 +34,19 @@ func newLockName(namespace, resourceName, lockKey string, kind lockKind) *lockNa
 }
 
 func getSemaphoreLockName(sem *v1alpha1.SemaphoreRef, wfNamespace string) (*lockName, error) {
-	if sem.ConfigMapKeyRef != nil {
-		namespace := sem.Namespace
-		if namespace == "" {
-			namespace = wfNamespace
-		}
+	namespace := sem.Namespace
+	if namespace == "" {
+		namespace = wfNamespace
+	}
+	switch {
+	case sem.ConfigMapKeyRef != nil && sem.Database != nil:
+		return nil, fmt.Errorf("cannot get LockName for a Semaphore with both a ConfigMapRef and a Database")
+	case sem.ConfigMapKeyRef != nil:
 		return newLockName(namespace, sem.ConfigMapKeyRef.Name, sem.ConfigMapKeyRef.Key, lockKindConfigMap), nil
+	case sem.Database != nil:
+		return newLockName(namespace, sem.Database.Key, "", lockKindDatabase), nil
 	}
-	return nil, fmt.Errorf("cannot get LockName for a Semaphore without a ConfigMapRef")
+	return nil, fmt.Errorf("cannot get LockName for a Semaphore without a ConfigMapRef or Database")
 }
 
 func getMutexLockName(mtx *v1alpha1.Mutex, wfNamespace string) *lockName {
@@ -47,6 +54,9 @@ func getMutexLockName(mtx *v1alpha1.Mutex, wfNamespace string) *lockName {
 	if namespace == "" {
 		namespace = wfNamespace
 	}
+	if mtx.Database {
+		return newLockName(namespace, mtx.Name, "", lockKindDatabaseMutex)
+	}
 	return newLockName(namespace, mtx.Name, "", lockKindMutex)
 }
 
@@ -72,7 +82,7 @@ func DecodeLockName(name string) (*lockName, error) {
 	namespace := items[0]
 
 	switch lockKind {
-	case lockKindMutex:
+	case lockKindMutex, lockKindDatabase, lockKindDatabaseMutex:
 		lock = lockName{Namespace: namespace, Kind: lockKind, ResourceName: items[2]}
 	case lockKindConfigMap:
 		components := strings.Split(items[2], "/")
@@ -94,12 +104,29 @@ func DecodeLockName(name string) (*lockName, error) {
 }
 
 func (ln *lockName) encodeName() string {
-	if ln.Kind == lockKindMutex {
+	if ln.Kind != lockKindConfigMap {
 		return ln.validateEncoding(fmt.Sprintf("%s/%s/%s", ln.Namespace, ln.Kind, ln.ResourceName))
 	}
 	return ln.validateEncoding(fmt.Sprintf("%s/%s/%s/%s", ln.Namespace, ln.Kind, ln.ResourceName, ln.Key))
 }
 
+// isDatabase returns whether the lock is held in the synchronization database
+func (ln *lockName) isDatabase() bool {
+	return ln.Kind == lockKindDatabase || ln.Kind == lockKindDatabaseMutex
+}
+
+// isDatabaseLock returns whether the encoded lock name is of a lock held in the synchronization database.
+// Names that cannot be decoded, such as those recorded by older versions, are not.
+func isDatabaseLock(name string) bool {
+	lock, err := DecodeLockName(name)
+	return err == nil && lock.isDatabase()
+}
+
+// databaseKey returns the name of the semaphore in the database limit table
+func (ln *lockName) databaseKey() string {
+	return fmt.Sprintf("%s/%s", ln.Namespace, ln.ResourceName)
+}
+
 func (ln *lockName) validate() error {
 	if ln.Namespace == "" {
 		return errors.New(errors.CodeBadRequest, "Invalid lock key: Namespace is missing")
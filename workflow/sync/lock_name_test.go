@@ -64,6 +64,32 @@ func TestDecodeLockName(t *testing.T) {
 				return err == nil // this should error
 			},
 		},
+		{
+			"TestDatabaseLockNamesWork",
+			args{"default/Database/foo"},
+			&lockName{
+				Namespace:    "default",
+				ResourceName: "foo",
+				Key:          "",
+				Kind:         lockKindDatabase,
+			},
+			func(t assert.TestingT, err error, i ...interface{}) bool {
+				return true
+			},
+		},
+		{
+			"TestDatabaseMutexLockNamesWork",
+			args{"default/DatabaseMutex/foo"},
+			&lockName{
+				Namespace:    "default",
+				ResourceName: "foo",
+				Key:          "",
+				Kind:         lockKindDatabaseMutex,
+			},
+			func(t assert.TestingT, err error, i ...interface{}) bool {
+				return true
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
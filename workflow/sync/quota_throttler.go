@@ -0,0 +1,187 @@
+package sync
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// DemandFunc returns the resources an item needs, named as resource quotas name them (e.g. "requests.cpu").
+type DemandFunc func(Key) apiv1.ResourceList
+
+// HeadroomFunc returns the resources still available in a namespace's resource quotas, named as resource quotas name
+// them. Resources that are not limited by any quota are absent.
+type HeadroomFunc func(namespace string) (apiv1.ResourceList, error)
+
+type QueueAfterFunc func(Key, time.Duration)
+
+// quotaReservationPeriod is how long the demand of an admitted item is reserved for. Resource quotas only account for
+// pods once they have been created, so without this, items admitted in quick succession could together exceed the
+// quota before any of their pods exist.
+const quotaReservationPeriod = 30 * time.Second
+
+type reservation struct {
+	resources apiv1.ResourceList
+	until     time.Time
+}
+
+type quotaThrottler struct {
+	demandFunc   DemandFunc
+	headroomFunc HeadroomFunc
+	queue        QueueFunc
+	queueAfter   QueueAfterFunc
+	retryPeriod  time.Duration
+	inProgress   buckets
+	pending      map[BucketKey]*priorityQueue
+	demands      map[Key]apiv1.ResourceList
+	reservations map[Key]reservation
+	lock         *sync.Mutex
+	now          func() time.Time
+}
+
+// NewQuotaThrottler returns a throttler that only runs the items of a namespace, in priority order, once their demand
+// fits within the headroom of the namespace's resource quotas. When an item may need processing, `queue` is invoked.
+// While the highest priority item of a namespace does not fit, it is queued again after `retryPeriod` using
+// `queueAfter`, as headroom is freed by pods completing, which the throttler is not told about.
+// To prevent an item that can never fit from blocking its namespace forever, it is run anyway if nothing else in the
+// namespace is.
+func NewQuotaThrottler(demandFunc DemandFunc, headroomFunc HeadroomFunc, queue QueueFunc, queueAfter QueueAfterFunc, retryPeriod time.Duration) Throttler {
+	return &quotaThrottler{
+		demandFunc:   demandFunc,
+		headroomFunc: headroomFunc,
+		queue:        queue,
+		queueAfter:   queueAfter,
+		retryPeriod:  retryPeriod,
+		inProgress:   make(buckets),
+		pending:      make(map[BucketKey]*priorityQueue),
+		demands:      make(map[Key]apiv1.ResourceList),
+		reservations: make(map[Key]reservation),
+		lock:         &sync.Mutex{},
+		now:          time.Now,
+	}
+}
+
+func (t *quotaThrottler) Init(wfs []wfv1.Workflow) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, wf := range wfs {
+		key, err := cache.MetaNamespaceKeyFunc(&wf)
+		if err != nil {
+			return err
+		}
+		if wf.Status.Phase == wfv1.WorkflowRunning {
+			bucketKey := NamespaceBucket(key)
+			if _, ok := t.inProgress[bucketKey]; !ok {
+				t.inProgress[bucketKey] = make(bucket)
+			}
+			t.inProgress[bucketKey][key] = true
+		}
+	}
+	return nil
+}
+
+func (t *quotaThrottler) Add(key Key, priority int32, creationTime time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	bucketKey := NamespaceBucket(key)
+	if x, ok := t.inProgress[bucketKey]; ok && x[key] {
+		return
+	}
+	if _, ok := t.pending[bucketKey]; !ok {
+		t.pending[bucketKey] = &priorityQueue{itemByKey: make(map[string]*item)}
+	}
+	t.pending[bucketKey].add(key, priority, creationTime)
+	t.demands[key] = t.demandFunc(key)
+	t.queueThrottled(bucketKey)
+}
+
+func (t *quotaThrottler) Admit(key Key) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	bucketKey := NamespaceBucket(key)
+	if x, ok := t.inProgress[bucketKey]; ok && x[key] {
+		return true
+	}
+	t.queueThrottled(bucketKey)
+	return false
+}
+
+func (t *quotaThrottler) Remove(key Key) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	bucketKey := NamespaceBucket(key)
+	if x, ok := t.inProgress[bucketKey]; ok {
+		delete(x, key)
+	}
+	if x, ok := t.pending[bucketKey]; ok {
+		x.remove(key)
+	}
+	delete(t.demands, key)
+	delete(t.reservations, key)
+	t.queueThrottled(bucketKey)
+}
+
+func (t *quotaThrottler) queueThrottled(bucketKey BucketKey) {
+	if _, ok := t.inProgress[bucketKey]; !ok {
+		t.inProgress[bucketKey] = make(bucket)
+	}
+	inProgress := t.inProgress[bucketKey]
+	pending, ok := t.pending[bucketKey]
+	if !ok || pending.Len() == 0 {
+		return
+	}
+	headroom, err := t.headroomFunc(bucketKey)
+	if err != nil {
+		log.WithError(err).WithField("namespace", bucketKey).Warn("Failed to get resource quota headroom")
+		t.queueAfter(pending.peek().key, t.retryPeriod)
+		return
+	}
+	now := t.now()
+	for key, r := range t.reservations {
+		if now.After(r.until) {
+			delete(t.reservations, key)
+		} else if NamespaceBucket(key) == bucketKey {
+			subtractResources(headroom, r.resources)
+		}
+	}
+	for pending.Len() > 0 {
+		key := pending.peek().key
+		demand := t.demands[key]
+		if len(inProgress) > 0 && !fitsResources(demand, headroom) {
+			t.queueAfter(key, t.retryPeriod)
+			return
+		}
+		pending.pop()
+		inProgress[key] = true
+		t.reservations[key] = reservation{resources: demand, until: now.Add(quotaReservationPeriod)}
+		subtractResources(headroom, demand)
+		t.queue(key)
+	}
+}
+
+// fitsResources returns whether the demand fits within the headroom of each resource the headroom limits
+func fitsResources(demand, headroom apiv1.ResourceList) bool {
+	for name, available := range headroom {
+		if needed, ok := demand[name]; ok && needed.Cmp(available) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// subtractResources subtracts the demand from the headroom of each resource the headroom limits
+func subtractResources(headroom, demand apiv1.ResourceList) {
+	for name, available := range headroom {
+		if needed, ok := demand[name]; ok {
+			available.Sub(needed)
+			headroom[name] = available
+		}
+	}
+}
+
+var _ Throttler = &quotaThrottler{}
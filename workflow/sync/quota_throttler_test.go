@@ -0,0 +1,112 @@
+package sync
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func newTestQuotaThrottler(demands map[Key]string, headroom map[string]string, queued, retried *[]Key) *quotaThrottler {
+	return NewQuotaThrottler(
+		func(key Key) apiv1.ResourceList {
+			return apiv1.ResourceList{apiv1.ResourceRequestsCPU: resource.MustParse(demands[key])}
+		},
+		func(namespace string) (apiv1.ResourceList, error) {
+			cpu, ok := headroom[namespace]
+			if !ok {
+				return nil, errors.New("no quota")
+			}
+			return apiv1.ResourceList{apiv1.ResourceRequestsCPU: resource.MustParse(cpu)}, nil
+		},
+		func(key Key) { *queued = append(*queued, key) },
+		func(key Key, _ time.Duration) { *retried = append(*retried, key) },
+		time.Second,
+	).(*quotaThrottler)
+}
+
+func TestQuotaThrottler(t *testing.T) {
+	var queued, retried []Key
+	headroom := map[string]string{"a": "3", "b": "1"}
+	throttler := newTestQuotaThrottler(map[Key]string{"a/0": "2", "a/1": "2", "a/2": "2", "b/0": "1"}, headroom, &queued, &retried)
+
+	throttler.Add("a/0", 0, time.Now())
+	throttler.Add("a/1", 0, time.Now().Add(time.Hour))
+	throttler.Add("a/2", 0, time.Now().Add(2*time.Hour))
+	throttler.Add("b/0", 0, time.Now())
+
+	assert.True(t, throttler.Admit("a/0"))
+	assert.False(t, throttler.Admit("a/1"), "does not fit the headroom left once a/0 is reserved")
+	assert.False(t, throttler.Admit("a/2"), "fits, but waits for a/1, which has higher priority")
+	assert.True(t, throttler.Admit("b/0"), "other namespaces are not affected")
+	assert.Equal(t, []Key{"a/0", "b/0"}, queued)
+	assert.Contains(t, retried, "a/1")
+	assert.NotContains(t, retried, "a/2")
+
+	// a/0's pods now account for its demand in the headroom
+	throttler.now = func() time.Time { return time.Now().Add(quotaReservationPeriod + time.Second) }
+	headroom["a"] = "1"
+	assert.False(t, throttler.Admit("a/1"))
+
+	queued = nil
+	headroom["a"] = "3"
+	assert.False(t, throttler.Admit("a/1"), "is admitted, and queued to be processed")
+	assert.Equal(t, []Key{"a/1"}, queued)
+	assert.True(t, throttler.Admit("a/1"))
+	assert.False(t, throttler.Admit("a/2"), "does not fit once a/1 is reserved")
+
+	queued = nil
+	throttler.Remove("a/1")
+	assert.True(t, throttler.Admit("a/2"))
+	assert.Equal(t, []Key{"a/2"}, queued)
+}
+
+func TestQuotaThrottlerPriority(t *testing.T) {
+	var queued, retried []Key
+	throttler := newTestQuotaThrottler(map[Key]string{"a/0": "1", "a/1": "1", "a/2": "1"}, map[string]string{"a": "2"}, &queued, &retried)
+
+	throttler.Add("a/0", 1, time.Now())
+	throttler.Add("a/1", 3, time.Now())
+	throttler.Add("a/2", 2, time.Now())
+
+	assert.True(t, throttler.Admit("a/0"), "is started, even though low priority")
+	assert.True(t, throttler.Admit("a/1"))
+	assert.False(t, throttler.Admit("a/2"))
+	assert.Equal(t, []Key{"a/0", "a/1"}, queued)
+}
+
+func TestQuotaThrottlerNeverFits(t *testing.T) {
+	var queued, retried []Key
+	throttler := newTestQuotaThrottler(map[Key]string{"a/0": "10", "a/1": "1"}, map[string]string{"a": "2"}, &queued, &retried)
+
+	throttler.Add("a/0", 0, time.Now())
+	throttler.Add("a/1", 0, time.Now().Add(time.Hour))
+	assert.True(t, throttler.Admit("a/0"), "runs anyway, as nothing else in the namespace is running")
+	assert.False(t, throttler.Admit("a/1"))
+	assert.Equal(t, []Key{"a/0"}, queued)
+}
+
+func TestQuotaThrottlerRunningWorkflowsAreNotQueued(t *testing.T) {
+	var queued, retried []Key
+	throttler := newTestQuotaThrottler(map[Key]string{"a/0": "1", "a/1": "1"}, map[string]string{"a": "1"}, &queued, &retried)
+
+	throttler.Add("a/0", 0, time.Now())
+	assert.True(t, throttler.Admit("a/0"))
+	throttler.Add("a/0", 0, time.Now())
+	throttler.Add("a/1", 0, time.Now())
+	assert.True(t, throttler.Admit("a/0"), "stays running")
+	assert.False(t, throttler.Admit("a/1"))
+}
+
+func TestQuotaThrottlerHeadroomError(t *testing.T) {
+	var queued, retried []Key
+	throttler := newTestQuotaThrottler(map[Key]string{"c/0": "1"}, map[string]string{}, &queued, &retried)
+
+	throttler.Add("c/0", 0, time.Now())
+	assert.False(t, throttler.Admit("c/0"))
+	assert.Empty(t, queued)
+	assert.Contains(t, retried, "c/0")
+}
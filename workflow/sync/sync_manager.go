@@ -8,7 +8,10 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
@@ -24,6 +27,8 @@ type Manager struct {
 	nextWorkflow NextWorkflow
 	getSyncLimit GetSyncLimit
 	isWFDeleted  IsWorkflowDeleted
+	dbInfo       *dbInfo
+	dbLimitCache *limitCache
 }
 
 func NewLockManager(getSyncLimit GetSyncLimit, nextWorkflow NextWorkflow, isWFDeleted IsWorkflowDeleted) *Manager {
@@ -36,6 +41,55 @@ func NewLockManager(getSyncLimit GetSyncLimit, nextWorkflow NextWorkflow, isWFDe
 	}
 }
 
+// EnableDatabase allows workflows to use semaphores and mutexes held in the database, which are shared with other
+// controllers using the same database. It records a heartbeat for this controller, and polls for locks released by
+// other controllers, until the context is done.
+func (sm *Manager) EnableDatabase(ctx context.Context, repo sqldb.SyncRepo, syncConfig *config.SyncConfig) error {
+	if syncConfig.ControllerName == "" {
+		return fmt.Errorf("synchronization controllerName must be set to use database locks")
+	}
+	if err := repo.Heartbeat(syncConfig.ControllerName); err != nil {
+		return fmt.Errorf("failed to record synchronization heartbeat: %w", err)
+	}
+	sm.dbInfo = &dbInfo{
+		repo:                      repo,
+		controllerName:            syncConfig.ControllerName,
+		inactiveControllerTimeout: syncConfig.GetInactiveControllerTimeout(),
+	}
+	sm.dbLimitCache = newLimitCache(syncConfig.GetLimitCacheTTL(), repo.GetLimit)
+	log.WithField("controllerName", syncConfig.ControllerName).Info("Database synchronization is enabled")
+
+	go wait.UntilWithContext(ctx, sm.databaseHeartbeat, syncConfig.GetHeartbeatInterval())
+	go wait.UntilWithContext(ctx, sm.notifyDatabaseWaiters, syncConfig.GetPollInterval())
+	return nil
+}
+
+// databaseHeartbeat records that this controller is alive, and expires the locks of controllers that are not
+func (sm *Manager) databaseHeartbeat(ctx context.Context) {
+	defer runtimeutil.HandleCrashWithContext(ctx, runtimeutil.PanicHandlers...)
+
+	if err := sm.dbInfo.repo.Heartbeat(sm.dbInfo.controllerName); err != nil {
+		log.WithError(err).Error("Failed to record synchronization heartbeat")
+	}
+	if err := sm.dbInfo.repo.DeleteExpired(sm.dbInfo.since()); err != nil {
+		log.WithError(err).Error("Failed to delete expired synchronization locks")
+	}
+}
+
+// notifyDatabaseWaiters enqueues workflows waiting for database locks, as they may have been released by other controllers
+func (sm *Manager) notifyDatabaseWaiters(ctx context.Context) {
+	defer runtimeutil.HandleCrashWithContext(ctx, runtimeutil.PanicHandlers...)
+
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+
+	for _, lock := range sm.syncLockMap {
+		if dbLock, ok := lock.(*databaseSemaphore); ok {
+			dbLock.notifyWaiters()
+		}
+	}
+}
+
 func (sm *Manager) getWorkflowKey(key string) (string, error) {
 	if key == "" {
 		return "", fmt.Errorf("holderkey is empty")
@@ -187,7 +241,11 @@ func (sm *Manager) Initialize(ctx context.Context, wfs []wfv1.Workflow) {
 			for _, holding := range wf.Status.Synchronization.Mutex.Holding {
 				mutex := sm.syncLockMap[holding.Mutex]
 				if mutex == nil {
-					mutex := sm.initializeMutex(holding.Mutex)
+					mutex, err := sm.initializeMutex(holding.Mutex)
+					if err != nil {
+						log.Warnf("cannot initialize mutex '%s': %v", holding.Mutex, err)
+						continue
+					}
 					if holding.Holder != "" {
 						level, err := getWorkflowSyncLevelByName(ctx, &wf, holding.Mutex)
 						if err != nil {
@@ -240,7 +298,7 @@ func (sm *Manager) TryAcquire(ctx context.Context, wf *wfv1.Workflow, nodeName s
 			case wfv1.SynchronizationTypeSemaphore:
 				lock, err = sm.initializeSemaphore(lockKey)
 			case wfv1.SynchronizationTypeMutex:
-				lock = sm.initializeMutex(lockKey)
+				lock, err = sm.initializeMutex(lockKey)
 			default:
 				return false, false, "", failedLockName, fmt.Errorf("unknown Synchronization Type")
 			}
@@ -439,19 +497,45 @@ func (sm *Manager) getCurrentLockHolders(lockName string) []string {
 }
 
 func (sm *Manager) initializeSemaphore(semaphoreName string) (semaphore, error) {
-	limit, err := sm.getSyncLimit(semaphoreName)
+	limit, err := sm.getLimit(semaphoreName)
 	if err != nil {
 		return nil, err
 	}
+	if isDatabaseLock(semaphoreName) {
+		return newDatabaseSemaphore(semaphoreName, limit, sm.nextWorkflow, sm.dbInfo, "semaphore"), nil
+	}
 	return NewSemaphore(semaphoreName, limit, sm.nextWorkflow, "semaphore"), nil
 }
 
-func (sm *Manager) initializeMutex(mutexName string) semaphore {
-	return NewMutex(mutexName, sm.nextWorkflow)
+func (sm *Manager) initializeMutex(mutexName string) (semaphore, error) {
+	if isDatabaseLock(mutexName) {
+		if sm.dbInfo == nil {
+			return nil, errDatabaseNotEnabled
+		}
+		return newDatabaseMutex(mutexName, sm.nextWorkflow, sm.dbInfo), nil
+	}
+	return NewMutex(mutexName, sm.nextWorkflow), nil
+}
+
+var errDatabaseNotEnabled = fmt.Errorf("database locks are not enabled: synchronization must be configured in the controller's configmap")
+
+// getLimit returns the limit of the semaphore, which is held in either a ConfigMap or the database
+func (sm *Manager) getLimit(semaphoreName string) (int, error) {
+	if isDatabaseLock(semaphoreName) {
+		if sm.dbInfo == nil {
+			return 0, errDatabaseNotEnabled
+		}
+		lock, err := DecodeLockName(semaphoreName)
+		if err != nil {
+			return 0, err
+		}
+		return sm.dbLimitCache.get(lock.databaseKey())
+	}
+	return sm.getSyncLimit(semaphoreName)
 }
 
 func (sm *Manager) isSemaphoreSizeChanged(semaphore semaphore) (bool, int, error) {
-	limit, err := sm.getSyncLimit(semaphore.getName())
+	limit, err := sm.getLimit(semaphore.getName())
 	if err != nil {
 		return false, semaphore.getLimit(), err
 	}
@@ -112,9 +112,9 @@ func (ctx *Context) GetTemplateByName(name string) (*wfv1.Template, error) {
 
 func (ctx *Context) GetTemplateGetterFromRef(tmplRef *wfv1.TemplateRef) (wfv1.TemplateHolder, error) {
 	if tmplRef.ClusterScope {
-		return ctx.cwftmplGetter.Get(tmplRef.Name)
+		return GetClusterWorkflowTemplate(ctx.cwftmplGetter, tmplRef.Name, tmplRef.Revision)
 	}
-	return ctx.wftmplGetter.Get(tmplRef.Name)
+	return GetWorkflowTemplate(ctx.wftmplGetter, tmplRef.Name, tmplRef.Revision)
 }
 
 // GetTemplateFromRef returns a template found by a given template ref.
@@ -124,9 +124,9 @@ func (ctx *Context) GetTemplateFromRef(tmplRef *wfv1.TemplateRef) (*wfv1.Templat
 	var wftmpl wfv1.TemplateHolder
 	var err error
 	if tmplRef.ClusterScope {
-		wftmpl, err = ctx.cwftmplGetter.Get(tmplRef.Name)
+		wftmpl, err = GetClusterWorkflowTemplate(ctx.cwftmplGetter, tmplRef.Name, tmplRef.Revision)
 	} else {
-		wftmpl, err = ctx.wftmplGetter.Get(tmplRef.Name)
+		wftmpl, err = GetWorkflowTemplate(ctx.wftmplGetter, tmplRef.Name, tmplRef.Revision)
 	}
 
 	if err != nil {
@@ -236,11 +236,18 @@ func (ctx *Context) resolveTemplateImpl(tmplHolder wfv1.TemplateReferenceHolder)
 func (ctx *Context) WithTemplateHolder(tmplHolder wfv1.TemplateReferenceHolder) (*Context, error) {
 	tmplRef := tmplHolder.GetTemplateRef()
 	if tmplRef != nil {
-		tmplName := tmplRef.Name
 		if tmplRef.ClusterScope {
-			return ctx.WithClusterWorkflowTemplate(tmplName)
+			cwftmpl, err := GetClusterWorkflowTemplate(ctx.cwftmplGetter, tmplRef.Name, tmplRef.Revision)
+			if err != nil {
+				return nil, err
+			}
+			return ctx.WithTemplateBase(cwftmpl), nil
 		} else {
-			return ctx.WithWorkflowTemplate(tmplName)
+			wftmpl, err := GetWorkflowTemplate(ctx.wftmplGetter, tmplRef.Name, tmplRef.Revision)
+			if err != nil {
+				return nil, err
+			}
+			return ctx.WithTemplateBase(wftmpl), nil
 		}
 	}
 	return ctx.WithTemplateBase(ctx.tmplBase), nil
@@ -9,6 +9,7 @@ import (
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	wfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
@@ -145,6 +146,59 @@ func TestGetTemplateFromRef(t *testing.T) {
 	require.EqualError(t, err, "template unknown not found in workflow template some-workflow-template")
 }
 
+type fakeTemplateRevisions map[int64]*wfv1.WorkflowTemplate
+
+func (f fakeTemplateRevisions) GetWorkflowTemplate(_, name string, revision int64) (*wfv1.WorkflowTemplate, error) {
+	wftmpl, ok := f[revision]
+	if !ok {
+		return nil, errors.Errorf(errors.CodeNotFound, "revision %d of WorkflowTemplate %s not found", revision, name)
+	}
+	return wftmpl, nil
+}
+
+func (f fakeTemplateRevisions) GetClusterWorkflowTemplate(name string, revision int64) (*wfv1.ClusterWorkflowTemplate, error) {
+	return nil, errors.Errorf(errors.CodeNotFound, "revision %d of ClusterWorkflowTemplate %s not found", revision, name)
+}
+
+func TestGetTemplateFromRefRevision(t *testing.T) {
+	wfClientset := fakewfclientset.NewSimpleClientset()
+	err := createWorkflowTemplate(wfClientset, someWorkflowTemplateYaml)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wftmpl := unmarshalWftmpl(baseWorkflowTemplateYaml)
+	wftmplGetter := WrapWorkflowTemplateInterface(wfClientset.ArgoprojV1alpha1().WorkflowTemplates(metav1.NamespaceDefault))
+	cwftmplGetter := WrapClusterWorkflowTemplateInterface(wfClientset.ArgoprojV1alpha1().ClusterWorkflowTemplates())
+
+	// Revisions cannot be got without persistence.
+	ctx := NewContext(wftmplGetter, cwftmplGetter, wftmpl, nil)
+	tmplRef := wfv1.TemplateRef{Name: "some-workflow-template", Template: "whalesay", Revision: 1}
+	_, err = ctx.GetTemplateFromRef(&tmplRef)
+	require.EqualError(t, err, "cannot get revision 1 of workflow template some-workflow-template: template revisions require persistence to be configured")
+
+	revision := unmarshalWftmpl(someWorkflowTemplateYaml)
+	revision.GetTemplateByName("whalesay").Container.Image = "docker/whalesay:v1"
+	ctx = NewContext(WithWorkflowTemplateRevisions(wftmplGetter, fakeTemplateRevisions{1: revision}, metav1.NamespaceDefault), cwftmplGetter, wftmpl, nil)
+
+	// The pinned revision is used rather than the latest spec.
+	tmpl, err := ctx.GetTemplateFromRef(&tmplRef)
+	require.NoError(t, err)
+	assert.Equal(t, "docker/whalesay:v1", tmpl.Container.Image)
+	newCtx, err := ctx.WithTemplateHolder(&wfv1.WorkflowStep{TemplateRef: &tmplRef})
+	require.NoError(t, err)
+	assert.Equal(t, "docker/whalesay:v1", newCtx.GetCurrentTemplateBase().GetTemplateByName("whalesay").Container.Image)
+
+	// The latest spec is used without a revision.
+	tmplRef.Revision = 0
+	tmpl, err = ctx.GetTemplateFromRef(&tmplRef)
+	require.NoError(t, err)
+	assert.NotEqual(t, "docker/whalesay:v1", tmpl.Container.Image)
+
+	tmplRef.Revision = 2
+	_, err = ctx.GetTemplateFromRef(&tmplRef)
+	require.EqualError(t, err, "revision 2 of WorkflowTemplate some-workflow-template not found")
+}
+
 func TestGetTemplate(t *testing.T) {
 	wfClientset := fakewfclientset.NewSimpleClientset()
 	err := createWorkflowTemplate(wfClientset, anotherWorkflowTemplateYaml)
@@ -0,0 +1,85 @@
+package templateresolution
+
+import (
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// WorkflowTemplateRevisionGetter is implemented by WorkflowTemplateNamespacedGetters that can also get earlier
+// revisions of WorkflowTemplates.
+type WorkflowTemplateRevisionGetter interface {
+	// GetRevision retrieves a revision of the WorkflowTemplate of a given name.
+	GetRevision(name string, revision int64) (*wfv1.WorkflowTemplate, error)
+}
+
+// ClusterWorkflowTemplateRevisionGetter is implemented by ClusterWorkflowTemplateGetters that can also get earlier
+// revisions of ClusterWorkflowTemplates.
+type ClusterWorkflowTemplateRevisionGetter interface {
+	// GetRevision retrieves a revision of the ClusterWorkflowTemplate of a given name.
+	GetRevision(name string, revision int64) (*wfv1.ClusterWorkflowTemplate, error)
+}
+
+// TemplateRevisions holds the revisions of WorkflowTemplates and ClusterWorkflowTemplates.
+type TemplateRevisions interface {
+	GetWorkflowTemplate(namespace, name string, revision int64) (*wfv1.WorkflowTemplate, error)
+	GetClusterWorkflowTemplate(name string, revision int64) (*wfv1.ClusterWorkflowTemplate, error)
+}
+
+type workflowTemplateRevisionGetter struct {
+	WorkflowTemplateNamespacedGetter
+	revisions TemplateRevisions
+	namespace string
+}
+
+// WithWorkflowTemplateRevisions returns a getter that also gets revisions of the namespace's WorkflowTemplates.
+func WithWorkflowTemplateRevisions(getter WorkflowTemplateNamespacedGetter, revisions TemplateRevisions, namespace string) WorkflowTemplateNamespacedGetter {
+	if revisions == nil {
+		return getter
+	}
+	return &workflowTemplateRevisionGetter{getter, revisions, namespace}
+}
+
+func (g *workflowTemplateRevisionGetter) GetRevision(name string, revision int64) (*wfv1.WorkflowTemplate, error) {
+	return g.revisions.GetWorkflowTemplate(g.namespace, name, revision)
+}
+
+type clusterWorkflowTemplateRevisionGetter struct {
+	ClusterWorkflowTemplateGetter
+	revisions TemplateRevisions
+}
+
+// WithClusterWorkflowTemplateRevisions returns a getter that also gets revisions of ClusterWorkflowTemplates.
+func WithClusterWorkflowTemplateRevisions(getter ClusterWorkflowTemplateGetter, revisions TemplateRevisions) ClusterWorkflowTemplateGetter {
+	if revisions == nil {
+		return getter
+	}
+	return &clusterWorkflowTemplateRevisionGetter{getter, revisions}
+}
+
+func (g *clusterWorkflowTemplateRevisionGetter) GetRevision(name string, revision int64) (*wfv1.ClusterWorkflowTemplate, error) {
+	return g.revisions.GetClusterWorkflowTemplate(name, revision)
+}
+
+// GetWorkflowTemplate gets the revision of the WorkflowTemplate, or its latest spec if the revision is zero.
+func GetWorkflowTemplate(getter WorkflowTemplateNamespacedGetter, name string, revision int64) (*wfv1.WorkflowTemplate, error) {
+	if revision == 0 {
+		return getter.Get(name)
+	}
+	revisionGetter, ok := getter.(WorkflowTemplateRevisionGetter)
+	if !ok {
+		return nil, errors.Errorf(errors.CodeBadRequest, "cannot get revision %d of workflow template %s: template revisions require persistence to be configured", revision, name)
+	}
+	return revisionGetter.GetRevision(name, revision)
+}
+
+// GetClusterWorkflowTemplate gets the revision of the ClusterWorkflowTemplate, or its latest spec if the revision is zero.
+func GetClusterWorkflowTemplate(getter ClusterWorkflowTemplateGetter, name string, revision int64) (*wfv1.ClusterWorkflowTemplate, error) {
+	if revision == 0 {
+		return getter.Get(name)
+	}
+	revisionGetter, ok := getter.(ClusterWorkflowTemplateRevisionGetter)
+	if !ok {
+		return nil, errors.Errorf(errors.CodeBadRequest, "cannot get revision %d of cluster workflow template %s: template revisions require persistence to be configured", revision, name)
+	}
+	return revisionGetter.GetRevision(name, revision)
+}
@@ -0,0 +1,127 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"k8s.io/client-go/kubernetes"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+// NewArtifactDriverFunc creates the driver of an artifact
+type NewArtifactDriverFunc func(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (common.ArtifactDriver, error)
+
+// SaveCompactedNodes saves the statuses of the nodes collapsed into a Compacted node to its artifact
+func SaveCompactedNodes(driver common.ArtifactDriver, art *wfv1.Artifact, nodes []wfv1.NodeStatus) error {
+	data, err := json.Marshal(nodes)
+	if err != nil {
+		return err
+	}
+	// drivers save local files
+	file, err := os.CreateTemp("", "compacted-nodes-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(file.Name()) }()
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return driver.Save(file.Name(), art)
+}
+
+// LoadCompactedNodes loads the statuses of the nodes collapsed into a Compacted node from its artifact
+func LoadCompactedNodes(driver common.ArtifactDriver, node wfv1.NodeStatus) ([]wfv1.NodeStatus, error) {
+	if node.Compacted == nil {
+		return nil, fmt.Errorf("node %s is not compacted", node.ID)
+	}
+	stream, err := driver.OpenStream(&node.Compacted.Artifact)
+	if err != nil {
+		return nil, fmt.Errorf("could not load compacted nodes of %s: %w", node.ID, err)
+	}
+	defer func() { _ = stream.Close() }()
+	data, err := io.ReadAll(stream)
+	if err != nil {
+		return nil, fmt.Errorf("could not read compacted nodes of %s: %w", node.ID, err)
+	}
+	var nodes []wfv1.NodeStatus
+	if err := json.Unmarshal(data, &nodes); err != nil {
+		return nil, fmt.Errorf("malformed compacted nodes of %s: %w", node.ID, err)
+	}
+	return nodes, nil
+}
+
+// RehydrateCompactedNodes replaces each Compacted node of the workflow with the nodes collapsed into it, so that the
+// workflow can be retried or resubmitted
+func RehydrateCompactedNodes(ctx context.Context, wf *wfv1.Workflow, kubeClient kubernetes.Interface, newDriver NewArtifactDriverFunc) error {
+	for _, compacted := range wf.Status.Nodes {
+		if compacted.Type != wfv1.NodeTypeCompacted || compacted.Compacted == nil {
+			continue
+		}
+		driver, err := newDriver(ctx, &compacted.Compacted.Artifact, resource.New(kubeClient, wf.Namespace))
+		if err != nil {
+			return err
+		}
+		nodes, err := LoadCompactedNodes(driver, compacted)
+		if err != nil {
+			return err
+		}
+		RestoreCompactedNodes(wf.Status.Nodes, compacted, nodes)
+	}
+	return nil
+}
+
+// RestoreCompactedNodes replaces a Compacted node with the nodes collapsed into it
+func RestoreCompactedNodes(allNodes wfv1.Nodes, compacted wfv1.NodeStatus, nodes []wfv1.NodeStatus) {
+	ids := make([]string, len(nodes))
+	// nodes which became children of the Compacted node, e.g. tasks that started after it was compacted, become
+	// children of each of the nodes collapsed into it
+	var children []string
+	for _, child := range compacted.Children {
+		if !slices.ContainsFunc(nodes, func(node wfv1.NodeStatus) bool { return slices.Contains(node.Children, child) }) {
+			children = append(children, child)
+		}
+	}
+	for i, node := range nodes {
+		ids[i] = node.ID
+		for _, child := range children {
+			if !slices.Contains(node.Children, child) {
+				node.Children = append(node.Children, child)
+			}
+		}
+		allNodes.Set(node.ID, node)
+	}
+	allNodes.Delete(compacted.ID)
+	for id, node := range allNodes {
+		children := replaceNodeID(node.Children, compacted.ID, ids)
+		outboundNodes := replaceNodeID(node.OutboundNodes, compacted.ID, ids)
+		if children != nil || outboundNodes != nil {
+			if children != nil {
+				node.Children = children
+			}
+			if outboundNodes != nil {
+				node.OutboundNodes = outboundNodes
+			}
+			allNodes.Set(id, node)
+		}
+	}
+}
+
+// replaceNodeID returns the IDs with the ID replaced by the replacements, or nil if the ID was not found
+func replaceNodeID(ids []string, id string, replacements []string) []string {
+	i := slices.Index(ids, id)
+	if i < 0 {
+		return nil
+	}
+	return slices.Concat(ids[:i], replacements, ids[i+1:])
+}
@@ -0,0 +1,34 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestRestoreCompactedNodes(t *testing.T) {
+	nodes := wfv1.Nodes{
+		"group":     {ID: "group", Name: "wf[0]", Type: wfv1.NodeTypeStepGroup, Children: []string{"compacted"}},
+		"compacted": {ID: "compacted", Name: "wf[0].hello(compacted)", Type: wfv1.NodeTypeCompacted, Children: []string{"retry-child", "next"}, Compacted: &wfv1.CompactedNodes{Count: 2}},
+		"next":      {ID: "next", Name: "wf[1]", Type: wfv1.NodeTypeStepGroup},
+		"steps":     {ID: "steps", Name: "wf", Type: wfv1.NodeTypeSteps, Children: []string{"group"}, OutboundNodes: []string{"compacted"}},
+	}
+	compacted := nodes["compacted"]
+	RestoreCompactedNodes(nodes, compacted, []wfv1.NodeStatus{
+		{ID: "hello-0", Name: "wf[0].hello(0:a)", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded},
+		{ID: "hello-1", Name: "wf[0].hello(1:b)", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded, Children: []string{"retry-child"}},
+	})
+
+	assert.False(t, nodes.Has("compacted"))
+	require.True(t, nodes.Has("hello-0"))
+	require.True(t, nodes.Has("hello-1"))
+	assert.Equal(t, []string{"hello-0", "hello-1"}, nodes["group"].Children)
+	assert.Equal(t, []string{"hello-0", "hello-1"}, nodes["steps"].OutboundNodes)
+	assert.Equal(t, []string{"group"}, nodes["steps"].Children)
+	// children added after compaction become children of each restored node
+	assert.Equal(t, []string{"next"}, nodes["hello-0"].Children)
+	assert.Equal(t, []string{"retry-child", "next"}, nodes["hello-1"].Children)
+}
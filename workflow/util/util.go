@@ -1106,7 +1106,7 @@ func resetPath(allNodes []*dagNode, startNode string) (map[string]bool, map[stri
 		case wfv1.NodeTypeTaskGroup:
 			addToReset(curr.n.ID)
 			findBoundaries = true
-		case wfv1.NodeTypeRetry:
+		case wfv1.NodeTypeRetry, wfv1.NodeTypeLoop:
 			addToReset(curr.n.ID)
 		case wfv1.NodeTypeSkipped:
 			// ignore -> doesn't make sense to reach this
@@ -1214,7 +1214,7 @@ func dagSortedNodes(nodes []*dagNode, rootNodeName string) []*dagNode {
 // iterate through all must delete nodes: iterator $node
 // obtain singular path to each $node
 // reset all "reset points" to $node
-func FormulateRetryWorkflow(ctx context.Context, wf *wfv1.Workflow, restartSuccessful bool, nodeFieldSelector string, parameters []string) (*wfv1.Workflow, []string, error) {
+func FormulateRetryWorkflow(ctx context.Context, wf *wfv1.Workflow, restartSuccessful bool, nodeFieldSelector string, parameters []string, nodeParameters []string, nodeArtifacts []string) (*wfv1.Workflow, []string, error) {
 
 	switch wf.Status.Phase {
 	case wfv1.WorkflowFailed, wfv1.WorkflowError:
@@ -1371,9 +1371,94 @@ func FormulateRetryWorkflow(ctx context.Context, wf *wfv1.Workflow, restartSucce
 		newWf.Status.Nodes.Set(id, *wfNode.DeepCopy())
 	}
 
+	if err := overrideNodeArguments(newWf, wf.Status.Nodes, nodeParameters, nodeArtifacts, setUnion(toReset, toDelete)); err != nil {
+		return nil, nil, err
+	}
+
 	return newWf, podsToDelete, nil
 }
 
+// overrideNodeArguments overrides the input parameters of nodes being retried, each given in the form NODE=NAME=VALUE,
+// and their input artifacts, each given in the form NODE=NAME=KEY, where NODE is the node's name or ID. An artifact is
+// replaced by the one at KEY in the same repository. Nodes that have been deleted get the overrides when they are
+// executed again.
+func overrideNodeArguments(newWf *wfv1.Workflow, nodes wfv1.Nodes, nodeParameters, nodeArtifacts []string, retried map[string]bool) error {
+	for _, paramStr := range nodeParameters {
+		node, name, value, err := parseNodeOverride(nodes, paramStr, "parameter", "NODE=NAME=VALUE", retried)
+		if err != nil {
+			return err
+		}
+		if node.Inputs == nil || node.Inputs.GetParameterByName(name) == nil {
+			return errors.Errorf(errors.CodeBadRequest, "node %s has no input parameter %s", node.Name, name)
+		}
+		overrides := newWf.Status.NodeArgumentOverrides[node.Name]
+		param := wfv1.Parameter{Name: name, Value: wfv1.AnyStringPtr(value)}
+		if i := slices.IndexFunc(overrides.Parameters, func(p wfv1.Parameter) bool { return p.Name == param.Name }); i >= 0 {
+			overrides.Parameters[i] = param
+		} else {
+			overrides.Parameters = append(overrides.Parameters, param)
+		}
+		setNodeArgumentOverrides(newWf, node, overrides)
+	}
+	for _, artStr := range nodeArtifacts {
+		node, name, key, err := parseNodeOverride(nodes, artStr, "artifact", "NODE=NAME=KEY", retried)
+		if err != nil {
+			return err
+		}
+		var input *wfv1.Artifact
+		if node.Inputs != nil {
+			input = node.Inputs.GetArtifactByName(name)
+		}
+		if input == nil {
+			return errors.Errorf(errors.CodeBadRequest, "node %s has no input artifact %s", node.Name, name)
+		}
+		art := wfv1.Artifact{Name: name, ArtifactLocation: *input.ArtifactLocation.DeepCopy()}
+		if err := art.SetKey(key); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "input artifact %s of node %s cannot be overridden with a key: %v", name, node.Name, err)
+		}
+		overrides := newWf.Status.NodeArgumentOverrides[node.Name]
+		if i := slices.IndexFunc(overrides.Artifacts, func(a wfv1.Artifact) bool { return a.Name == art.Name }); i >= 0 {
+			overrides.Artifacts[i] = art
+		} else {
+			overrides.Artifacts = append(overrides.Artifacts, art)
+		}
+		setNodeArgumentOverrides(newWf, node, overrides)
+	}
+	return nil
+}
+
+// parseNodeOverride parses an override of an input of a node being retried into the node, the input's name and its value
+func parseNodeOverride(nodes wfv1.Nodes, override, kind, form string, retried map[string]bool) (*wfv1.NodeStatus, string, string, error) {
+	parts := strings.SplitN(override, "=", 3)
+	if len(parts) != 3 {
+		return nil, "", "", fmt.Errorf("expected node %s of the form: %s. Received: %s", kind, form, override)
+	}
+	node, err := nodes.Get(parts[0])
+	if err != nil {
+		node = nodes.FindByName(parts[0])
+	}
+	if node == nil {
+		return nil, "", "", errors.Errorf(errors.CodeBadRequest, "node %s not found", parts[0])
+	}
+	if !retried[node.ID] {
+		return nil, "", "", errors.Errorf(errors.CodeBadRequest, "node %s is not being retried", node.Name)
+	}
+	return node, parts[1], parts[2], nil
+}
+
+// setNodeArgumentOverrides records the overrides of a node being retried
+func setNodeArgumentOverrides(newWf *wfv1.Workflow, node *wfv1.NodeStatus, overrides wfv1.Arguments) {
+	if newWf.Status.NodeArgumentOverrides == nil {
+		newWf.Status.NodeArgumentOverrides = make(map[string]wfv1.Arguments)
+	}
+	newWf.Status.NodeArgumentOverrides[node.Name] = overrides
+	// nodes that are reset rather than deleted are not initialized again, so record the overrides now
+	if n, err := newWf.Status.Nodes.Get(node.ID); err == nil {
+		n.ArgumentOverrides = overrides.DeepCopy()
+		newWf.Status.Nodes.Set(node.ID, *n)
+	}
+}
+
 func resetNode(node wfv1.NodeStatus) wfv1.NodeStatus {
 	// The previously supplied parameters needed to be reset. Otherwise, `argo node reset` would not work as expected.
 	if node.Type == wfv1.NodeTypeSuspend {
@@ -884,7 +884,7 @@ func TestDeepDeleteNodes(t *testing.T) {
 	ctx := context.Background()
 	wf, err := wfIf.Create(ctx, origWf, metav1.CreateOptions{})
 	require.NoError(t, err)
-	newWf, _, err := FormulateRetryWorkflow(ctx, wf, false, "", nil)
+	newWf, _, err := FormulateRetryWorkflow(ctx, wf, false, "", nil, nil, nil)
 	require.NoError(t, err)
 	newWfBytes, err := yaml.Marshal(newWf)
 	require.NoError(t, err)
@@ -1014,7 +1014,7 @@ func TestRetryExitHandler(t *testing.T) {
 	ctx := context.Background()
 	wf, err := wfIf.Create(ctx, origWf, metav1.CreateOptions{})
 	require.NoError(t, err)
-	newWf, _, err := FormulateRetryWorkflow(ctx, wf, false, "", nil)
+	newWf, _, err := FormulateRetryWorkflow(ctx, wf, false, "", nil, nil, nil)
 	require.NoError(t, err)
 	newWfBytes, err := yaml.Marshal(newWf)
 	require.NoError(t, err)
@@ -1039,7 +1039,7 @@ func TestFormulateRetryWorkflow(t *testing.T) {
 		}
 		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
 		require.NoError(t, err)
-		wf, _, err = FormulateRetryWorkflow(ctx, wf, false, "", nil)
+		wf, _, err = FormulateRetryWorkflow(ctx, wf, false, "", nil, nil, nil)
 		require.NoError(t, err)
 		assert.Len(t, wf.Status.Nodes, 1)
 	})
@@ -1070,7 +1070,7 @@ func TestFormulateRetryWorkflow(t *testing.T) {
 		}
 		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
 		require.NoError(t, err)
-		wf, _, err = FormulateRetryWorkflow(ctx, wf, true, "id=suspended", nil)
+		wf, _, err = FormulateRetryWorkflow(ctx, wf, true, "id=suspended", nil, nil, nil)
 		require.NoError(t, err)
 		require.Len(t, wf.Status.Nodes, 2)
 		assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["wf-with-skipped-and-suspended-nodes"].Phase)
@@ -1094,7 +1094,7 @@ func TestFormulateRetryWorkflow(t *testing.T) {
 		}
 		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
 		require.NoError(t, err)
-		wf, _, err = FormulateRetryWorkflow(ctx, wf, true, "id=3", nil)
+		wf, _, err = FormulateRetryWorkflow(ctx, wf, true, "id=3", nil, nil, nil)
 		require.NoError(t, err)
 		// Node #3, #4 are deleted and will be recreated so only 3 nodes left in wf.Status.Nodes
 		require.Len(t, wf.Status.Nodes, 3)
@@ -1121,7 +1121,7 @@ func TestFormulateRetryWorkflow(t *testing.T) {
 		}
 		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
 		require.NoError(t, err)
-		wf, _, err = FormulateRetryWorkflow(ctx, wf, true, "", nil)
+		wf, _, err = FormulateRetryWorkflow(ctx, wf, true, "", nil, nil, nil)
 		require.NoError(t, err)
 		// Node #2, #3, and #4 are deleted and will be recreated so only 2 nodes left in wf.Status.Nodes
 		require.Len(t, wf.Status.Nodes, 4)
@@ -1147,7 +1147,7 @@ func TestFormulateRetryWorkflow(t *testing.T) {
 					"override-param-wf": {ID: "override-param-wf", Name: "override-param-wf", Phase: wfv1.NodeSucceeded, Type: wfv1.NodeTypeDAG},
 				}},
 		}
-		wf, _, err := FormulateRetryWorkflow(context.Background(), wf, false, "", []string{"message=modified"})
+		wf, _, err := FormulateRetryWorkflow(context.Background(), wf, false, "", []string{"message=modified"}, nil, nil)
 		require.NoError(t, err)
 		assert.Equal(t, "modified", wf.Spec.Arguments.Parameters[0].Value.String())
 
@@ -1175,13 +1175,75 @@ func TestFormulateRetryWorkflow(t *testing.T) {
 					}},
 				}},
 		}
-		wf, _, err := FormulateRetryWorkflow(context.Background(), wf, false, "", []string{"message=modified"})
+		wf, _, err := FormulateRetryWorkflow(context.Background(), wf, false, "", []string{"message=modified"}, nil, nil)
 		require.NoError(t, err)
 		assert.Equal(t, "modified", wf.Spec.Arguments.Parameters[0].Value.String())
 		assert.Equal(t, "modified", wf.Status.StoredWorkflowSpec.Arguments.Parameters[0].Value.String())
 
 	})
 
+	t.Run("OverrideNodeParams", func(t *testing.T) {
+		newWf := func() *wfv1.Workflow {
+			return &wfv1.Workflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "override-node-param-wf",
+					Labels: map[string]string{},
+				},
+				Status: wfv1.WorkflowStatus{
+					Phase: wfv1.WorkflowFailed,
+					Nodes: map[string]wfv1.NodeStatus{
+						"1": {ID: "1", Name: "override-node-param-wf", Phase: wfv1.NodeFailed, Type: wfv1.NodeTypeDAG, Children: []string{"2", "3"}, Inputs: &wfv1.Inputs{
+							Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("hello")}},
+						}},
+						"2": {ID: "2", Name: "override-node-param-wf.a", Phase: wfv1.NodeFailed, Type: wfv1.NodeTypePod, BoundaryID: "1", Inputs: &wfv1.Inputs{
+							Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("hello")}},
+							Artifacts: []wfv1.Artifact{
+								{Name: "data", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}, Key: "my-data/original.tgz"}}},
+								{Name: "raw", ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "hello"}}},
+							},
+						}},
+						"3": {ID: "3", Name: "override-node-param-wf.b", Phase: wfv1.NodeSucceeded, Type: wfv1.NodeTypePod, BoundaryID: "1", Inputs: &wfv1.Inputs{
+							Parameters: []wfv1.Parameter{{Name: "message", Value: wfv1.AnyStringPtr("hello")}},
+						}},
+					},
+				},
+			}
+		}
+		wf, _, err := FormulateRetryWorkflow(context.Background(), newWf(), false, "", nil, []string{
+			"override-node-param-wf.a=message=bye=now",
+			"1=message=goodbye",
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "bye=now", wf.Status.NodeArgumentOverrides["override-node-param-wf.a"].Parameters[0].Value.String())
+		assert.Equal(t, "goodbye", wf.Status.NodeArgumentOverrides["override-node-param-wf"].Parameters[0].Value.String())
+		assert.False(t, wf.Status.Nodes.Has("2"))
+		require.NotNil(t, wf.Status.Nodes["1"].ArgumentOverrides)
+		assert.Equal(t, "goodbye", wf.Status.Nodes["1"].ArgumentOverrides.Parameters[0].Value.String())
+
+		wf, _, err = FormulateRetryWorkflow(context.Background(), newWf(), false, "", nil, nil, []string{"override-node-param-wf.a=data=my-data/fixed.tgz"})
+		require.NoError(t, err)
+		overrides := wf.Status.NodeArgumentOverrides["override-node-param-wf.a"]
+		require.Len(t, overrides.Artifacts, 1)
+		assert.Equal(t, "data", overrides.Artifacts[0].Name)
+		assert.Equal(t, "my-bucket", overrides.Artifacts[0].S3.Bucket)
+		assert.Equal(t, "my-data/fixed.tgz", overrides.Artifacts[0].S3.Key)
+		_, _, err = FormulateRetryWorkflow(context.Background(), newWf(), false, "", nil, nil, []string{"override-node-param-wf.a=other=my-data/fixed.tgz"})
+		require.EqualError(t, err, "node override-node-param-wf.a has no input artifact other")
+		_, _, err = FormulateRetryWorkflow(context.Background(), newWf(), false, "", nil, nil, []string{"override-node-param-wf.a=raw=my-data/fixed.tgz"})
+		require.ErrorContains(t, err, "input artifact raw of node override-node-param-wf.a cannot be overridden with a key")
+		_, _, err = FormulateRetryWorkflow(context.Background(), newWf(), false, "", nil, nil, []string{"override-node-param-wf.a=data"})
+		require.EqualError(t, err, "expected node artifact of the form: NODE=NAME=KEY. Received: override-node-param-wf.a=data")
+
+		_, _, err = FormulateRetryWorkflow(context.Background(), newWf(), false, "", nil, []string{"override-node-param-wf.b=message=bye"}, nil)
+		require.EqualError(t, err, "node override-node-param-wf.b is not being retried")
+		_, _, err = FormulateRetryWorkflow(context.Background(), newWf(), false, "", nil, []string{"override-node-param-wf.a=other=bye"}, nil)
+		require.EqualError(t, err, "node override-node-param-wf.a has no input parameter other")
+		_, _, err = FormulateRetryWorkflow(context.Background(), newWf(), false, "", nil, []string{"missing=message=bye"}, nil)
+		require.EqualError(t, err, "node missing not found")
+		_, _, err = FormulateRetryWorkflow(context.Background(), newWf(), false, "", nil, []string{"override-node-param-wf.a"}, nil)
+		require.EqualError(t, err, "expected node parameter of the form: NODE=NAME=VALUE. Received: override-node-param-wf.a")
+	})
+
 	t.Run("Fail on running workflow", func(t *testing.T) {
 		wf := &wfv1.Workflow{
 			ObjectMeta: metav1.ObjectMeta{
@@ -1195,7 +1257,7 @@ func TestFormulateRetryWorkflow(t *testing.T) {
 		}
 		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
 		require.NoError(t, err)
-		_, _, err = FormulateRetryWorkflow(ctx, wf, false, "", nil)
+		_, _, err = FormulateRetryWorkflow(ctx, wf, false, "", nil, nil, nil)
 		require.Error(t, err)
 	})
 
@@ -1212,7 +1274,7 @@ func TestFormulateRetryWorkflow(t *testing.T) {
 		}
 		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
 		require.NoError(t, err)
-		_, _, err = FormulateRetryWorkflow(ctx, wf, false, "", nil)
+		_, _, err = FormulateRetryWorkflow(ctx, wf, false, "", nil, nil, nil)
 		require.Error(t, err)
 	})
 
@@ -1232,7 +1294,7 @@ func TestFormulateRetryWorkflow(t *testing.T) {
 		}
 		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
 		require.NoError(t, err)
-		_, _, err = FormulateRetryWorkflow(ctx, wf, false, "", nil)
+		_, _, err = FormulateRetryWorkflow(ctx, wf, false, "", nil, nil, nil)
 		require.Error(t, err)
 	})
 
@@ -1254,7 +1316,7 @@ func TestFormulateRetryWorkflow(t *testing.T) {
 		}
 		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
 		require.NoError(t, err)
-		wf, _, err = FormulateRetryWorkflow(ctx, wf, true, "id=4", nil)
+		wf, _, err = FormulateRetryWorkflow(ctx, wf, true, "id=4", nil, nil, nil)
 		require.NoError(t, err)
 		// Node #4 is deleted and will be recreated so only 4 nodes left in wf.Status.Nodes
 		require.Len(t, wf.Status.Nodes, 4)
@@ -1284,7 +1346,7 @@ func TestFormulateRetryWorkflow(t *testing.T) {
 		}
 		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
 		require.NoError(t, err)
-		wf, podsToDelete, err := FormulateRetryWorkflow(ctx, wf, false, "", nil)
+		wf, podsToDelete, err := FormulateRetryWorkflow(ctx, wf, false, "", nil, nil, nil)
 		require.NoError(t, err)
 		require.Len(t, wf.Status.Nodes, 4)
 		assert.Equal(t, wfv1.NodeSucceeded, wf.Status.Nodes["1"].Phase)
@@ -1310,7 +1372,7 @@ func TestFormulateRetryWorkflow(t *testing.T) {
 		}
 		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
 		require.NoError(t, err)
-		wf, podsToDelete, err := FormulateRetryWorkflow(ctx, wf, true, "id=3", nil)
+		wf, podsToDelete, err := FormulateRetryWorkflow(ctx, wf, true, "id=3", nil, nil, nil)
 		require.NoError(t, err)
 		require.Len(t, wf.Status.Nodes, 2)
 		assert.Equal(t, wfv1.NodeSucceeded, wf.Status.Nodes["1"].Phase)
@@ -1857,14 +1919,14 @@ func TestRetryWorkflowWithNestedDAGsWithSuspendNodes(t *testing.T) {
 	wf := wfv1.MustUnmarshalWorkflow(retryWorkflowWithNestedDAGsWithSuspendNodes)
 
 	// Retry top individual pod node
-	wf, podsToDelete, err := FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step1", nil)
+	wf, podsToDelete, err := FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step1", nil, nil, nil)
 	require.NoError(t, err)
 	assert.Len(t, wf.Status.Nodes, 1)
 	assert.Len(t, podsToDelete, 6)
 
 	// Retry top individual suspend node
 	wf = wfv1.MustUnmarshalWorkflow(retryWorkflowWithNestedDAGsWithSuspendNodes)
-	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step2", nil)
+	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step2", nil, nil, nil)
 	require.NoError(t, err)
 	require.Len(t, wf.Status.Nodes, 2)
 	assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["fail-two-nested-dag-suspend"].Phase)
@@ -1873,7 +1935,7 @@ func TestRetryWorkflowWithNestedDAGsWithSuspendNodes(t *testing.T) {
 
 	// Retry the starting on first DAG in one of the branches
 	wf = wfv1.MustUnmarshalWorkflow(retryWorkflowWithNestedDAGsWithSuspendNodes)
-	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step3-middle2", nil)
+	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step3-middle2", nil, nil, nil)
 	require.NoError(t, err)
 
 	assert.Len(t, wf.Status.Nodes, 9)
@@ -1891,7 +1953,7 @@ func TestRetryWorkflowWithNestedDAGsWithSuspendNodes(t *testing.T) {
 
 	// Retry the starting on second DAG in one of the branches
 	wf = wfv1.MustUnmarshalWorkflow(retryWorkflowWithNestedDAGsWithSuspendNodes)
-	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step3-middle2.dag2-branch2-step1", nil)
+	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step3-middle2.dag2-branch2-step1", nil, nil, nil)
 	require.NoError(t, err)
 	assert.Len(t, wf.Status.Nodes, 10)
 	assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["fail-two-nested-dag-suspend"].Phase)
@@ -1910,7 +1972,7 @@ func TestRetryWorkflowWithNestedDAGsWithSuspendNodes(t *testing.T) {
 
 	// Retry the first individual node (suspended node) connecting to the second DAG in one of the branches
 	wf = wfv1.MustUnmarshalWorkflow(retryWorkflowWithNestedDAGsWithSuspendNodes)
-	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step3-middle2.dag2-branch2-step1.dag3-step1", nil)
+	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step3-middle2.dag2-branch2-step1.dag3-step1", nil, nil, nil)
 	require.NoError(t, err)
 	assert.Len(t, wf.Status.Nodes, 11)
 	assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["fail-two-nested-dag-suspend"].Phase)
@@ -1930,7 +1992,7 @@ func TestRetryWorkflowWithNestedDAGsWithSuspendNodes(t *testing.T) {
 
 	// Retry the second individual node (pod node) connecting to the second DAG in one of the branches
 	wf = wfv1.MustUnmarshalWorkflow(retryWorkflowWithNestedDAGsWithSuspendNodes)
-	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step3-middle2.dag2-branch2-step1.dag3-step2", nil)
+	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step3-middle2.dag2-branch2-step1.dag3-step2", nil, nil, nil)
 	require.NoError(t, err)
 	assert.Len(t, wf.Status.Nodes, 12)
 	assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["fail-two-nested-dag-suspend"].Phase)
@@ -1952,7 +2014,7 @@ func TestRetryWorkflowWithNestedDAGsWithSuspendNodes(t *testing.T) {
 
 	// Retry the third individual node (pod node) connecting to the second DAG in one of the branches
 	wf = wfv1.MustUnmarshalWorkflow(retryWorkflowWithNestedDAGsWithSuspendNodes)
-	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step3-middle2.dag2-branch2-step1.dag3-step3", nil)
+	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step3-middle2.dag2-branch2-step1.dag3-step3", nil, nil, nil)
 	require.NoError(t, err)
 	assert.Len(t, wf.Status.Nodes, 13)
 	assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["fail-two-nested-dag-suspend"].Phase)
@@ -1975,7 +2037,7 @@ func TestRetryWorkflowWithNestedDAGsWithSuspendNodes(t *testing.T) {
 
 	// Retry the last individual node (suspend node) connecting to the second DAG in one of the branches
 	wf = wfv1.MustUnmarshalWorkflow(retryWorkflowWithNestedDAGsWithSuspendNodes)
-	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step3-middle2.dag2-branch2-step2", nil)
+	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step3-middle2.dag2-branch2-step2", nil, nil, nil)
 	require.NoError(t, err)
 	assert.Len(t, wf.Status.Nodes, 14)
 	assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["fail-two-nested-dag-suspend"].Phase)
@@ -1998,7 +2060,7 @@ func TestRetryWorkflowWithNestedDAGsWithSuspendNodes(t *testing.T) {
 
 	// Retry the node that connects the two branches
 	wf = wfv1.MustUnmarshalWorkflow(retryWorkflowWithNestedDAGsWithSuspendNodes)
-	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step4", nil)
+	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step4", nil, nil, nil)
 	require.NoError(t, err)
 	assert.Len(t, wf.Status.Nodes, 15)
 	assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["fail-two-nested-dag-suspend"].Phase)
@@ -2008,7 +2070,7 @@ func TestRetryWorkflowWithNestedDAGsWithSuspendNodes(t *testing.T) {
 
 	// Retry the last node (failing node)
 	wf = wfv1.MustUnmarshalWorkflow(retryWorkflowWithNestedDAGsWithSuspendNodes)
-	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step5-tofail", nil)
+	wf, podsToDelete, err = FormulateRetryWorkflow(ctx, wf, true, "name=fail-two-nested-dag-suspend.dag1-step5-tofail", nil, nil, nil)
 	require.NoError(t, err)
 	assert.Len(t, wf.Status.Nodes, 16)
 	assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["fail-two-nested-dag-suspend"].Phase)
@@ -2273,7 +2335,7 @@ func TestStepsRetryWorkflow(t *testing.T) {
 			succeeded[node.ID] = true
 		}
 	}
-	newWf, podsToDelete, err := FormulateRetryWorkflow(context.Background(), wf, true, selectorStr, []string{})
+	newWf, podsToDelete, err := FormulateRetryWorkflow(context.Background(), wf, true, selectorStr, []string{}, nil, nil)
 	require.NoError(err)
 	assert.Len(podsToDelete, 1)
 	assert.Len(newWf.Status.Nodes, 5)
@@ -2567,7 +2629,7 @@ func TestDAGDiamondRetryWorkflow(t *testing.T) {
 			succeeded[node.ID] = true
 		}
 	}
-	newWf, podsToDelete, err := FormulateRetryWorkflow(context.Background(), wf, true, selectorStr, []string{})
+	newWf, podsToDelete, err := FormulateRetryWorkflow(context.Background(), wf, true, selectorStr, []string{}, nil, nil)
 
 	require.NoError(err)
 	assert.Len(podsToDelete, 2)
@@ -2994,7 +3056,7 @@ func TestOnExitWorkflowRetry(t *testing.T) {
 	}
 
 	selectorStr := "id=work-avoidance-trkkq-4183398008"
-	newWf, podsToDelete, err := FormulateRetryWorkflow(context.Background(), wf, true, selectorStr, []string{})
+	newWf, podsToDelete, err := FormulateRetryWorkflow(context.Background(), wf, true, selectorStr, []string{}, nil, nil)
 	require.NoError(err)
 	assert.Len(newWf.Status.Nodes, 6)
 	assert.Len(podsToDelete, 2)
@@ -3147,7 +3209,7 @@ func TestOnExitWorkflow(t *testing.T) {
 	assert := assert.New(t)
 	wf := wfv1.MustUnmarshalWorkflow(onExitWorkflow)
 
-	newWf, podsToDelete, err := FormulateRetryWorkflow(context.Background(), wf, false, "", []string{})
+	newWf, podsToDelete, err := FormulateRetryWorkflow(context.Background(), wf, false, "", []string{}, nil, nil)
 	require.NoError(err)
 	assert.Len(podsToDelete, 1)
 	assert.Len(newWf.Status.Nodes, 1)
@@ -3897,7 +3959,7 @@ func TestNestedDAG(t *testing.T) {
 		}
 	}
 
-	newWf, podsToDelete, err := FormulateRetryWorkflow(context.Background(), wf, true, "id=dag-nested-zxlc2-744943701", []string{})
+	newWf, podsToDelete, err := FormulateRetryWorkflow(context.Background(), wf, true, "id=dag-nested-zxlc2-744943701", []string{}, nil, nil)
 	require.NoError(err)
 	assert.Len(podsToDelete, 2)
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -137,6 +138,25 @@ func validateHooks(hooks wfv1.LifecycleHooks, hookBaseName string) error {
 	return nil
 }
 
+func validateLoop(loop *wfv1.Loop, loopBaseName string) error {
+	if loop == nil {
+		return nil
+	}
+	if loop.Until == "" {
+		return errors.Errorf(errors.CodeBadRequest, "%s.loop.until is required", loopBaseName)
+	}
+	if loop.MaxIterations == nil {
+		return errors.Errorf(errors.CodeBadRequest, "%s.loop.maxIterations is required", loopBaseName)
+	}
+	if !intstr.IsValidIntOrArgoVariable(loop.MaxIterations) && !placeholderGenerator.IsPlaceholder(loop.MaxIterations.StrVal) {
+		return errors.Errorf(errors.CodeBadRequest, "%s.loop.maxIterations must be a positive integer > 0 or an argo variable", loopBaseName)
+	}
+	if i, err := intstr.Int(loop.MaxIterations); err == nil && i != nil && *i <= 0 {
+		return errors.Errorf(errors.CodeBadRequest, "%s.loop.maxIterations must be a positive integer > 0 or an argo variable", loopBaseName)
+	}
+	return nil
+}
+
 // ValidateWorkflow accepts a workflow and performs validation against it.
 func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespacedGetter, cwftmplGetter templateresolution.ClusterWorkflowTemplateGetter, wf *wfv1.Workflow, opts ValidateOpts) error {
 	ctx := newTemplateValidationCtx(wf, opts)
@@ -159,9 +179,9 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 			return err
 		}
 		if wf.Spec.WorkflowTemplateRef.ClusterScope {
-			wfSpecHolder, err = cwftmplGetter.Get(wf.Spec.WorkflowTemplateRef.Name)
+			wfSpecHolder, err = templateresolution.GetClusterWorkflowTemplate(cwftmplGetter, wf.Spec.WorkflowTemplateRef.Name, wf.Spec.WorkflowTemplateRef.Revision)
 		} else {
-			wfSpecHolder, err = wftmplGetter.Get(wf.Spec.WorkflowTemplateRef.Name)
+			wfSpecHolder, err = templateresolution.GetWorkflowTemplate(wftmplGetter, wf.Spec.WorkflowTemplateRef.Name, wf.Spec.WorkflowTemplateRef.Revision)
 		}
 		if err != nil {
 			return err
@@ -428,6 +448,18 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 		return err
 	}
 
+	if err := validateMemoize(tmpl); err != nil {
+		return err
+	}
+
+	if err := validateGang(tmpl); err != nil {
+		return err
+	}
+
+	if err := validateCheckpoint(tmpl); err != nil {
+		return err
+	}
+
 	localParams := make(map[string]string)
 	if tmpl.IsPodType() {
 		localParams[common.LocalVarPodName] = placeholderGenerator.NextPlaceholder()
@@ -586,11 +618,51 @@ func (ctx *templateValidationCtx) validateTemplateHolder(tmplHolder wfv1.Templat
 		default:
 			return nil, fmt.Errorf("%s is not a valid RetryPolicy", resolvedTmpl.RetryStrategy.RetryPolicy)
 		}
+		if err := validateRetryRules(resolvedTmpl.RetryStrategy.RetryRules); err != nil {
+			return nil, err
+		}
+		if err := validateResourceEscalation(resolvedTmpl.RetryStrategy.ResourceEscalation); err != nil {
+			return nil, err
+		}
 	}
 
 	return resolvedTmpl, ctx.validateTemplate(resolvedTmpl, tmplCtx, args, workflowTemplateValidation)
 }
 
+// validateRetryRules validates the matches, limits and backoffs of retry rules
+func validateRetryRules(rules []wfv1.RetryRule) error {
+	for i, rule := range rules {
+		if rule.Limit == nil {
+			return errors.Errorf(errors.CodeBadRequest, "retryStrategy.retryRules[%d].limit is required", i)
+		}
+		if rule.Backoff != nil && rule.Backoff.Duration == "" {
+			return errors.Errorf(errors.CodeBadRequest, "retryStrategy.retryRules[%d].backoff.duration is required", i)
+		}
+		if match := rule.Match; match != nil {
+			if _, err := regexp.Compile(match.Message); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "retryStrategy.retryRules[%d].match.message is not a valid regular expression: %v", i, err)
+			}
+			switch match.Status {
+			case wfv1.NodeFailed, wfv1.NodeError, "":
+			default:
+				return errors.Errorf(errors.CodeBadRequest, "retryStrategy.retryRules[%d].match.status must be one of: Failed, Error", i)
+			}
+		}
+	}
+	return nil
+}
+
+// validateResourceEscalation validates the factor of a resource escalation
+func validateResourceEscalation(escalation *wfv1.ResourceEscalation) error {
+	if escalation == nil || escalation.Factor == nil {
+		return nil
+	}
+	if factor, err := strconv.ParseFloat(escalation.Factor.String(), 64); err != nil || factor < 1 {
+		return errors.Errorf(errors.CodeBadRequest, "retryStrategy.resourceEscalation.factor must be a number >= 1")
+	}
+	return nil
+}
+
 // validateTemplateType validates that only one template type is defined
 func validateTemplateType(tmpl *wfv1.Template) error {
 	numTypes := 0
@@ -610,6 +682,68 @@ func validateTemplateType(tmpl *wfv1.Template) error {
 	return nil
 }
 
+func validateMemoize(tmpl *wfv1.Template) error {
+	if tmpl.Memoize == nil || tmpl.Memoize.Cache == nil {
+		return nil
+	}
+	cache := tmpl.Memoize.Cache
+	numCaches := 0
+	for _, set := range []bool{cache.ConfigMap != nil, cache.SQL != nil, cache.Artifact != nil} {
+		if set {
+			numCaches++
+		}
+	}
+	if numCaches != 1 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.memoize.cache must have exactly one of configMap, sql or artifact", tmpl.Name)
+	}
+	if cache.GetName() == "" {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.memoize.cache name is required", tmpl.Name)
+	}
+	return nil
+}
+
+func validateGang(tmpl *wfv1.Template) error {
+	if tmpl.Gang == nil {
+		return nil
+	}
+	if tmpl.Container == nil && tmpl.Script == nil && tmpl.ContainerSet == nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.gang is only valid for container, containerSet and script templates", tmpl.Name)
+	}
+	minAvailable, err := tmpl.Gang.GetMinAvailable(100)
+	if err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.gang.%s", tmpl.Name, err)
+	}
+	if minAvailable < 1 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.gang.minAvailable must be at least 1", tmpl.Name)
+	}
+	return nil
+}
+
+func validateCheckpoint(tmpl *wfv1.Template) error {
+	if tmpl.Checkpoint == nil {
+		return nil
+	}
+	if tmpl.Container == nil && tmpl.Script == nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.checkpoint is only valid for container and script templates", tmpl.Name)
+	}
+	if !path.IsAbs(tmpl.Checkpoint.Path) {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.checkpoint.path must be an absolute path", tmpl.Name)
+	}
+	if common.FindOverlappingVolume(tmpl, tmpl.Checkpoint.Path) != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.checkpoint.path must not be within a volume mount", tmpl.Name)
+	}
+	if !strings.Contains(tmpl.Checkpoint.Interval, "{{") {
+		interval, err := tmpl.Checkpoint.GetInterval()
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.checkpoint.interval %s", tmpl.Name, err)
+		}
+		if interval <= 0 {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.checkpoint.interval must be positive", tmpl.Name)
+		}
+	}
+	return nil
+}
+
 func validateInputs(tmpl *wfv1.Template) (map[string]interface{}, error) {
 	err := validateWorkflowFieldNames(tmpl.Inputs.Parameters)
 	if err != nil {
@@ -649,6 +783,10 @@ func validateInputs(tmpl *wfv1.Template) (map[string]interface{}, error) {
 		if err != nil {
 			return nil, err
 		}
+		err = validateArtifactEncryption(errPrefix, art.Encryption)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return scope, nil
 }
@@ -669,6 +807,13 @@ func validateArtifactLocation(errPrefix string, art wfv1.ArtifactLocation) error
 	return nil
 }
 
+func validateArtifactEncryption(errPrefix string, encryption *wfv1.ArtifactEncryption) error {
+	if encryption != nil && (encryption.KeySecret == nil || encryption.KeySecret.Name == "" || encryption.KeySecret.Key == "") {
+		return errors.Errorf(errors.CodeBadRequest, "%s.encryption.keySecret name and key are required", errPrefix)
+	}
+	return nil
+}
+
 // resolveAllVariables is a helper to ensure all {{variables}} are resolvable from current scope
 func resolveAllVariables(scope map[string]interface{}, globalParams map[string]string, tmplStr string, workflowTemplateValidation bool) error {
 	_, allowAllItemRefs := scope[anyItemMagicValue] // 'item.*' is a magic placeholder value set by addItemsToScope
@@ -962,6 +1107,9 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
 			}
+			if resolvedTmpl != nil && resolvedTmpl.Outputs.HasDAG() {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s template outputs a DAG, which is only supported by DAG tasks", tmpl.Name, i, step.Name)
+			}
 
 			if step.HasExitHook() {
 				ctx.addOutputsToScope(resolvedTmpl, fmt.Sprintf("steps.%s", step.Name), scope, false, false)
@@ -973,6 +1121,11 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 				return err
 			}
 
+			err = validateLoop(step.Loop, fmt.Sprintf("templates.%s.steps[%d].%s", tmpl.Name, i, step.Name))
+			if err != nil {
+				return err
+			}
+
 			stepBytes, err := json.Marshal(step)
 			if err != nil {
 				return errors.InternalWrapError(err)
@@ -1130,6 +1283,17 @@ func validateOutputs(scope map[string]interface{}, globalParams map[string]strin
 		return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs %s", tmpl.Name, err.Error())
 	}
 
+	if tmpl.Outputs.DAG != nil {
+		switch tmpl.GetType() {
+		case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript:
+			if tmpl.Outputs.DAG.Path == "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs.dag.path must be specified", tmpl.Name)
+			}
+		default:
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs.dag only valid in container/script templates", tmpl.Name)
+		}
+	}
+
 	for _, art := range tmpl.Outputs.Artifacts {
 		artRef := fmt.Sprintf("outputs.artifacts.%s", art.Name)
 		if tmpl.IsLeaf() {
@@ -1148,6 +1312,21 @@ func validateOutputs(scope map[string]interface{}, globalParams map[string]strin
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.globalName: %s", tmpl.Name, artRef, errs[0])
 			}
 		}
+		err = validateArtifactEncryption(fmt.Sprintf("templates.%s.%s", tmpl.Name, artRef), art.Encryption)
+		if err != nil {
+			return err
+		}
+		if art.Stream && art.Encryption != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.stream cannot be used with encryption", tmpl.Name, artRef)
+		}
+		if art.Git != nil {
+			if art.Archive != nil && art.Archive.None == nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.archive must be none for git artifacts", tmpl.Name, artRef)
+			}
+			if art.Encryption != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.encryption cannot be used with git artifacts", tmpl.Name, artRef)
+			}
+		}
 	}
 	for _, param := range tmpl.Outputs.Parameters {
 		paramRef := fmt.Sprintf("templates.%s.outputs.parameters.%s", tmpl.Name, param.Name)
@@ -1357,6 +1536,19 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
 
+		if resolvedTmpl != nil && resolvedTmpl.Outputs.HasDAG() && task.ShouldExpand() {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s template outputs a DAG, which cannot be used with withItems, withParam or withSequence", tmpl.Name, task.Name)
+		}
+
+		if resolvedTmpl != nil && resolvedTmpl.Outputs.HasDAG() && task.Loop != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s template outputs a DAG, which cannot be used with loop", tmpl.Name, task.Name)
+		}
+
+		err = validateLoop(task.Loop, fmt.Sprintf("templates.%s.tasks.%s", tmpl.Name, task.Name))
+		if err != nil {
+			return err
+		}
+
 		resolvedTemplates[task.Name] = resolvedTmpl
 
 		prefix := fmt.Sprintf("tasks.%s", task.Name)
@@ -1453,6 +1645,105 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 	return nil
 }
 
+// ValidateDynamicDAG validates a DAG generated by a template at runtime (see outputs.dag) and sorts its tasks.
+// The templates the tasks refer to are not validated here, they are resolved as the tasks run.
+func ValidateDynamicDAG(tmpl *wfv1.Template) error {
+	if len(tmpl.DAG.Tasks) == 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s must have at least one task", tmpl.Name)
+	}
+	err := validateWorkflowFieldNames(tmpl.DAG.Tasks)
+	if err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks%s", tmpl.Name, err.Error())
+	}
+	nameToTask := make(map[string]wfv1.DAGTask)
+	for _, task := range tmpl.DAG.Tasks {
+		if task.Depends != "" && len(task.Dependencies) > 0 {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s cannot use both 'depends' and 'dependencies'", tmpl.Name, task.Name)
+		}
+		if task.Template == "" && task.TemplateRef == nil && task.Inline == nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s must specify a template", tmpl.Name, task.Name)
+		}
+		nameToTask[task.Name] = task
+	}
+	dagValidationCtx := &dagValidationContext{
+		tasks:        nameToTask,
+		dependencies: make(map[string]map[string]common.DependencyType),
+	}
+	for _, task := range tmpl.DAG.Tasks {
+		for depName := range dagValidationCtx.GetTaskDependenciesWithDependencyTypes(task.Name) {
+			if _, ok := nameToTask[depName]; !ok {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s dependency '%s' not defined", tmpl.Name, task.Name, depName)
+			}
+		}
+	}
+	if err = verifyNoCycles(tmpl, dagValidationCtx); err != nil {
+		return err
+	}
+	if err = validateDAGTargets(tmpl, nameToTask); err != nil {
+		return err
+	}
+	if err = sortDAGTasks(tmpl, dagValidationCtx); err != nil {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s sorting failed: %s", tmpl.Name, err.Error())
+	}
+	return nil
+}
+
+// ValidateDynamicDAGReferences validates that a DAG generated at runtime only runs templates the workflow's spec
+// already runs, as is required when the controller restricts workflows to referenced templates. Inline templates are
+// rejected, as are references to templates that the spec does not already make.
+func ValidateDynamicDAGReferences(tmpl *wfv1.Template, spec *wfv1.WorkflowSpec) error {
+	refs := specTemplateRefs(spec)
+	validateRef := func(path string, ref *wfv1.TemplateRef) error {
+		if ref != nil && !refs[*ref] {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.templateRef %s/%s is not referenced by the workflow's spec, which is required when the controller is in reference mode", tmpl.Name, path, ref.Name, ref.Template)
+		}
+		return nil
+	}
+	for _, task := range tmpl.DAG.Tasks {
+		if task.Inline != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s.inline is not allowed when the controller is in reference mode", tmpl.Name, task.Name)
+		}
+		if err := validateRef("tasks."+task.Name, task.TemplateRef); err != nil {
+			return err
+		}
+		for event, hook := range task.Hooks {
+			if err := validateRef(fmt.Sprintf("tasks.%s.hooks.%s", task.Name, event), hook.TemplateRef); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// specTemplateRefs returns the template references made by the steps, tasks and hooks of a workflow's spec
+func specTemplateRefs(spec *wfv1.WorkflowSpec) map[wfv1.TemplateRef]bool {
+	refs := map[wfv1.TemplateRef]bool{}
+	add := func(ref *wfv1.TemplateRef, hooks wfv1.LifecycleHooks) {
+		if ref != nil {
+			refs[*ref] = true
+		}
+		for _, hook := range hooks {
+			if hook.TemplateRef != nil {
+				refs[*hook.TemplateRef] = true
+			}
+		}
+	}
+	add(nil, spec.Hooks)
+	for _, t := range spec.Templates {
+		for _, group := range t.Steps {
+			for _, step := range group.Steps {
+				add(step.TemplateRef, step.Hooks)
+			}
+		}
+		if t.DAG != nil {
+			for _, task := range t.DAG.Tasks {
+				add(task.TemplateRef, task.Hooks)
+			}
+		}
+	}
+	return refs
+}
+
 func validateDAGTaskArgumentDependency(arguments wfv1.Arguments, ancestry []string) error {
 	ancestryMap := make(map[string]struct{}, len(ancestry))
 	for _, a := range ancestry {
@@ -255,6 +255,269 @@ func TestResolveIOArtifactPathPlaceholders(t *testing.T) {
 	require.NoError(t, err)
 }
 
+var artifactEncryption = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: artifact-encryption-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    outputs:
+      artifacts:
+      - name: text
+        path: /tmp/text
+        encryption:
+          keySecret:
+            name: my-artifact-key
+            key: key
+    container:
+      image: busybox
+`
+
+func TestValidateArtifactEncryption(t *testing.T) {
+	err := validate(artifactEncryption)
+	require.NoError(t, err)
+
+	err = validate(strings.ReplaceAll(artifactEncryption, "            key: key\n", ""))
+	require.EqualError(t, err, "templates.main.outputs.artifacts.text.encryption.keySecret name and key are required")
+}
+
+func TestValidateArtifactStream(t *testing.T) {
+	err := validate(strings.ReplaceAll(artifactEncryption, "        encryption:\n", "        stream: true\n        encryption:\n"))
+	require.EqualError(t, err, "templates.main.outputs.artifacts.text.stream cannot be used with encryption")
+}
+
+var gitOutputArtifact = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: git-output-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    outputs:
+      artifacts:
+      - name: config
+        path: /tmp/config
+        git:
+          repo: https://github.com/argoproj/argo-workflows.git
+          branch: config
+          commit:
+            message: Update config by {{workflow.name}}
+    container:
+      image: busybox
+`
+
+func TestValidateGitOutputArtifact(t *testing.T) {
+	require.NoError(t, validate(gitOutputArtifact))
+	err := validate(strings.ReplaceAll(gitOutputArtifact, "        git:\n", "        archive:\n          tar: {}\n        git:\n"))
+	require.EqualError(t, err, "templates.main.outputs.artifacts.config.archive must be none for git artifacts")
+	require.NoError(t, validate(strings.ReplaceAll(gitOutputArtifact, "        git:\n", "        archive:\n          none: {}\n        git:\n")))
+}
+
+var dagOutput = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: dag-output-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: generate
+        template: generate
+      - name: finish
+        template: echo
+        depends: generate
+  - name: generate
+    outputs:
+      dag:
+        path: /tmp/dag.json
+    container:
+      image: busybox
+  - name: echo
+    container:
+      image: busybox
+`
+
+func TestValidateDAGOutput(t *testing.T) {
+	require.NoError(t, validate(dagOutput))
+	err := validate(strings.ReplaceAll(dagOutput, "        path: /tmp/dag.json\n", "        path: \"\"\n"))
+	require.EqualError(t, err, "templates.main.tasks.generate templates.generate.outputs.dag.path must be specified")
+	err = validate(strings.ReplaceAll(dagOutput, "        template: generate\n", "        template: generate\n        withItems: [1, 2]\n"))
+	require.EqualError(t, err, "templates.main.tasks.generate template outputs a DAG, which cannot be used with withItems, withParam or withSequence")
+	err = validate(strings.ReplaceAll(dagOutput, "    dag:\n      tasks:\n      - name: generate\n        template: generate\n      - name: finish\n        template: echo\n        depends: generate\n", "    steps:\n    - - name: generate\n        template: generate\n"))
+	require.EqualError(t, err, "templates.main.steps[0].generate template outputs a DAG, which is only supported by DAG tasks")
+}
+
+func TestValidateDynamicDAG(t *testing.T) {
+	dag := func(tasks ...wfv1.DAGTask) *wfv1.Template {
+		return &wfv1.Template{Name: "dag", DAG: &wfv1.DAGTemplate{Tasks: tasks}}
+	}
+	t.Run("Sorted", func(t *testing.T) {
+		tmpl := dag(wfv1.DAGTask{Name: "b", Template: "echo", Depends: "a"}, wfv1.DAGTask{Name: "a", Template: "echo"})
+		require.NoError(t, ValidateDynamicDAG(tmpl))
+		assert.Equal(t, "a", tmpl.DAG.Tasks[0].Name)
+		assert.Equal(t, "b", tmpl.DAG.Tasks[1].Name)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		require.EqualError(t, ValidateDynamicDAG(dag()), "templates.dag must have at least one task")
+	})
+	t.Run("NoTemplate", func(t *testing.T) {
+		require.EqualError(t, ValidateDynamicDAG(dag(wfv1.DAGTask{Name: "a"})), "templates.dag.tasks.a must specify a template")
+	})
+	t.Run("UndefinedDependency", func(t *testing.T) {
+		err := ValidateDynamicDAG(dag(wfv1.DAGTask{Name: "a", Template: "echo", Depends: "b"}))
+		require.EqualError(t, err, "templates.dag.tasks.a dependency 'b' not defined")
+	})
+	t.Run("Cycle", func(t *testing.T) {
+		err := ValidateDynamicDAG(dag(wfv1.DAGTask{Name: "a", Template: "echo", Depends: "b"}, wfv1.DAGTask{Name: "b", Template: "echo", Depends: "a"}))
+		require.ErrorContains(t, err, "dependency cycle detected")
+	})
+}
+
+func TestValidateDynamicDAGReferences(t *testing.T) {
+	ref := &wfv1.TemplateRef{Name: "my-wftmpl", Template: "echo"}
+	spec := &wfv1.WorkflowSpec{Templates: []wfv1.Template{
+		{Name: "main", Steps: []wfv1.ParallelSteps{{Steps: []wfv1.WorkflowStep{{Name: "echo", TemplateRef: ref}}}}},
+	}}
+	dag := func(task wfv1.DAGTask) *wfv1.Template {
+		return &wfv1.Template{Name: "dag", DAG: &wfv1.DAGTemplate{Tasks: []wfv1.DAGTask{task}}}
+	}
+	require.NoError(t, ValidateDynamicDAGReferences(dag(wfv1.DAGTask{Name: "a", Template: "echo"}), spec))
+	require.NoError(t, ValidateDynamicDAGReferences(dag(wfv1.DAGTask{Name: "a", TemplateRef: ref}), spec))
+	err := ValidateDynamicDAGReferences(dag(wfv1.DAGTask{Name: "a", TemplateRef: &wfv1.TemplateRef{Name: "my-wftmpl", Template: "echo", ClusterScope: true}}), spec)
+	require.EqualError(t, err, "templates.dag.tasks.a.templateRef my-wftmpl/echo is not referenced by the workflow's spec, which is required when the controller is in reference mode")
+	err = ValidateDynamicDAGReferences(dag(wfv1.DAGTask{Name: "a", Inline: &wfv1.Template{}}), spec)
+	require.EqualError(t, err, "templates.dag.tasks.a.inline is not allowed when the controller is in reference mode")
+}
+
+var loop = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: loop-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: poll
+        template: poll
+        loop:
+          until: "outputs.parameters.ready == 'true'"
+          maxIterations: 10
+    - - name: print
+        template: print
+        arguments:
+          parameters:
+          - name: ready
+            value: "{{steps.poll.outputs.parameters.ready}}"
+  - name: poll
+    outputs:
+      parameters:
+      - name: ready
+        valueFrom:
+          path: /tmp/ready
+    container:
+      image: busybox
+  - name: print
+    inputs:
+      parameters:
+      - name: ready
+    container:
+      image: busybox
+`
+
+func TestValidateLoop(t *testing.T) {
+	require.NoError(t, validate(loop))
+	err := validate(strings.ReplaceAll(loop, "          until: \"outputs.parameters.ready == 'true'\"\n", ""))
+	require.EqualError(t, err, "templates.main.steps[0].poll.loop.until is required")
+	err = validate(strings.ReplaceAll(loop, "          maxIterations: 10\n", ""))
+	require.EqualError(t, err, "templates.main.steps[0].poll.loop.maxIterations is required")
+	err = validate(strings.ReplaceAll(loop, "maxIterations: 10", "maxIterations: 0"))
+	require.EqualError(t, err, "templates.main.steps[0].poll.loop.maxIterations must be a positive integer > 0 or an argo variable")
+	err = validate(strings.ReplaceAll(loop, "maxIterations: 10", "maxIterations: ten"))
+	require.EqualError(t, err, "templates.main.steps[0].poll.loop.maxIterations must be a positive integer > 0 or an argo variable")
+	err = validate(strings.ReplaceAll(dagOutput, "        template: generate\n", "        template: generate\n        loop:\n          until: \"true\"\n          maxIterations: 2\n"))
+	require.EqualError(t, err, "templates.main.tasks.generate template outputs a DAG, which cannot be used with loop")
+}
+
+var retryRules = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: retry-rules-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: retry
+        template: retry
+  - name: retry
+    retryStrategy:
+      retryRules:
+      - match:
+          evicted: true
+        limit: 10
+      - match:
+          exitCodes: [1]
+          message: "^Error"
+          status: Failed
+        limit: 2
+        backoff:
+          duration: 1m
+    container:
+      image: busybox
+`
+
+func TestValidateRetryRules(t *testing.T) {
+	require.NoError(t, validate(retryRules))
+	err := validate(strings.ReplaceAll(retryRules, "        limit: 2\n", ""))
+	require.EqualError(t, err, "templates.main.steps[0].retry retryStrategy.retryRules[1].limit is required")
+	err = validate(strings.ReplaceAll(retryRules, "duration: 1m", "factor: 2"))
+	require.EqualError(t, err, "templates.main.steps[0].retry retryStrategy.retryRules[1].backoff.duration is required")
+	err = validate(strings.ReplaceAll(retryRules, `"^Error"`, `"^(Error"`))
+	require.ErrorContains(t, err, "templates.main.steps[0].retry retryStrategy.retryRules[1].match.message is not a valid regular expression")
+	err = validate(strings.ReplaceAll(retryRules, "status: Failed", "status: Succeeded"))
+	require.EqualError(t, err, "templates.main.steps[0].retry retryStrategy.retryRules[1].match.status must be one of: Failed, Error")
+}
+
+var resourceEscalation = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: resource-escalation-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    retryStrategy:
+      limit: 3
+      resourceEscalation:
+        factor: "1.5"
+        maxMemory: 4Gi
+    container:
+      image: busybox
+      resources:
+        requests:
+          memory: 1Gi
+`
+
+func TestValidateResourceEscalation(t *testing.T) {
+	require.NoError(t, validate(resourceEscalation))
+	err := validate(strings.ReplaceAll(resourceEscalation, `factor: "1.5"`, `factor: "0.5"`))
+	require.EqualError(t, err, "retryStrategy.resourceEscalation.factor must be a number >= 1")
+	err = validate(strings.ReplaceAll(resourceEscalation, `factor: "1.5"`, `factor: double`))
+	require.EqualError(t, err, "retryStrategy.resourceEscalation.factor must be a number >= 1")
+}
+
 var outputParameterPath = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -3294,3 +3557,143 @@ func TestShouldCheckValidationToSpacedParameters(t *testing.T) {
 	// Do not allow leading or trailing spaces in parameters
 	require.ErrorContains(t, err, "failed to resolve {{  workflow.thisdoesnotexist  }}")
 }
+
+var memoizeWithTwoCaches = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: memoized-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    memoize:
+      key: "cache-key"
+      cache:
+        configMap:
+          name: cache-config
+        sql:
+          name: my-cache
+    container:
+      image: busybox
+`
+
+var memoizeWithSQLCache = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: memoized-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    memoize:
+      key: "cache-key"
+      cache:
+        sql:
+          name: my-cache
+    container:
+      image: busybox
+`
+
+func TestMemoizeCache(t *testing.T) {
+	err := validate(memoizeWithTwoCaches)
+	require.EqualError(t, err, "templates.main.memoize.cache must have exactly one of configMap, sql or artifact")
+	err = validate(memoizeWithSQLCache)
+	require.NoError(t, err)
+}
+
+var gangTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: gang-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: pod
+        template: pod
+        withItems: [1, 2]
+  - name: pod
+    gang:
+      minAvailable: MIN_AVAILABLE
+    container:
+      image: alpine:latest
+`
+
+var gangStepsTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: gang-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    gang: {}
+    steps:
+    - - name: pod
+        template: pod
+  - name: pod
+    container:
+      image: alpine:latest
+`
+
+func TestGang(t *testing.T) {
+	err := validate(strings.Replace(gangTemplate, "MIN_AVAILABLE", "1", 1))
+	require.NoError(t, err)
+	err = validate(strings.Replace(gangTemplate, "MIN_AVAILABLE", "50%", 1))
+	require.NoError(t, err)
+	err = validate(strings.Replace(gangTemplate, "MIN_AVAILABLE", "0", 1))
+	require.EqualError(t, err, "templates.main.steps[0].pod templates.pod.gang.minAvailable must be at least 1")
+	err = validate(strings.Replace(gangTemplate, "MIN_AVAILABLE", "all", 1))
+	require.ErrorContains(t, err, "templates.pod.gang.minAvailable")
+	err = validate(gangStepsTemplate)
+	require.EqualError(t, err, "templates.main.gang is only valid for container, containerSet and script templates")
+}
+
+var checkpointTemplate = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: checkpoint-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: interval
+        value: 1m
+    checkpoint:
+      path: PATH
+      interval: INTERVAL
+    container:
+      image: alpine:latest
+      volumeMounts:
+      - name: data
+        mountPath: /data
+  volumes:
+  - name: data
+    emptyDir: {}
+`
+
+func TestCheckpoint(t *testing.T) {
+	newTemplate := func(path, interval string) string {
+		return strings.NewReplacer("PATH", path, "INTERVAL", interval).Replace(checkpointTemplate)
+	}
+	err := validate(newTemplate("/checkpoint", "1m"))
+	require.NoError(t, err)
+	err = validate(newTemplate("/checkpoint", "'{{inputs.parameters.interval}}'"))
+	require.NoError(t, err)
+	err = validate(newTemplate("checkpoint", "1m"))
+	require.EqualError(t, err, "templates.main.checkpoint.path must be an absolute path")
+	err = validate(newTemplate("/data/checkpoint", "1m"))
+	require.EqualError(t, err, "templates.main.checkpoint.path must not be within a volume mount")
+	err = validate(newTemplate("/checkpoint", "often"))
+	require.ErrorContains(t, err, "templates.main.checkpoint.interval unable to parse often as a duration")
+	err = validate(newTemplate("/checkpoint", "0s"))
+	require.EqualError(t, err, "templates.main.checkpoint.interval must be positive")
+}